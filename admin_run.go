@@ -61,7 +61,14 @@ const (
 type AdminRunsListOptions struct {
 	ListOptions
 
-	RunStatus     string `url:"filter[status],omitempty"`
+	// Optional: A list of run statuses to restrict results to.
+	RunStatuses []RunStatus `url:"filter[status],omitempty"`
+
+	// Optional: A comma-separated list of run statuses to restrict results to.
+	//
+	// Deprecated: Use RunStatuses instead.
+	RunStatus string `url:"filter[status],omitempty"`
+
 	CreatedBefore string `url:"filter[to],omitempty"`
 	CreatedAfter  string `url:"filter[from],omitempty"`
 	Query         string `url:"q,omitempty"`
@@ -133,6 +140,12 @@ func (o *AdminRunsListOptions) valid() error {
 		return err
 	}
 
+	for _, status := range o.RunStatuses {
+		if err := validateAdminRunFilterParams(string(status)); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
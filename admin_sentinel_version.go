@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"reflect"
+	"sync"
 	"time"
 )
 
@@ -33,26 +35,34 @@ type AdminSentinelVersions interface {
 
 	// Delete a Sentinel version
 	Delete(ctx context.Context, id string) error
+
+	// CreateOrUpdate creates a Sentinel version, or updates the Sentinel version
+	// with the same version string if it already exists.
+	CreateOrUpdate(ctx context.Context, options AdminSentinelVersionCreateOptions) (*AdminSentinelVersion, error)
 }
 
 // adminSentinelVersions implements AdminSentinelVersions.
 type adminSentinelVersions struct {
 	client *Client
+
+	// mu serializes the CreateOrUpdate calls made with this client.
+	mu sync.Mutex
 }
 
 // AdminSentinelVersion represents a Sentinel Version
 type AdminSentinelVersion struct {
-	ID               string    `jsonapi:"primary,sentinel-versions"`
-	Version          string    `jsonapi:"attr,version"`
-	URL              string    `jsonapi:"attr,url"`
-	SHA              string    `jsonapi:"attr,sha"`
-	Deprecated       bool      `jsonapi:"attr,deprecated"`
-	DeprecatedReason *string   `jsonapi:"attr,deprecated-reason,omitempty"`
-	Official         bool      `jsonapi:"attr,official"`
-	Enabled          bool      `jsonapi:"attr,enabled"`
-	Beta             bool      `jsonapi:"attr,beta"`
-	Usage            int       `jsonapi:"attr,usage"`
-	CreatedAt        time.Time `jsonapi:"attr,created-at,iso8601"`
+	ID               string                     `jsonapi:"primary,sentinel-versions"`
+	Version          string                     `jsonapi:"attr,version"`
+	URL              string                     `jsonapi:"attr,url"`
+	SHA              string                     `jsonapi:"attr,sha"`
+	Deprecated       bool                       `jsonapi:"attr,deprecated"`
+	DeprecatedReason *string                    `jsonapi:"attr,deprecated-reason,omitempty"`
+	Official         bool                       `jsonapi:"attr,official"`
+	Enabled          bool                       `jsonapi:"attr,enabled"`
+	Beta             bool                       `jsonapi:"attr,beta"`
+	Usage            int                        `jsonapi:"attr,usage"`
+	CreatedAt        time.Time                  `jsonapi:"attr,created-at,iso8601"`
+	Archs            []*ToolVersionArchitecture `jsonapi:"attr,archs,omitempty"`
 }
 
 // AdminSentinelVersionsListOptions represents the options for listing
@@ -69,28 +79,30 @@ type AdminSentinelVersionsListOptions struct {
 
 // AdminSentinelVersionCreateOptions for creating an Sentinel version.
 type AdminSentinelVersionCreateOptions struct {
-	Type             string  `jsonapi:"primary,sentinel-versions"`
-	Version          string  `jsonapi:"attr,version"` // Required
-	URL              string  `jsonapi:"attr,url"`     // Required
-	SHA              string  `jsonapi:"attr,sha"`     // Required
-	Official         *bool   `jsonapi:"attr,official,omitempty"`
-	Deprecated       *bool   `jsonapi:"attr,deprecated,omitempty"`
-	DeprecatedReason *string `jsonapi:"attr,deprecated-reason,omitempty"`
-	Enabled          *bool   `jsonapi:"attr,enabled,omitempty"`
-	Beta             *bool   `jsonapi:"attr,beta,omitempty"`
+	Type             string                     `jsonapi:"primary,sentinel-versions"`
+	Version          string                     `jsonapi:"attr,version"` // Required
+	URL              string                     `jsonapi:"attr,url"`     // Required, unless Archs is set
+	SHA              string                     `jsonapi:"attr,sha"`     // Required, unless Archs is set
+	Official         *bool                      `jsonapi:"attr,official,omitempty"`
+	Deprecated       *bool                      `jsonapi:"attr,deprecated,omitempty"`
+	DeprecatedReason *string                    `jsonapi:"attr,deprecated-reason,omitempty"`
+	Enabled          *bool                      `jsonapi:"attr,enabled,omitempty"`
+	Beta             *bool                      `jsonapi:"attr,beta,omitempty"`
+	Archs            []*ToolVersionArchitecture `jsonapi:"attr,archs,omitempty"`
 }
 
 // AdminSentinelVersionUpdateOptions for updating Sentinel version.
 type AdminSentinelVersionUpdateOptions struct {
-	Type             string  `jsonapi:"primary,sentinel-versions"`
-	Version          *string `jsonapi:"attr,version,omitempty"`
-	URL              *string `jsonapi:"attr,url,omitempty"`
-	SHA              *string `jsonapi:"attr,sha,omitempty"`
-	Official         *bool   `jsonapi:"attr,official,omitempty"`
-	Deprecated       *bool   `jsonapi:"attr,deprecated,omitempty"`
-	DeprecatedReason *string `jsonapi:"attr,deprecated-reason,omitempty"`
-	Enabled          *bool   `jsonapi:"attr,enabled,omitempty"`
-	Beta             *bool   `jsonapi:"attr,beta,omitempty"`
+	Type             string                     `jsonapi:"primary,sentinel-versions"`
+	Version          *string                    `jsonapi:"attr,version,omitempty"`
+	URL              *string                    `jsonapi:"attr,url,omitempty"`
+	SHA              *string                    `jsonapi:"attr,sha,omitempty"`
+	Official         *bool                      `jsonapi:"attr,official,omitempty"`
+	Deprecated       *bool                      `jsonapi:"attr,deprecated,omitempty"`
+	DeprecatedReason *string                    `jsonapi:"attr,deprecated-reason,omitempty"`
+	Enabled          *bool                      `jsonapi:"attr,enabled,omitempty"`
+	Beta             *bool                      `jsonapi:"attr,beta,omitempty"`
+	Archs            []*ToolVersionArchitecture `jsonapi:"attr,archs,omitempty"`
 }
 
 // AdminSentinelVersionsList represents a list of Sentinel versions.
@@ -191,13 +203,80 @@ func (a *adminSentinelVersions) Delete(ctx context.Context, id string) error {
 	return req.Do(ctx, nil)
 }
 
+// CreateOrUpdate creates a Sentinel version, or updates the existing Sentinel
+// version with the same version string. Calls made with the same client are
+// serialized, and a version created by another client between the lookup and
+// the creation is updated instead.
+func (a *adminSentinelVersions) CreateOrUpdate(ctx context.Context, options AdminSentinelVersionCreateOptions) (*AdminSentinelVersion, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	existing, err := a.findByVersion(ctx, options.Version)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		sv, createErr := a.Create(ctx, options)
+		if createErr == nil {
+			return sv, nil
+		}
+
+		existing, err = a.findByVersion(ctx, options.Version)
+		if err != nil || existing == nil {
+			return nil, createErr
+		}
+	}
+
+	updateOpts := AdminSentinelVersionUpdateOptions{
+		Official:         options.Official,
+		Deprecated:       options.Deprecated,
+		DeprecatedReason: options.DeprecatedReason,
+		Enabled:          options.Enabled,
+		Beta:             options.Beta,
+		Archs:            options.Archs,
+	}
+	if options.URL != "" {
+		updateOpts.URL = String(options.URL)
+	}
+	if options.SHA != "" {
+		updateOpts.SHA = String(options.SHA)
+	}
+
+	return a.Update(ctx, existing.ID, updateOpts)
+}
+
+// findByVersion returns the Sentinel version with the exact version string, or nil
+// if it does not exist.
+func (a *adminSentinelVersions) findByVersion(ctx context.Context, version string) (*AdminSentinelVersion, error) {
+	l, err := a.List(ctx, &AdminSentinelVersionsListOptions{Filter: version})
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range l.Items {
+		if item.Version == version {
+			return item, nil
+		}
+	}
+
+	return nil, nil
+}
+
 func (o AdminSentinelVersionCreateOptions) valid() error {
-	if (o == AdminSentinelVersionCreateOptions{}) {
+	if (reflect.DeepEqual(o, AdminSentinelVersionCreateOptions{})) {
 		return ErrRequiredSentinelVerCreateOps
 	}
 	if o.Version == "" {
 		return ErrRequiredVersion
 	}
+	if validToolVersionArchs(o.Archs) {
+		return nil
+	}
 	if o.URL == "" {
 		return ErrRequiredURL
 	}
@@ -6,6 +6,7 @@ package tfe
 import (
 	"context"
 	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -156,6 +157,31 @@ func TestAdminSentinelVersions_CreateDelete(t *testing.T) {
 		assert.Equal(t, false, sv.Beta)
 	})
 
+	t.Run("with architectures instead of a URL and sha", func(t *testing.T) {
+		opts := AdminSentinelVersionCreateOptions{
+			Version: createAdminSentinelVersion(),
+			Archs: []*ToolVersionArchitecture{
+				{
+					URL:  "https://www.hashicorp.com",
+					Sha:  genSha(t),
+					OS:   linux,
+					Arch: amd64,
+				},
+			},
+		}
+		sv, err := client.Admin.SentinelVersions.Create(ctx, opts)
+		require.NoError(t, err)
+
+		defer func() {
+			deleteErr := client.Admin.SentinelVersions.Delete(ctx, sv.ID)
+			require.NoError(t, deleteErr)
+		}()
+
+		assert.Equal(t, opts.Version, sv.Version)
+		require.Len(t, sv.Archs, 1)
+		assert.Equal(t, amd64, sv.Archs[0].Arch)
+	})
+
 	t.Run("with empty options", func(t *testing.T) {
 		_, err := client.Admin.SentinelVersions.Create(ctx, AdminSentinelVersionCreateOptions{})
 		require.Equal(t, err, ErrRequiredSentinelVerCreateOps)
@@ -227,3 +253,59 @@ func TestAdminSentinelVersions_ReadUpdate(t *testing.T) {
 		require.Error(t, err)
 	})
 }
+
+func TestAdminSentinelVersions_CreateOrUpdate(t *testing.T) {
+	skipUnlessEnterprise(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	opts := AdminSentinelVersionCreateOptions{
+		Version: createAdminSentinelVersion(),
+		URL:     "https://www.hashicorp.com",
+		SHA:     genSha(t),
+		Enabled: Bool(false),
+	}
+
+	created, err := client.Admin.SentinelVersions.CreateOrUpdate(ctx, opts)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		deleteErr := client.Admin.SentinelVersions.Delete(ctx, created.ID)
+		require.NoError(t, deleteErr)
+	})
+	assert.Equal(t, opts.Version, created.Version)
+	assert.False(t, created.Enabled)
+
+	t.Run("when the version already exists", func(t *testing.T) {
+		opts.Enabled = Bool(true)
+		updated, err := client.Admin.SentinelVersions.CreateOrUpdate(ctx, opts)
+		require.NoError(t, err)
+		assert.Equal(t, created.ID, updated.ID)
+		assert.True(t, updated.Enabled)
+	})
+
+	t.Run("when called concurrently", func(t *testing.T) {
+		var wg sync.WaitGroup
+		ids := make([]string, 3)
+		for i := range ids {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				sv, err := client.Admin.SentinelVersions.CreateOrUpdate(ctx, opts)
+				if assert.NoError(t, err) {
+					ids[i] = sv.ID
+				}
+			}(i)
+		}
+		wg.Wait()
+
+		for _, id := range ids {
+			assert.Equal(t, created.ID, id)
+		}
+	})
+
+	t.Run("with empty options", func(t *testing.T) {
+		_, err := client.Admin.SentinelVersions.CreateOrUpdate(ctx, AdminSentinelVersionCreateOptions{})
+		assert.Equal(t, ErrRequiredSentinelVerCreateOps, err)
+	})
+}
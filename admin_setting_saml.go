@@ -5,6 +5,10 @@ package tfe
 
 import (
 	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"time"
 )
 
 // Compile-time proof of interface implementation.
@@ -22,6 +26,18 @@ type SAMLSettings interface {
 	// RevokeIdpCert revokes the older IdP certificate when the new IdP
 	// certificate is known to be functioning correctly.
 	RevokeIdpCert(ctx context.Context) (*AdminSAMLSetting, error)
+
+	// RotateIdpCert validates a new IdP certificate and uploads it. The
+	// previous IdP certificate is kept as the old IdP certificate until it is
+	// revoked with RevokeIdpCert.
+	RotateIdpCert(ctx context.Context, idpCert string) (*AdminSAMLSetting, error)
+
+	// RotateCertificate validates a new certificate and private key used to
+	// sign SAML requests and uploads them.
+	RotateCertificate(ctx context.Context, certificate, privateKey string) (*AdminSAMLSetting, error)
+
+	// ReadCertificates returns the validity of the configured certificates.
+	ReadCertificates(ctx context.Context) (*AdminSAMLCertificates, error)
 }
 
 type adminSAMLSettings struct {
@@ -53,6 +69,32 @@ type AdminSAMLSetting struct {
 	SignatureDigestMethod     string `jsonapi:"attr,signature-digest-method"`
 }
 
+// AdminSAMLCertificate represents the validity of a certificate configured in
+// the SAML settings.
+type AdminSAMLCertificate struct {
+	Subject   string
+	NotBefore time.Time
+	NotAfter  time.Time
+}
+
+// AdminSAMLCertificates represents the certificates configured in the SAML
+// settings. A certificate is nil when it is not configured and an error is
+// returned when it cannot be parsed.
+type AdminSAMLCertificates struct {
+	// The active IdP certificate.
+	IDPCert *AdminSAMLCertificate
+	// The previous IdP certificate, until it is revoked.
+	OldIDPCert *AdminSAMLCertificate
+	// The certificate used to sign SAML requests.
+	Certificate *AdminSAMLCertificate
+}
+
+// ExpiresWithin reports whether the certificate expires within the given
+// duration from now.
+func (c *AdminSAMLCertificate) ExpiresWithin(d time.Duration) bool {
+	return time.Now().Add(d).After(c.NotAfter)
+}
+
 // Read returns the SAML settings.
 func (a *adminSAMLSettings) Read(ctx context.Context) (*AdminSAMLSetting, error) {
 	req, err := a.client.NewRequest("GET", "admin/saml-settings", nil)
@@ -124,3 +166,138 @@ func (a *adminSAMLSettings) RevokeIdpCert(ctx context.Context) (*AdminSAMLSettin
 
 	return saml, nil
 }
+
+// RotateIdpCert validates a new IdP certificate and uploads it. The certificate
+// must be a PEM encoded X.509 certificate that is currently valid, so a
+// malformed upload cannot lock administrators out of Terraform Enterprise.
+func (a *adminSAMLSettings) RotateIdpCert(ctx context.Context, idpCert string) (*AdminSAMLSetting, error) {
+	if _, err := parseSAMLCertificate(idpCert); err != nil {
+		return nil, err
+	}
+
+	return a.Update(ctx, AdminSAMLSettingsUpdateOptions{
+		IDPCert: String(idpCert),
+	})
+}
+
+// RotateCertificate validates a new certificate and private key used to sign
+// SAML requests and uploads them. The certificate must be currently valid and
+// the private key must match it.
+func (a *adminSAMLSettings) RotateCertificate(ctx context.Context, certificate, privateKey string) (*AdminSAMLSetting, error) {
+	cert, err := parseSAMLCertificate(certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := parseSAMLPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	pub, ok := key.Public().(interface{ Equal(crypto.PublicKey) bool })
+	if !ok || !pub.Equal(cert.PublicKey) {
+		return nil, ErrSAMLPrivateKeyMismatch
+	}
+
+	return a.Update(ctx, AdminSAMLSettingsUpdateOptions{
+		Certificate: String(certificate),
+		PrivateKey:  String(privateKey),
+	})
+}
+
+// ReadCertificates reads the SAML settings and parses the configured
+// certificates.
+func (a *adminSAMLSettings) ReadCertificates(ctx context.Context) (*AdminSAMLCertificates, error) {
+	saml, err := a.Read(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	certs := &AdminSAMLCertificates{}
+	for _, c := range []struct {
+		value string
+		dst   **AdminSAMLCertificate
+	}{
+		{saml.IDPCert, &certs.IDPCert},
+		{saml.OldIDPCert, &certs.OldIDPCert},
+		{saml.Certificate, &certs.Certificate},
+	} {
+		if c.value == "" {
+			continue
+		}
+
+		cert, err := decodeSAMLCertificate(c.value)
+		if err != nil {
+			return nil, err
+		}
+		*c.dst = &AdminSAMLCertificate{
+			Subject:   cert.Subject.String(),
+			NotBefore: cert.NotBefore,
+			NotAfter:  cert.NotAfter,
+		}
+	}
+
+	return certs, nil
+}
+
+// parseSAMLCertificate decodes a PEM encoded certificate and checks that it is
+// currently valid.
+func parseSAMLCertificate(value string) (*x509.Certificate, error) {
+	cert, err := decodeSAMLCertificate(value)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if now.Before(cert.NotBefore) || now.After(cert.NotAfter) {
+		return nil, ErrSAMLCertificateNotValid
+	}
+
+	return cert, nil
+}
+
+func decodeSAMLCertificate(value string) (*x509.Certificate, error) {
+	block, _ := pem.Decode([]byte(value))
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, ErrInvalidSAMLCertificate
+	}
+
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return nil, ErrInvalidSAMLCertificate
+	}
+
+	return cert, nil
+}
+
+// parseSAMLPrivateKey decodes a PEM encoded PKCS #1, PKCS #8 or SEC 1 private
+// key.
+func parseSAMLPrivateKey(value string) (crypto.Signer, error) {
+	block, _ := pem.Decode([]byte(value))
+	if block == nil {
+		return nil, ErrInvalidSAMLPrivateKey
+	}
+
+	var key any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		key, err = x509.ParseECPrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	default:
+		return nil, ErrInvalidSAMLPrivateKey
+	}
+	if err != nil {
+		return nil, ErrInvalidSAMLPrivateKey
+	}
+
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, ErrInvalidSAMLPrivateKey
+	}
+
+	return signer, nil
+}
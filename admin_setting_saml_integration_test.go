@@ -5,7 +5,16 @@ package tfe
 
 import (
 	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -129,3 +138,94 @@ func TestAdminSettings_SAML_Update(t *testing.T) {
 		assert.NotNil(t, samlSettings.IDPCert)
 	})
 }
+
+func TestAdminSettings_SAML_RotateCertificates(t *testing.T) {
+	valid, validKey := generateSAMLCertificate(t, "valid", time.Now().Add(-time.Hour), time.Now().Add(24*time.Hour))
+	expired, _ := generateSAMLCertificate(t, "expired", time.Now().Add(-48*time.Hour), time.Now().Add(-24*time.Hour))
+	_, otherKey := generateSAMLCertificate(t, "other", time.Now().Add(-time.Hour), time.Now().Add(24*time.Hour))
+
+	attributes, err := json.Marshal(map[string]string{
+		"idp-cert":     valid,
+		"old-idp-cert": expired,
+	})
+	require.NoError(t, err)
+	document := `{"data":{"id":"saml","type":"saml-settings","attributes":` + string(attributes) + `}}`
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/admin/saml-settings":   document,
+		"PATCH /api/v2/admin/saml-settings": document,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("ReadCertificates", func(t *testing.T) {
+		certs, err := client.Admin.Settings.SAML.ReadCertificates(ctx)
+		require.NoError(t, err)
+
+		require.NotNil(t, certs.IDPCert)
+		assert.Equal(t, "CN=valid", certs.IDPCert.Subject)
+		assert.False(t, certs.IDPCert.ExpiresWithin(time.Hour))
+		assert.True(t, certs.IDPCert.ExpiresWithin(48*time.Hour))
+
+		require.NotNil(t, certs.OldIDPCert)
+		assert.True(t, certs.OldIDPCert.ExpiresWithin(0))
+
+		assert.Nil(t, certs.Certificate)
+	})
+
+	t.Run("RotateIdpCert with a valid certificate", func(t *testing.T) {
+		saml, err := client.Admin.Settings.SAML.RotateIdpCert(ctx, valid)
+		require.NoError(t, err)
+		assert.Equal(t, valid, saml.IDPCert)
+	})
+
+	t.Run("RotateIdpCert with an expired certificate", func(t *testing.T) {
+		_, err := client.Admin.Settings.SAML.RotateIdpCert(ctx, expired)
+		assert.Equal(t, ErrSAMLCertificateNotValid, err)
+	})
+
+	t.Run("RotateIdpCert with a malformed certificate", func(t *testing.T) {
+		_, err := client.Admin.Settings.SAML.RotateIdpCert(ctx, "testCert")
+		assert.Equal(t, ErrInvalidSAMLCertificate, err)
+	})
+
+	t.Run("RotateCertificate with a matching private key", func(t *testing.T) {
+		_, err := client.Admin.Settings.SAML.RotateCertificate(ctx, valid, validKey)
+		require.NoError(t, err)
+	})
+
+	t.Run("RotateCertificate with another private key", func(t *testing.T) {
+		_, err := client.Admin.Settings.SAML.RotateCertificate(ctx, valid, otherKey)
+		assert.Equal(t, ErrSAMLPrivateKeyMismatch, err)
+	})
+
+	t.Run("RotateCertificate with a malformed private key", func(t *testing.T) {
+		_, err := client.Admin.Settings.SAML.RotateCertificate(ctx, valid, "testPrivateKey")
+		assert.Equal(t, ErrInvalidSAMLPrivateKey, err)
+	})
+}
+
+// generateSAMLCertificate returns a PEM encoded self-signed certificate and
+// its PEM encoded private key.
+func generateSAMLCertificate(t *testing.T, cn string, notBefore, notAfter time.Time) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: cn},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key)
+	require.NoError(t, err)
+
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	pkey := pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER})
+	return string(cert), string(pkey)
+}
@@ -216,15 +216,17 @@ func (o AdminTerraformVersionCreateOptions) valid() error {
 	if !validString(o.Version) {
 		return ErrRequiredVersion
 	}
-	if !o.validArch() && (!validString(o.URL) || !validString(o.Sha)) {
+	if !validToolVersionArchs(o.Archs) && (!validString(o.URL) || !validString(o.Sha)) {
 		return ErrRequiredArchOrURLAndSha
 	}
 	return nil
 }
 
-func (o AdminTerraformVersionCreateOptions) validArch() bool {
+// validToolVersionArchs reports whether at least one of the architectures of
+// a tool version is complete and supported.
+func validToolVersionArchs(archs []*ToolVersionArchitecture) bool {
 	var valid bool
-	for _, a := range o.Archs {
+	for _, a := range archs {
 		valid = validString(&a.URL) && validString(&a.Sha) && a.OS == linux && (a.Arch == amd64 || a.Arch == arm64)
 		if valid {
 			break
@@ -33,7 +33,7 @@ type AdminUsers interface {
 	// GrantAdmin grants admin privileges to a user by its ID.
 	GrantAdmin(ctx context.Context, userID string) (*AdminUser, error)
 
-	// RevokeAdmin revokees admin privileges to a user by its ID.
+	// RevokeAdmin revokes admin privileges to a user by its ID.
 	RevokeAdmin(ctx context.Context, userID string) (*AdminUser, error)
 
 	// Disable2FA disables a user's two-factor authentication in the situation
@@ -78,7 +78,8 @@ const AdminUserOrgs AdminUserIncludeOpt = "organizations"
 type AdminUserListOptions struct {
 	ListOptions
 
-	// Optional: A search query string. Users are searchable by username and email address.
+	// Optional: A search query string. Users are searchable by username and email address,
+	// so a user can be found by its email address with this query.
 	Query string `url:"q,omitempty"`
 
 	// Optional: Can be "true" or "false" to show only administrators or non-administrators.
@@ -71,8 +71,14 @@ type AdminWorkspaceListOptions struct {
 	// https://developer.hashicorp.com/terraform/enterprise/api-docs/admin/workspaces#available-related-resources
 	Include []AdminWorkspaceIncludeOpt `url:"include,omitempty"`
 
-	// Optional: A comma-separated list of Run statuses to restrict results. See available resources
+	// Optional: A list of run statuses to restrict results to the workspaces
+	// whose current run has one of them. See available resources
 	// https://developer.hashicorp.com/terraform/enterprise/api-docs/admin/workspaces#query-parameters
+	CurrentRunStatuses []RunStatus `url:"filter[current_run][status],omitempty"`
+
+	// Optional: A comma-separated list of Run statuses to restrict results.
+	//
+	// Deprecated: Use CurrentRunStatuses instead.
 	Filter string `url:"filter[current_run][status],omitempty"`
 
 	// Optional: May sort on "name" (the default) and "current-run.created-at" (which sorts by the time of the current run)
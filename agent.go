@@ -22,6 +22,10 @@ type Agents interface {
 
 	// List all the agents of the given pool.
 	List(ctx context.Context, agentPoolID string, options *AgentListOptions) (*AgentList, error)
+
+	// ListByStatus lists the agents of the given pool across all pages,
+	// keeping those with one of the given statuses.
+	ListByStatus(ctx context.Context, agentPoolID string, options *AgentListByStatusOptions) ([]*Agent, error)
 }
 
 // agents implements Agents.
@@ -35,6 +39,18 @@ type AgentList struct {
 	Items []*Agent
 }
 
+// AgentStatus represents the status of an agent.
+type AgentStatus string
+
+// List of available agent statuses.
+const (
+	AgentIdle    AgentStatus = "idle"
+	AgentBusy    AgentStatus = "busy"
+	AgentUnknown AgentStatus = "unknown"
+	AgentErrored AgentStatus = "errored"
+	AgentExited  AgentStatus = "exited"
+)
+
 // Agent represents a HCP Terraform agent.
 type Agent struct {
 	ID         string `jsonapi:"primary,agents"`
@@ -51,6 +67,19 @@ type AgentListOptions struct {
 	LastPingSince time.Time `url:"filter[last-ping-since],omitempty,iso8601"`
 }
 
+// AgentListByStatusOptions represents the options for listing the agents of
+// a pool by status.
+type AgentListByStatusOptions struct {
+	// Optional: Only list the agents with one of these statuses. The API has
+	// no status filter, so the agents of every page are filtered
+	// client-side. All the agents are listed when empty.
+	Statuses []AgentStatus
+
+	// Optional: Only list the agents that pinged since this time, filtered
+	// by the API.
+	LastPingSince time.Time
+}
+
 // Read a single agent by its ID
 func (s *agents) Read(ctx context.Context, agentID string) (*Agent, error) {
 	if !validStringID(&agentID) {
@@ -92,3 +121,67 @@ func (s *agents) List(ctx context.Context, agentPoolID string, options *AgentLis
 
 	return agentList, nil
 }
+
+// ListByStatus lists the agents of an agent pool across all pages, keeping
+// those with one of the statuses of the options.
+func (s *agents) ListByStatus(ctx context.Context, agentPoolID string, options *AgentListByStatusOptions) ([]*Agent, error) {
+	if !validStringID(&agentPoolID) {
+		return nil, ErrInvalidAgentPoolID
+	}
+	if options == nil {
+		options = &AgentListByStatusOptions{}
+	}
+
+	listOptions := &AgentListOptions{
+		ListOptions:   ListOptions{PageSize: 100},
+		LastPingSince: options.LastPingSince,
+	}
+
+	var matching []*Agent
+	err := forEachPage(&listOptions.ListOptions, func() (*Pagination, error) {
+		al, err := s.List(ctx, agentPoolID, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, a := range al.Items {
+			if options.matches(a) {
+				matching = append(matching, a)
+			}
+		}
+		return al.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return matching, nil
+}
+
+// countAgents lists all the agents of an agent pool and counts them by
+// status.
+func countAgents(ctx context.Context, client *Client, agentPoolID string) (map[AgentStatus]int, error) {
+	agents, err := client.Agents.ListByStatus(ctx, agentPoolID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	counts := make(map[AgentStatus]int)
+	for _, a := range agents {
+		counts[AgentStatus(a.Status)]++
+	}
+
+	return counts, nil
+}
+
+// matches reports whether the agent has one of the statuses of the options.
+func (o *AgentListByStatusOptions) matches(a *Agent) bool {
+	if len(o.Statuses) == 0 {
+		return true
+	}
+	for _, status := range o.Statuses {
+		if AgentStatus(a.Status) == status {
+			return true
+		}
+	}
+	return false
+}
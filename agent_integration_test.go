@@ -73,3 +73,39 @@ func TestAgentsList(t *testing.T) {
 		assert.EqualError(t, err, ErrInvalidOrg.Error())
 	})
 }
+
+func TestAgentsListByStatus(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/agent-pools/apool-1/agents": `{"data":[
+			{"id":"agent-1","type":"agents","attributes":{"name":"one","status":"idle"}},
+			{"id":"agent-2","type":"agents","attributes":{"name":"two","status":"busy"}},
+			{"id":"agent-3","type":"agents","attributes":{"name":"three","status":"errored"}}
+		]}`,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("with statuses", func(t *testing.T) {
+		agents, err := client.Agents.ListByStatus(ctx, "apool-1", &AgentListByStatusOptions{
+			Statuses: []AgentStatus{AgentIdle, AgentErrored},
+		})
+		require.NoError(t, err)
+		require.Len(t, agents, 2)
+		assert.Equal(t, "agent-1", agents[0].ID)
+		assert.Equal(t, "agent-3", agents[1].ID)
+	})
+
+	t.Run("without options", func(t *testing.T) {
+		agents, err := client.Agents.ListByStatus(ctx, "apool-1", nil)
+		require.NoError(t, err)
+		assert.Len(t, agents, 3)
+	})
+
+	t.Run("without a valid agent pool ID", func(t *testing.T) {
+		agents, err := client.Agents.ListByStatus(ctx, badIdentifier, nil)
+		assert.Nil(t, agents)
+		assert.EqualError(t, err, ErrInvalidAgentPoolID.Error())
+	})
+}
@@ -35,8 +35,35 @@ type AgentPools interface {
 	// UpdateAllowedWorkspaces updates the list of allowed workspaces associated with an agent pool.
 	UpdateAllowedWorkspaces(ctx context.Context, agentPool string, options AgentPoolAllowedWorkspacesUpdateOptions) (*AgentPool, error)
 
+	// UpdateAllowedProjects updates the list of allowed projects associated with an agent pool.
+	UpdateAllowedProjects(ctx context.Context, agentPool string, options AgentPoolAllowedProjectsUpdateOptions) (*AgentPool, error)
+
+	// AllowWorkspace adds a workspace to the allowed workspaces of an agent
+	// pool, keeping the workspaces already allowed.
+	AllowWorkspace(ctx context.Context, agentPoolID string, workspaceID string) (*AgentPool, error)
+
+	// DisallowWorkspace removes a workspace from the allowed workspaces of an
+	// agent pool, keeping the other workspaces allowed.
+	DisallowWorkspace(ctx context.Context, agentPoolID string, workspaceID string) (*AgentPool, error)
+
+	// AllowProject adds a project to the allowed projects of an agent pool,
+	// keeping the projects already allowed.
+	AllowProject(ctx context.Context, agentPoolID string, projectID string) (*AgentPool, error)
+
+	// DisallowProject removes a project from the allowed projects of an agent
+	// pool, keeping the other projects allowed.
+	DisallowProject(ctx context.Context, agentPoolID string, projectID string) (*AgentPool, error)
+
 	// Delete an agent pool by its ID.
 	Delete(ctx context.Context, agentPoolID string) error
+
+	// ValidateForWorkspace checks whether the agent pool can be used by the
+	// workspace before switching the workspace to the agent execution mode.
+	ValidateForWorkspace(ctx context.Context, agentPoolID string, workspaceID string) error
+
+	// ReadUtilization reads the number of agents of an agent pool by status
+	// and the number of queued runs waiting for an agent of the pool.
+	ReadUtilization(ctx context.Context, agentPoolID string) (*AgentPoolUtilization, error)
 }
 
 // agentPools implements AgentPools.
@@ -61,6 +88,7 @@ type AgentPool struct {
 	Organization      *Organization `jsonapi:"relation,organization"`
 	Workspaces        []*Workspace  `jsonapi:"relation,workspaces"`
 	AllowedWorkspaces []*Workspace  `jsonapi:"relation,allowed-workspaces"`
+	AllowedProjects   []*Project    `jsonapi:"relation,allowed-projects"`
 }
 
 // A list of relations to include
@@ -71,6 +99,33 @@ const AgentPoolWorkspaces AgentPoolIncludeOpt = "workspaces"
 
 type AgentPoolReadOptions struct {
 	Include []AgentPoolIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
+}
+
+// AgentPoolUtilization represents the utilization of an agent pool.
+type AgentPoolUtilization struct {
+	AgentPoolID string
+	// The number of agents of the pool by status.
+	Total   int
+	Idle    int
+	Busy    int
+	Unknown int
+	Errored int
+	Exited  int
+	// The number of queued runs of workspaces using the pool.
+	QueueDepth int
+}
+
+// BusyRatio returns the fraction of the agents able to take work that are
+// busy, between 0 and 1. It returns 1 when no agent is able to take work.
+func (u *AgentPoolUtilization) BusyRatio() float64 {
+	available := u.Idle + u.Busy
+	if available == 0 {
+		return 1
+	}
+	return float64(u.Busy) / float64(available)
 }
 
 // AgentPoolListOptions represents the options for listing agent pools.
@@ -85,6 +140,12 @@ type AgentPoolListOptions struct {
 
 	// Optional: String (workspace name) used to filter the results.
 	AllowedWorkspacesName string `url:"filter[allowed_workspaces][name],omitempty"`
+
+	// Optional: String (project name) used to filter the results.
+	AllowedProjectsName string `url:"filter[allowed_projects][name],omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // AgentPoolCreateOptions represents the options for creating an agent pool.
@@ -103,6 +164,9 @@ type AgentPoolCreateOptions struct {
 
 	// List of workspaces that are associated with an agent pool.
 	AllowedWorkspaces []*Workspace `jsonapi:"relation,allowed-workspaces,omitempty"`
+
+	// List of projects whose workspaces are associated with an agent pool.
+	AllowedProjects []*Project `jsonapi:"relation,allowed-projects,omitempty"`
 }
 
 // List all the agent pools of the given organization.
@@ -169,7 +233,7 @@ func (s *agentPools) ReadWithOptions(ctx context.Context, agentpoolID string, op
 	}
 
 	u := fmt.Sprintf("agent-pools/%s", url.PathEscape(agentpoolID))
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequest("GET", u, options)
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +263,9 @@ type AgentPoolUpdateOptions struct {
 
 	// A new list of workspaces that are associated with an agent pool.
 	AllowedWorkspaces []*Workspace `jsonapi:"relation,allowed-workspaces,omitempty"`
+
+	// A new list of projects whose workspaces are associated with an agent pool.
+	AllowedProjects []*Project `jsonapi:"relation,allowed-projects,omitempty"`
 }
 
 // AgentPoolUpdateAllowedWorkspacesOptions represents the options for updating the allowed workspace on an agent pool
@@ -213,6 +280,18 @@ type AgentPoolAllowedWorkspacesUpdateOptions struct {
 	AllowedWorkspaces []*Workspace `jsonapi:"relation,allowed-workspaces"`
 }
 
+// AgentPoolAllowedProjectsUpdateOptions represents the options for updating the allowed projects on an agent pool
+type AgentPoolAllowedProjectsUpdateOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,agent-pools"`
+
+	// A new list of projects whose workspaces are associated with an agent pool.
+	AllowedProjects []*Project `jsonapi:"relation,allowed-projects"`
+}
+
 // Update an agent pool by its ID.
 // **Note:** This method cannot be used to clear the allowed workspaces field, instead use UpdateAllowedWorkspaces
 func (s *agentPools) Update(ctx context.Context, agentPoolID string, options AgentPoolUpdateOptions) (*AgentPool, error) {
@@ -259,6 +338,193 @@ func (s *agentPools) UpdateAllowedWorkspaces(ctx context.Context, agentPoolID st
 	return k, nil
 }
 
+func (s *agentPools) UpdateAllowedProjects(ctx context.Context, agentPoolID string, options AgentPoolAllowedProjectsUpdateOptions) (*AgentPool, error) {
+	if !validStringID(&agentPoolID) {
+		return nil, ErrInvalidAgentPoolID
+	}
+
+	u := fmt.Sprintf("agent-pools/%s", url.PathEscape(agentPoolID))
+	req, err := s.client.NewRequest("PATCH", u, &options)
+	if err != nil {
+		return nil, err
+	}
+
+	k := &AgentPool{}
+	err = req.Do(ctx, k)
+	if err != nil {
+		return nil, err
+	}
+
+	return k, nil
+}
+
+// AllowWorkspace reads the allowed workspaces of an agent pool and sends
+// them back with the workspace added, leaving the other settings of the pool
+// untouched. The pool is returned as is when the workspace is already
+// allowed. A workspace allowed or disallowed concurrently, between the read
+// and the update, is overwritten.
+func (s *agentPools) AllowWorkspace(ctx context.Context, agentPoolID, workspaceID string) (*AgentPool, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	return s.updateAllowedWorkspaces(ctx, agentPoolID, func(allowed []*Workspace) ([]*Workspace, bool) {
+		for _, ws := range allowed {
+			if ws.ID == workspaceID {
+				return allowed, false
+			}
+		}
+		return append(allowed, &Workspace{ID: workspaceID}), true
+	})
+}
+
+// DisallowWorkspace reads the allowed workspaces of an agent pool and sends
+// them back without the workspace, leaving the other settings of the pool
+// untouched. The pool is returned as is when the workspace is not allowed.
+// A workspace allowed or disallowed concurrently, between the read and the
+// update, is overwritten.
+func (s *agentPools) DisallowWorkspace(ctx context.Context, agentPoolID, workspaceID string) (*AgentPool, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	return s.updateAllowedWorkspaces(ctx, agentPoolID, func(allowed []*Workspace) ([]*Workspace, bool) {
+		kept := make([]*Workspace, 0, len(allowed))
+		for _, ws := range allowed {
+			if ws.ID != workspaceID {
+				kept = append(kept, ws)
+			}
+		}
+		return kept, len(kept) != len(allowed)
+	})
+}
+
+// AllowProject reads the allowed projects of an agent pool and sends them
+// back with the project added, like AllowWorkspace.
+func (s *agentPools) AllowProject(ctx context.Context, agentPoolID, projectID string) (*AgentPool, error) {
+	if !validStringID(&projectID) {
+		return nil, ErrInvalidProjectID
+	}
+
+	return s.updateAllowedProjects(ctx, agentPoolID, func(allowed []*Project) ([]*Project, bool) {
+		for _, p := range allowed {
+			if p.ID == projectID {
+				return allowed, false
+			}
+		}
+		return append(allowed, &Project{ID: projectID}), true
+	})
+}
+
+// DisallowProject reads the allowed projects of an agent pool and sends them
+// back without the project, like DisallowWorkspace.
+func (s *agentPools) DisallowProject(ctx context.Context, agentPoolID, projectID string) (*AgentPool, error) {
+	if !validStringID(&projectID) {
+		return nil, ErrInvalidProjectID
+	}
+
+	return s.updateAllowedProjects(ctx, agentPoolID, func(allowed []*Project) ([]*Project, bool) {
+		kept := make([]*Project, 0, len(allowed))
+		for _, p := range allowed {
+			if p.ID != projectID {
+				kept = append(kept, p)
+			}
+		}
+		return kept, len(kept) != len(allowed)
+	})
+}
+
+// updateAllowedWorkspaces reads the allowed workspaces of an agent pool and
+// updates them with the result of change, unless change reports that they
+// did not change.
+func (s *agentPools) updateAllowedWorkspaces(ctx context.Context, agentPoolID string, change func([]*Workspace) ([]*Workspace, bool)) (*AgentPool, error) {
+	pool, err := s.Read(ctx, agentPoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, changed := change(pool.AllowedWorkspaces)
+	if !changed {
+		return pool, nil
+	}
+
+	return s.UpdateAllowedWorkspaces(ctx, agentPoolID, AgentPoolAllowedWorkspacesUpdateOptions{
+		AllowedWorkspaces: allowed,
+	})
+}
+
+// updateAllowedProjects reads the allowed projects of an agent pool and
+// updates them with the result of change, unless change reports that they
+// did not change.
+func (s *agentPools) updateAllowedProjects(ctx context.Context, agentPoolID string, change func([]*Project) ([]*Project, bool)) (*AgentPool, error) {
+	pool, err := s.Read(ctx, agentPoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	allowed, changed := change(pool.AllowedProjects)
+	if !changed {
+		return pool, nil
+	}
+
+	return s.UpdateAllowedProjects(ctx, agentPoolID, AgentPoolAllowedProjectsUpdateOptions{
+		AllowedProjects: allowed,
+	})
+}
+
+// ValidateForWorkspace checks that the agent pool belongs to the workspace's
+// organization, that the organization is entitled to use agents and that the
+// workspace, or its project, is allowed to use the pool. It returns nil when the workspace can
+// be updated to use the pool, or an error wrapping one of
+// ErrAgentPoolOrganizationMismatch, ErrAgentPoolNotEntitled or
+// ErrAgentPoolWorkspaceNotAllowed otherwise.
+func (s *agentPools) ValidateForWorkspace(ctx context.Context, agentPoolID, workspaceID string) error {
+	if !validStringID(&agentPoolID) {
+		return ErrInvalidAgentPoolID
+	}
+	if !validStringID(&workspaceID) {
+		return ErrInvalidWorkspaceID
+	}
+
+	pool, err := s.Read(ctx, agentPoolID)
+	if err != nil {
+		return err
+	}
+
+	ws, err := s.client.Workspaces.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	if pool.Organization == nil || ws.Organization == nil || pool.Organization.Name != ws.Organization.Name {
+		return fmt.Errorf("%w: agent pool %q cannot be used by workspace %q", ErrAgentPoolOrganizationMismatch, pool.Name, ws.Name)
+	}
+
+	entitlements, err := s.client.Organizations.ReadEntitlements(ctx, ws.Organization.Name)
+	if err != nil {
+		return err
+	}
+	if !entitlements.Agents {
+		return fmt.Errorf("%w: organization %q", ErrAgentPoolNotEntitled, ws.Organization.Name)
+	}
+
+	if pool.OrganizationScoped {
+		return nil
+	}
+	for _, allowed := range pool.AllowedWorkspaces {
+		if allowed.ID == ws.ID {
+			return nil
+		}
+	}
+	for _, allowed := range pool.AllowedProjects {
+		if ws.Project != nil && allowed.ID == ws.Project.ID {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: add workspace %q to the allowed workspaces of agent pool %q", ErrAgentPoolWorkspaceNotAllowed, ws.Name, pool.Name)
+}
+
 // Delete an agent pool by its ID.
 func (s *agentPools) Delete(ctx context.Context, agentPoolID string) error {
 	if !validStringID(&agentPoolID) {
@@ -274,6 +540,66 @@ func (s *agentPools) Delete(ctx context.Context, agentPoolID string) error {
 	return req.Do(ctx, nil)
 }
 
+// ReadUtilization counts the agents of an agent pool by status and the queued
+// runs of the organization that belong to workspaces using the pool.
+func (s *agentPools) ReadUtilization(ctx context.Context, agentPoolID string) (*AgentPoolUtilization, error) {
+	if !validStringID(&agentPoolID) {
+		return nil, ErrInvalidAgentPoolID
+	}
+
+	pool, err := s.ReadWithOptions(ctx, agentPoolID, &AgentPoolReadOptions{
+		Include: []AgentPoolIncludeOpt{AgentPoolWorkspaces},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	counts, err := countAgents(ctx, s.client, agentPoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	u := &AgentPoolUtilization{
+		AgentPoolID: agentPoolID,
+		Idle:        counts[AgentIdle],
+		Busy:        counts[AgentBusy],
+		Unknown:     counts[AgentUnknown],
+		Errored:     counts[AgentErrored],
+		Exited:      counts[AgentExited],
+	}
+	for _, n := range counts {
+		u.Total += n
+	}
+
+	if len(pool.Workspaces) == 0 || pool.Organization == nil {
+		return u, nil
+	}
+
+	workspaces := make(map[string]bool, len(pool.Workspaces))
+	for _, ws := range pool.Workspaces {
+		workspaces[ws.ID] = true
+	}
+
+	options := ReadRunQueueOptions{ListOptions: ListOptions{PageSize: 100}}
+	err = forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		rq, err := s.client.Organizations.ReadRunQueue(ctx, pool.Organization.Name, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rq.Items {
+			if r.Workspace != nil && workspaces[r.Workspace.ID] {
+				u.QueueDepth++
+			}
+		}
+		return rq.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return u, nil
+}
+
 func (o AgentPoolCreateOptions) valid() error {
 	if !validString(o.Name) {
 		return ErrRequiredName
@@ -5,6 +5,13 @@ package tfe
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -394,6 +401,258 @@ func TestAgentPoolsUpdateAllowedWorkspaces(t *testing.T) {
 	})
 }
 
+func TestAgentPoolsAllowAndDisallow(t *testing.T) {
+	t.Parallel()
+
+	// The fake API serves an agent pool whose allowed workspaces and projects
+	// are replaced by each PATCH, and records the PATCH bodies.
+	var mu sync.Mutex
+	workspaces := []string{"ws-1"}
+	projects := []string{}
+	var patches []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		linkage := func(typ string, ids []string) string {
+			data := make([]string, 0, len(ids))
+			for _, id := range ids {
+				data = append(data, fmt.Sprintf(`{"id":%q,"type":%q}`, id, typ))
+			}
+			return "[" + strings.Join(data, ",") + "]"
+		}
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+			return
+		case r.URL.Path != "/api/v2/agent-pools/apool-1":
+			w.WriteHeader(http.StatusNotFound)
+			return
+		case r.Method == "PATCH":
+			var body struct {
+				Data struct {
+					Relationships map[string]struct {
+						Data []struct {
+							ID string `json:"id"`
+						} `json:"data"`
+					} `json:"relationships"`
+				} `json:"data"`
+			}
+			raw, _ := io.ReadAll(r.Body)
+			patches = append(patches, string(raw))
+			require.NoError(t, json.Unmarshal(raw, &body))
+			for name, rel := range body.Data.Relationships {
+				ids := []string{}
+				for _, d := range rel.Data {
+					ids = append(ids, d.ID)
+				}
+				switch name {
+				case "allowed-workspaces":
+					workspaces = ids
+				case "allowed-projects":
+					projects = ids
+				}
+			}
+		}
+		fmt.Fprintf(w, `{"data":{"id":"apool-1","type":"agent-pools","relationships":{"allowed-workspaces":{"data":%s},"allowed-projects":{"data":%s}}}}`,
+			linkage("workspaces", workspaces), linkage("projects", projects))
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	ids := func(pool *AgentPool) (ws []string, prj []string) {
+		for _, w := range pool.AllowedWorkspaces {
+			ws = append(ws, w.ID)
+		}
+		for _, p := range pool.AllowedProjects {
+			prj = append(prj, p.ID)
+		}
+		return ws, prj
+	}
+
+	t.Run("allowing a workspace", func(t *testing.T) {
+		pool, err := client.AgentPools.AllowWorkspace(ctx, "apool-1", "ws-2")
+		require.NoError(t, err)
+		ws, _ := ids(pool)
+		assert.Equal(t, []string{"ws-1", "ws-2"}, ws)
+		require.Len(t, patches, 1)
+		assert.NotContains(t, patches[0], "allowed-projects")
+	})
+
+	t.Run("allowing an allowed workspace", func(t *testing.T) {
+		_, err := client.AgentPools.AllowWorkspace(ctx, "apool-1", "ws-2")
+		require.NoError(t, err)
+		assert.Len(t, patches, 1)
+	})
+
+	t.Run("disallowing a workspace", func(t *testing.T) {
+		pool, err := client.AgentPools.DisallowWorkspace(ctx, "apool-1", "ws-1")
+		require.NoError(t, err)
+		ws, _ := ids(pool)
+		assert.Equal(t, []string{"ws-2"}, ws)
+	})
+
+	t.Run("allowing and disallowing projects", func(t *testing.T) {
+		pool, err := client.AgentPools.AllowProject(ctx, "apool-1", "prj-1")
+		require.NoError(t, err)
+		ws, prj := ids(pool)
+		assert.Equal(t, []string{"ws-2"}, ws)
+		assert.Equal(t, []string{"prj-1"}, prj)
+
+		pool, err = client.AgentPools.DisallowProject(ctx, "apool-1", "prj-1")
+		require.NoError(t, err)
+		_, prj = ids(pool)
+		assert.Empty(t, prj)
+		assert.Contains(t, patches[len(patches)-1], `"allowed-projects":{"data":[]}`)
+	})
+
+	t.Run("with invalid IDs", func(t *testing.T) {
+		_, err := client.AgentPools.AllowWorkspace(ctx, "apool-1", badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+
+		_, err = client.AgentPools.DisallowProject(ctx, "apool-1", badIdentifier)
+		assert.Equal(t, ErrInvalidProjectID, err)
+
+		_, err = client.AgentPools.AllowProject(ctx, badIdentifier, "prj-1")
+		assert.EqualError(t, err, ErrInvalidAgentPoolID.Error())
+	})
+}
+
+func TestAgentPoolsValidateForWorkspace(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	upgradeOrganizationSubscription(t, client, orgTest)
+
+	workspaceTest, workspaceTestCleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(workspaceTestCleanup)
+
+	t.Run("with an organization scoped agent pool", func(t *testing.T) {
+		pool, poolCleanup := createAgentPool(t, client, orgTest)
+		t.Cleanup(poolCleanup)
+
+		err := client.AgentPools.ValidateForWorkspace(ctx, pool.ID, workspaceTest.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("when the workspace is allowed", func(t *testing.T) {
+		pool, poolCleanup := createAgentPoolWithOptions(t, client, orgTest, AgentPoolCreateOptions{
+			Name:               String(randomString(t)),
+			OrganizationScoped: Bool(false),
+			AllowedWorkspaces:  []*Workspace{workspaceTest},
+		})
+		t.Cleanup(poolCleanup)
+
+		err := client.AgentPools.ValidateForWorkspace(ctx, pool.ID, workspaceTest.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("when the workspace is not allowed", func(t *testing.T) {
+		pool, poolCleanup := createAgentPoolWithOptions(t, client, orgTest, AgentPoolCreateOptions{
+			Name:               String(randomString(t)),
+			OrganizationScoped: Bool(false),
+		})
+		t.Cleanup(poolCleanup)
+
+		err := client.AgentPools.ValidateForWorkspace(ctx, pool.ID, workspaceTest.ID)
+		assert.ErrorIs(t, err, ErrAgentPoolWorkspaceNotAllowed)
+	})
+
+	t.Run("when the workspace belongs to another organization", func(t *testing.T) {
+		pool, poolCleanup := createAgentPool(t, client, orgTest)
+		t.Cleanup(poolCleanup)
+
+		otherWorkspace, otherWorkspaceCleanup := createWorkspace(t, client, nil)
+		t.Cleanup(otherWorkspaceCleanup)
+
+		err := client.AgentPools.ValidateForWorkspace(ctx, pool.ID, otherWorkspace.ID)
+		assert.ErrorIs(t, err, ErrAgentPoolOrganizationMismatch)
+	})
+
+	t.Run("when the agent pool ID is invalid", func(t *testing.T) {
+		err := client.AgentPools.ValidateForWorkspace(ctx, badIdentifier, workspaceTest.ID)
+		assert.EqualError(t, err, ErrInvalidAgentPoolID.Error())
+	})
+
+	t.Run("when the workspace ID is invalid", func(t *testing.T) {
+		err := client.AgentPools.ValidateForWorkspace(ctx, "apool-123", badIdentifier)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestAgentPoolsReadUtilization(t *testing.T) {
+	skipUnlessLinuxAMD64(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	org, orgCleanup := createOrganization(t, client)
+	t.Cleanup(orgCleanup)
+
+	upgradeOrganizationSubscription(t, client, org)
+
+	_, agentPool, agentCleanup := createAgent(t, client, org)
+	t.Cleanup(agentCleanup)
+
+	t.Run("with an agent in the pool", func(t *testing.T) {
+		u, err := client.AgentPools.ReadUtilization(ctx, agentPool.ID)
+		require.NoError(t, err)
+		assert.Equal(t, agentPool.ID, u.AgentPoolID)
+		assert.GreaterOrEqual(t, u.Total, 1)
+		assert.Equal(t, u.Total, u.Idle+u.Busy+u.Unknown+u.Errored+u.Exited)
+		assert.Equal(t, 0, u.QueueDepth)
+	})
+
+	t.Run("when the agent pool ID is invalid", func(t *testing.T) {
+		u, err := client.AgentPools.ReadUtilization(ctx, badIdentifier)
+		assert.Nil(t, u)
+		assert.EqualError(t, err, ErrInvalidAgentPoolID.Error())
+	})
+}
+
+func TestAgentPoolsReadUtilization_QueueDepth(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/agent-pools/apool-1": `{"data":{"id":"apool-1","type":"agent-pools","attributes":{"name":"pool"},"relationships":{
+			"organization":{"data":{"id":"hashicorp","type":"organizations"}},
+			"workspaces":{"data":[{"id":"ws-1","type":"workspaces"},{"id":"ws-2","type":"workspaces"}]}}}}`,
+		"GET /api/v2/agent-pools/apool-1/agents": `{"data":[
+			{"id":"agent-1","type":"agents","attributes":{"status":"busy"}},
+			{"id":"agent-2","type":"agents","attributes":{"status":"busy"}},
+			{"id":"agent-3","type":"agents","attributes":{"status":"idle"}},
+			{"id":"agent-4","type":"agents","attributes":{"status":"errored"}}
+		]}`,
+		"GET /api/v2/organizations/hashicorp/runs/queue": `{"data":[
+			{"id":"run-1","type":"runs","attributes":{"status":"plan_queued"},"relationships":{"workspace":{"data":{"id":"ws-1","type":"workspaces"}}}},
+			{"id":"run-2","type":"runs","attributes":{"status":"plan_queued"},"relationships":{"workspace":{"data":{"id":"ws-2","type":"workspaces"}}}},
+			{"id":"run-3","type":"runs","attributes":{"status":"plan_queued"},"relationships":{"workspace":{"data":{"id":"ws-3","type":"workspaces"}}}}
+		]}`,
+	})
+	defer done()
+
+	u, err := client.AgentPools.ReadUtilization(context.Background(), "apool-1")
+	require.NoError(t, err)
+
+	assert.Equal(t, &AgentPoolUtilization{
+		AgentPoolID: "apool-1",
+		Total:       4,
+		Idle:        1,
+		Busy:        2,
+		Errored:     1,
+		QueueDepth:  2,
+	}, u)
+	assert.InDelta(t, 2.0/3.0, u.BusyRatio(), 0.001)
+}
+
 func TestAgentPoolsDelete(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -0,0 +1,156 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Analytics = (*analytics)(nil)
+
+// Analytics describes helpers that aggregate the history of resources into
+// metrics. They do not map to a single API endpoint and only perform read
+// requests.
+type Analytics interface {
+	// WorkspaceRunStats aggregates the runs of a workspace created within
+	// the given window. A window of 0 aggregates the full run history.
+	WorkspaceRunStats(ctx context.Context, workspaceID string, window time.Duration) (*WorkspaceRunStats, error)
+}
+
+// analytics implements Analytics.
+type analytics struct {
+	client *Client
+}
+
+// WorkspaceRunStats represents the aggregated run history of a workspace.
+type WorkspaceRunStats struct {
+	WorkspaceID string
+	// The creation time of the oldest run taken into account. It is zero
+	// when the full run history was aggregated.
+	Since time.Time
+
+	// The number of runs by outcome. Runs that have not reached a final
+	// status are counted as in progress.
+	Total      int
+	Succeeded  int
+	Failed     int
+	Discarded  int
+	Canceled   int
+	InProgress int
+
+	// The fraction of finished runs, between 0 and 1, that succeeded or
+	// failed. Discarded and canceled runs are not taken into account.
+	SuccessRate float64
+	FailureRate float64
+
+	// The average time spent planning and applying.
+	AveragePlanDuration  time.Duration
+	AverageApplyDuration time.Duration
+	// The average time between the creation of a run and the end of its
+	// apply, for the runs that were applied.
+	MeanTimeToApply time.Duration
+}
+
+// WorkspaceRunStats pages through the runs of a workspace, newest first, until
+// a run older than the window is found and aggregates them.
+func (s *analytics) WorkspaceRunStats(ctx context.Context, workspaceID string, window time.Duration) (*WorkspaceRunStats, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	if window < 0 {
+		return nil, ErrInvalidRunStatsWindow
+	}
+
+	stats := &WorkspaceRunStats{WorkspaceID: workspaceID}
+	if window > 0 {
+		stats.Since = time.Now().Add(-window)
+	}
+
+	var planTotal, applyTotal, timeToApplyTotal time.Duration
+	var planned, applied int
+
+	options := &RunListOptions{ListOptions: ListOptions{PageSize: 100}}
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		rl, err := s.client.Runs.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		done := false
+		for _, r := range rl.Items {
+			// Runs are listed newest first, so no further run is in the window.
+			if !stats.Since.IsZero() && r.CreatedAt.Before(stats.Since) {
+				done = true
+				break
+			}
+
+			stats.Total++
+			switch r.Status {
+			case RunApplied, RunPlannedAndFinished, RunPlannedAndSaved:
+				stats.Succeeded++
+			case RunErrored:
+				stats.Failed++
+			case RunDiscarded:
+				stats.Discarded++
+			case RunCanceled:
+				stats.Canceled++
+			default:
+				stats.InProgress++
+			}
+
+			ts := r.StatusTimestamps
+			if ts == nil {
+				continue
+			}
+			if d := planDuration(ts); d > 0 {
+				planTotal += d
+				planned++
+			}
+			if !ts.ApplyingAt.IsZero() && ts.AppliedAt.After(ts.ApplyingAt) {
+				applyTotal += ts.AppliedAt.Sub(ts.ApplyingAt)
+				timeToApplyTotal += ts.AppliedAt.Sub(r.CreatedAt)
+				applied++
+			}
+		}
+		if done {
+			return nil, nil
+		}
+		return rl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if finished := stats.Succeeded + stats.Failed; finished > 0 {
+		stats.SuccessRate = float64(stats.Succeeded) / float64(finished)
+		stats.FailureRate = float64(stats.Failed) / float64(finished)
+	}
+	if planned > 0 {
+		stats.AveragePlanDuration = planTotal / time.Duration(planned)
+	}
+	if applied > 0 {
+		stats.AverageApplyDuration = applyTotal / time.Duration(applied)
+		stats.MeanTimeToApply = timeToApplyTotal / time.Duration(applied)
+	}
+
+	return stats, nil
+}
+
+// planDuration returns the time a run spent planning, or 0 when the plan has
+// not finished.
+func planDuration(ts *RunStatusTimestamps) time.Duration {
+	if ts.PlanningAt.IsZero() {
+		return 0
+	}
+
+	for _, end := range []time.Time{ts.PlannedAt, ts.PlannedAndFinishedAt, ts.PlannedAndSavedAt} {
+		if end.After(ts.PlanningAt) {
+			return end.Sub(ts.PlanningAt)
+		}
+	}
+
+	return 0
+}
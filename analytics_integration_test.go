@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyticsWorkspaceRunStats(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	t.Cleanup(wTestCleanup)
+
+	t.Run("without any runs", func(t *testing.T) {
+		stats, err := client.Analytics.WorkspaceRunStats(ctx, wTest.ID, 24*time.Hour)
+		require.NoError(t, err)
+		assert.Equal(t, wTest.ID, stats.WorkspaceID)
+		assert.Equal(t, 0, stats.Total)
+		assert.Zero(t, stats.SuccessRate)
+	})
+
+	t.Run("with a negative window", func(t *testing.T) {
+		stats, err := client.Analytics.WorkspaceRunStats(ctx, wTest.ID, -time.Hour)
+		assert.Nil(t, stats)
+		assert.Equal(t, ErrInvalidRunStatsWindow, err)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		stats, err := client.Analytics.WorkspaceRunStats(ctx, badIdentifier, 0)
+		assert.Nil(t, stats)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestAnalyticsWorkspaceRunStats_Aggregate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	ts := func(d time.Duration) string {
+		return now.Add(d).Format(time.RFC3339)
+	}
+	run := func(id string, status RunStatus, created time.Duration, timestamps string) string {
+		return fmt.Sprintf(`{"id":%q,"type":"runs","attributes":{"status":%q,"created-at":%q,"status-timestamps":{%s}}}`,
+			id, status, ts(created), timestamps)
+	}
+
+	runs := []string{
+		// Applied: 2m plan, 3m apply, 10m from creation to applied.
+		run("run-1", RunApplied, -time.Hour, fmt.Sprintf(`"planning-at":%q,"planned-at":%q,"applying-at":%q,"applied-at":%q`,
+			ts(-time.Hour), ts(-58*time.Minute), ts(-53*time.Minute), ts(-50*time.Minute))),
+		// Plan only: 4m plan.
+		run("run-2", RunPlannedAndFinished, -2*time.Hour, fmt.Sprintf(`"planning-at":%q,"planned-and-finished-at":%q`,
+			ts(-2*time.Hour), ts(-116*time.Minute))),
+		run("run-3", RunErrored, -3*time.Hour, ""),
+		run("run-4", RunDiscarded, -4*time.Hour, ""),
+		run("run-5", RunCanceled, -5*time.Hour, ""),
+		// Outside of the window.
+		run("run-6", RunErrored, -48*time.Hour, ""),
+	}
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-123/runs": `{"data":[` + strings.Join(runs, ",") + `]}`,
+	})
+	defer done()
+
+	stats, err := client.Analytics.WorkspaceRunStats(context.Background(), "ws-123", 24*time.Hour)
+	require.NoError(t, err)
+
+	assert.Equal(t, 5, stats.Total)
+	assert.Equal(t, 2, stats.Succeeded)
+	assert.Equal(t, 1, stats.Failed)
+	assert.Equal(t, 1, stats.Discarded)
+	assert.Equal(t, 1, stats.Canceled)
+	assert.InDelta(t, 2.0/3.0, stats.SuccessRate, 0.001)
+	assert.InDelta(t, 1.0/3.0, stats.FailureRate, 0.001)
+	assert.Equal(t, 3*time.Minute, stats.AveragePlanDuration)
+	assert.Equal(t, 3*time.Minute, stats.AverageApplyDuration)
+	assert.Equal(t, 10*time.Minute, stats.MeanTimeToApply)
+
+	t.Run("over the full run history", func(t *testing.T) {
+		stats, err := client.Analytics.WorkspaceRunStats(context.Background(), "ws-123", 0)
+		require.NoError(t, err)
+		assert.True(t, stats.Since.IsZero())
+		assert.Equal(t, 6, stats.Total)
+		assert.Equal(t, 2, stats.Failed)
+	})
+}
@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/jsonapi"
+)
+
+// APIError is the error returned when the API responds with an error status
+// code that does not match one of the sentinel errors of this package, such as
+// ErrResourceNotFound, which are returned as is. Its message is built from the
+// error document of the response. Use errors.As to access the details of the
+// response:
+//
+//	var apiErr *tfe.APIError
+//	if errors.As(err, &apiErr) {
+//		log.Printf("request %s failed with status %d", apiErr.RequestID, apiErr.StatusCode)
+//	}
+type APIError struct {
+	// The HTTP status code of the response.
+	StatusCode int
+
+	// The errors of the JSON:API error document of the response, including
+	// their codes and the source of the error, if the response included one.
+	Errors []*jsonapi.ErrorObject
+
+	// The ID the API assigned to the request, as reported by the
+	// X-Request-Id response header, if any.
+	RequestID string
+
+	// The rate limit reported by the response headers, or nil if the
+	// response did not include them.
+	RateLimit *APIRateLimit
+
+	// How long the response asked to wait before retrying the request, as
+	// reported by its Retry-After header, or zero if it did not include one.
+	// Backoff.Wait waits at least that long.
+	RetryAfter time.Duration
+
+	err error
+}
+
+// APIRateLimit represents the rate limit reported by the API.
+type APIRateLimit struct {
+	// The number of requests allowed per second.
+	Limit float64
+	// The number of requests remaining in the current window.
+	Remaining float64
+	// The time until the rate limit is reset.
+	Reset time.Duration
+}
+
+// Error returns the message of the wrapped error.
+func (e *APIError) Error() string {
+	return e.err.Error()
+}
+
+// Unwrap returns the error holding the message of the response.
+func (e *APIError) Unwrap() error {
+	return e.err
+}
+
+// Codes returns the application-specific codes of the errors of the
+// response, in order and skipping the errors without a code.
+func (e *APIError) Codes() []string {
+	var codes []string
+	for _, obj := range e.Errors {
+		if obj != nil && obj.Code != "" {
+			codes = append(codes, obj.Code)
+		}
+	}
+	return codes
+}
+
+// isTransientError reports whether a request failed in a way that may not
+// recur when it is made again: the API was rate limiting or failed with a
+// server error, or the request did not reach it.
+func isTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode == http.StatusTooManyRequests || apiErr.StatusCode >= 500
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// parseRetryAfter returns the wait reported by the Retry-After header of a
+// response, given either in seconds or as an HTTP date, or zero if the header
+// is not set or not valid.
+func parseRetryAfter(h http.Header, now time.Time) time.Duration {
+	v := h.Get(_headerRetryAfter)
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+	if at, err := http.ParseTime(v); err == nil && at.After(now) {
+		return at.Sub(now)
+	}
+	return 0
+}
+
+// parseRateLimit returns the rate limit reported by the headers of a
+// response, or nil if none of the rate limit headers is set.
+func parseRateLimit(h http.Header) *APIRateLimit {
+	limit, remaining, reset := h.Get(_headerRateLimit), h.Get(_headerRateRemaining), h.Get(_headerRateReset)
+	if limit == "" && remaining == "" && reset == "" {
+		return nil
+	}
+
+	rl := &APIRateLimit{}
+	if v, err := strconv.ParseFloat(limit, 64); err == nil {
+		rl.Limit = v
+	}
+	if v, err := strconv.ParseFloat(remaining, 64); err == nil {
+		rl.Remaining = v
+	}
+	if v, err := strconv.ParseFloat(reset, 64); err == nil {
+		rl.Reset = time.Duration(v * float64(time.Second))
+	}
+
+	return rl
+}
@@ -47,6 +47,17 @@ const (
 	ApplyUnreachable ApplyStatus = "unreachable"
 )
 
+// IsTerminal reports whether an apply with the status has stopped, so its
+// status does not change anymore.
+func (s ApplyStatus) IsTerminal() bool {
+	switch s {
+	case ApplyCanceled, ApplyErrored, ApplyFinished, ApplyUnreachable:
+		return true
+	default:
+		return false
+	}
+}
+
 // Apply represents a Terraform Enterprise apply.
 type Apply struct {
 	ID                   string                 `jsonapi:"primary,applies"`
@@ -118,12 +129,7 @@ func (s *applies) Logs(ctx context.Context, applyID string) (io.Reader, error) {
 			return false, err
 		}
 
-		switch a.Status {
-		case ApplyCanceled, ApplyErrored, ApplyFinished, ApplyUnreachable:
-			return true, nil
-		default:
-			return false, nil
-		}
+		return a.Status.IsTerminal(), nil
 	}
 
 	return &LogReader{
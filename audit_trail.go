@@ -6,6 +6,7 @@ package tfe
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"io"
 	"net/http"
 	"time"
@@ -95,9 +96,14 @@ func (s *auditTrails) List(ctx context.Context, options *AuditTrailListOptions)
 		return nil, err
 	}
 
+	token, err := s.client.authToken(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API token: %w", err)
+	}
+
 	headers := make(http.Header)
 	headers.Set("User-Agent", _userAgent)
-	headers.Set("Authorization", "Bearer "+s.client.token)
+	headers.Set("Authorization", "Bearer "+token)
 	headers.Set("Content-Type", "application/json")
 
 	if options != nil {
@@ -0,0 +1,132 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ AuthenticationTokens = (*authenticationTokens)(nil)
+
+// AuthenticationTokens describes the authentication token related methods
+// that the HCP Terraform and Terraform Enterprise API supports across the
+// types of tokens of an organization, e.g. to audit stale tokens. Use
+// UserTokens, TeamTokens and OrganizationTokens to manage the tokens.
+type AuthenticationTokens interface {
+	// List the user, team, organization and audit trail tokens of an
+	// organization.
+	List(ctx context.Context, organization string, options *AuthenticationTokenListOptions) (*AuthenticationTokenList, error)
+}
+
+// authenticationTokens implements AuthenticationTokens.
+type authenticationTokens struct {
+	client *Client
+}
+
+// AuthenticationTokenType represents the type of an authentication token.
+type AuthenticationTokenType string
+
+// List of available authentication token types.
+const (
+	AuthenticationTokenUser         AuthenticationTokenType = "user"
+	AuthenticationTokenTeam         AuthenticationTokenType = "team"
+	AuthenticationTokenOrganization AuthenticationTokenType = "organization"
+	AuthenticationTokenAuditTrails  AuthenticationTokenType = "audit-trails"
+)
+
+// AuthenticationTokenList represents a list of authentication tokens.
+type AuthenticationTokenList struct {
+	*Pagination
+	Items []*AuthenticationToken
+}
+
+// AuthenticationToken represents an authentication token of an organization,
+// of any type. Its secret value is never returned.
+type AuthenticationToken struct {
+	ID          string                  `jsonapi:"primary,authentication-tokens"`
+	TokenType   AuthenticationTokenType `jsonapi:"attr,token-type"`
+	Description string                  `jsonapi:"attr,description"`
+	CreatedAt   time.Time               `jsonapi:"attr,created-at,iso8601"`
+	// The zero time if the token was never used.
+	LastUsedAt time.Time `jsonapi:"attr,last-used-at,iso8601"`
+	// The zero time if the token does not expire.
+	ExpiredAt time.Time        `jsonapi:"attr,expired-at,iso8601"`
+	CreatedBy *CreatedByChoice `jsonapi:"polyrelation,created-by"`
+
+	// Relations
+	Team *Team `jsonapi:"relation,team"`
+	User *User `jsonapi:"relation,user"`
+}
+
+// AuthenticationTokenListOptions represents the options for listing the
+// authentication tokens of an organization.
+type AuthenticationTokenListOptions struct {
+	ListOptions
+
+	// Optional: Only list the tokens of the given types.
+	TokenTypes []AuthenticationTokenType `url:"filter[token-type],omitempty"`
+
+	// Optional: Only list the tokens last used before the given time, or
+	// never used.
+	LastUsedBefore time.Time `url:"filter[last-used-before],omitempty,iso8601"`
+
+	// Optional: Only list the tokens of the given user ID.
+	User string `url:"filter[user],omitempty"`
+
+	// Optional: Only list the tokens of the given team ID.
+	Team string `url:"filter[team],omitempty"`
+}
+
+// List the user, team, organization and audit trail tokens of an
+// organization.
+func (s *authenticationTokens) List(ctx context.Context, organization string, options *AuthenticationTokenListOptions) (*AuthenticationTokenList, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("organizations/%s/authentication-tokens", url.PathEscape(organization))
+	req, err := s.client.NewRequest("GET", u, options)
+	if err != nil {
+		return nil, err
+	}
+
+	tl := &AuthenticationTokenList{}
+	err = req.Do(ctx, tl)
+	if err != nil {
+		return nil, err
+	}
+
+	return tl, nil
+}
+
+// Stale reports whether the token was not used since the given time, or was
+// never used.
+func (t *AuthenticationToken) Stale(since time.Time) bool {
+	return t.LastUsedAt.Before(since)
+}
+
+// Expired reports whether the token expired at the given time.
+func (t *AuthenticationToken) Expired(at time.Time) bool {
+	return !t.ExpiredAt.IsZero() && !t.ExpiredAt.After(at)
+}
+
+func (o *AuthenticationTokenListOptions) valid() error {
+	if o == nil {
+		return nil
+	}
+	if o.User != "" && !validStringID(&o.User) {
+		return ErrInvalidUserID
+	}
+	if o.Team != "" && !validStringID(&o.Team) {
+		return ErrInvalidTeamID
+	}
+	return nil
+}
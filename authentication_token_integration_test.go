@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAuthenticationTokensList(t *testing.T) {
+	t.Parallel()
+
+	var query url.Values
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/organizations/acme/authentication-tokens":
+			query = r.URL.Query()
+			_, _ = w.Write([]byte(`{
+				"data":[
+					{"id":"at-1","type":"authentication-tokens","attributes":{"token-type":"team","last-used-at":"2024-01-01T00:00:00Z"},
+						"relationships":{"team":{"data":{"id":"team-1","type":"teams"}}}},
+					{"id":"at-2","type":"authentication-tokens","attributes":{"token-type":"user","expired-at":"2024-03-01T00:00:00Z"},
+						"relationships":{"user":{"data":{"id":"user-1","type":"users"}}}}
+				],
+				"meta":{"pagination":{"current-page":1,"total-count":2}}
+			}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{
+		Address:    srv.URL,
+		Token:      "insert-your-token-here",
+		HTTPClient: srv.Client(),
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("with filters", func(t *testing.T) {
+		atl, err := client.AuthenticationTokens.List(ctx, "acme", &AuthenticationTokenListOptions{
+			TokenTypes:     []AuthenticationTokenType{AuthenticationTokenUser, AuthenticationTokenTeam},
+			LastUsedBefore: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "user,team", query.Get("filter[token-type]"))
+		assert.Equal(t, "2024-02-01T00:00:00Z", query.Get("filter[last-used-before]"))
+
+		require.Len(t, atl.Items, 2)
+		assert.Equal(t, AuthenticationTokenTeam, atl.Items[0].TokenType)
+		assert.Equal(t, "team-1", atl.Items[0].Team.ID)
+		assert.Equal(t, "user-1", atl.Items[1].User.ID)
+		assert.Equal(t, 2, atl.TotalCount)
+	})
+
+	t.Run("stale and expired tokens", func(t *testing.T) {
+		atl, err := client.AuthenticationTokens.List(ctx, "acme", nil)
+		require.NoError(t, err)
+		require.Len(t, atl.Items, 2)
+
+		now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+		since := now.AddDate(0, -3, 0)
+		assert.True(t, atl.Items[0].Stale(since))
+		assert.False(t, atl.Items[0].Stale(time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC)))
+		assert.False(t, atl.Items[0].Expired(now))
+
+		assert.True(t, atl.Items[1].Stale(since))
+		assert.True(t, atl.Items[1].Expired(now))
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		atl, err := client.AuthenticationTokens.List(ctx, badIdentifier, nil)
+		assert.Nil(t, atl)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+
+	t.Run("with an invalid user filter", func(t *testing.T) {
+		atl, err := client.AuthenticationTokens.List(ctx, "acme", &AuthenticationTokenListOptions{User: badIdentifier})
+		assert.Nil(t, atl)
+		assert.Equal(t, ErrInvalidUserID, err)
+	})
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"errors"
+	"math"
+	"time"
+)
+
+// Backoff configures how long the helpers of the client wait between their
+// attempts: the polling helpers between their reads of a resource, such as
+// Runs.CancelOrDiscardAndWait and TaskStages.AwaitCompletion, and the helpers
+// retrying a request that failed with a transient error, such as
+// Workspaces.ListAll. Set Config.PollingBackoff to override the waits of the
+// polling helpers.
+type Backoff struct {
+	// The wait before the first attempt. Waits double every five attempts.
+	Min time.Duration
+
+	// The longest wait. Defaults to Min.
+	Max time.Duration
+}
+
+// The default waits of the helpers of the client.
+var (
+	// pollBackoff is the default wait of the helpers polling runs, stacks
+	// and registry modules.
+	pollBackoff = Backoff{Min: 3 * time.Second, Max: 5 * time.Second}
+
+	// logBackoff is the default wait of LogReader for new log output.
+	logBackoff = Backoff{Min: 500 * time.Millisecond, Max: 2 * time.Second}
+
+	// retryBackoff is the default wait before a request that failed with a
+	// transient error is retried.
+	retryBackoff = Backoff{Min: 500 * time.Millisecond, Max: 2 * time.Second}
+)
+
+// Duration returns the wait before the given attempt, growing exponentially
+// from Min and capped at Max.
+func (b Backoff) Duration(attempt int) time.Duration {
+	maxWait := b.Max
+	if maxWait < b.Min {
+		maxWait = b.Min
+	}
+
+	wait := math.Pow(2, float64(attempt)/5) * float64(b.Min)
+	if wait > float64(maxWait) {
+		return maxWait
+	}
+	return time.Duration(wait)
+}
+
+// Wait blocks for the wait before the given attempt. When err, the error of
+// the previous attempt, is an *APIError whose response asked to retry later
+// with a Retry-After header, it waits at least that long.
+//
+// It returns the error of the context when the context is done before the
+// wait ends, and context.DeadlineExceeded right away when the deadline of
+// the context would pass before the wait ends, rather than waiting for it.
+func (b Backoff) Wait(ctx context.Context, attempt int, err error) error {
+	wait := b.Duration(attempt)
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > wait {
+		wait = apiErr.RetryAfter
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return context.DeadlineExceeded
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// pollingBackoff returns the Backoff of the polling helpers: the one set
+// with Config.PollingBackoff, or their default one.
+func (c *Client) pollingBackoff(defaultBackoff Backoff) Backoff {
+	if c == nil || c.polling == nil {
+		return defaultBackoff
+	}
+	return *c.polling
+}
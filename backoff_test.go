@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackoffDuration(t *testing.T) {
+	t.Parallel()
+
+	b := Backoff{Min: 500 * time.Millisecond, Max: 2 * time.Second}
+	assert.Equal(t, 500*time.Millisecond, b.Duration(0))
+	assert.Equal(t, time.Second, b.Duration(5))
+	assert.Equal(t, 2*time.Second, b.Duration(10))
+	assert.Equal(t, 2*time.Second, b.Duration(50))
+
+	// Without a Max, the wait is constant.
+	assert.Equal(t, time.Second, Backoff{Min: time.Second}.Duration(20))
+}
+
+func TestBackoffWait(t *testing.T) {
+	t.Parallel()
+
+	b := Backoff{Min: time.Millisecond}
+
+	t.Run("waits for the backoff", func(t *testing.T) {
+		assert.NoError(t, b.Wait(context.Background(), 0, nil))
+	})
+
+	t.Run("waits for Retry-After", func(t *testing.T) {
+		start := time.Now()
+		err := b.Wait(context.Background(), 0, &APIError{StatusCode: http.StatusServiceUnavailable, RetryAfter: 30 * time.Millisecond})
+		require.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 30*time.Millisecond)
+	})
+
+	t.Run("with a deadline before the end of the wait", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		start := time.Now()
+		err := Backoff{Min: time.Hour}.Wait(ctx, 0, nil)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("with a canceled context", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := Backoff{Min: time.Hour}.Wait(ctx, 0, nil)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	header := func(v string) http.Header {
+		return http.Header{"Retry-After": []string{v}}
+	}
+
+	assert.Equal(t, 120*time.Second, parseRetryAfter(header("120"), now))
+	assert.Equal(t, 90*time.Second, parseRetryAfter(header("Wed, 01 May 2024 12:01:30 GMT"), now))
+	assert.Zero(t, parseRetryAfter(header("Wed, 01 May 2024 11:00:00 GMT"), now))
+	assert.Zero(t, parseRetryAfter(header("-1"), now))
+	assert.Zero(t, parseRetryAfter(header("soon"), now))
+	assert.Zero(t, parseRetryAfter(http.Header{}, now))
+}
+
+func TestClient_PollingBackoff(t *testing.T) {
+	t.Parallel()
+
+	// The fake API reports a task stage as running for its first reads, and
+	// answers the first read with a 503 asking to retry later.
+	var mu sync.Mutex
+	reads := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/task-stages/ts-1":
+			reads++
+			status := TaskStageRunning
+			if reads > 3 {
+				status = TaskStagePassed
+			}
+			_, _ = w.Write([]byte(`{"data":{"id":"ts-1","type":"task-stages","attributes":{"status":"` + string(status) + `"}}}`))
+		case "/api/v2/runs/run-1":
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusServiceUnavailable)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{
+		Address:        srv.URL,
+		Token:          "insert-your-token-here",
+		HTTPClient:     srv.Client(),
+		PollingBackoff: &Backoff{Min: time.Millisecond},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("overrides the waits of the polling helpers", func(t *testing.T) {
+		start := time.Now()
+		ts, err := client.TaskStages.AwaitCompletion(ctx, "ts-1")
+		require.NoError(t, err)
+		assert.Equal(t, TaskStagePassed, ts.Status)
+		assert.Equal(t, 4, reads)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("surfaces Retry-After", func(t *testing.T) {
+		_, err := client.Runs.Read(ctx, "run-1")
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 7*time.Second, apiErr.RetryAfter)
+	})
+}
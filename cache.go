@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Cache stores the responses of the API for Config.ReadCache. Its methods
+// are called concurrently. Errors of the underlying storage are not reported:
+// a response that cannot be read from or written to the cache is read from the
+// API instead.
+type Cache interface {
+	// Get returns the value stored for the key, if any.
+	Get(key string) ([]byte, bool)
+
+	// Set stores the value for the key.
+	Set(key string, value []byte)
+}
+
+// ReadCacheConfig configures the caching of the responses of GET requests.
+//
+// A cached response is served without a request to the API while it is
+// fresh, as set by its TTL. Once stale, a response with an ETag is
+// revalidated with an If-None-Match request, which the API answers with a
+// bodyless 304 Not Modified when the resource has not changed. Responses are
+// cached per API token, and the cached responses of a type of resource are
+// dropped when a POST, PATCH or DELETE request to that type of resource
+// succeeds.
+//
+// Only the responses of the API of the client are cached: downloads, such as
+// state versions and plan exports, and redirected requests never are.
+type ReadCacheConfig struct {
+	// Required: The cache to store the responses in, such as a DiskCache.
+	Cache Cache
+
+	// Optional: How long responses are served without a request to the API.
+	// Zero revalidates every request.
+	TTL time.Duration
+
+	// Optional: The TTL of the responses of each type of resource, overriding
+	// TTL. The type of resource of a request is the last collection of its
+	// path, e.g. "workspaces" for both organizations/:org/workspaces and
+	// workspaces/:id, and "runs" for workspaces/:id/runs.
+	TTLs map[string]time.Duration
+
+	// Optional: The types of resource whose responses are cached, named as
+	// for TTLs. Defaults to organizations, projects, workspaces, runs, plans,
+	// applies, teams, agent-pools, policy-sets and registry-modules. Other
+	// types, such as state versions and variables, can hold sensitive values
+	// that would be stored unencrypted, so list them only if the cache is
+	// trusted with them.
+	ResourceTypes []string
+}
+
+// defaultReadCacheResourceTypes are the types of resource cached when
+// ReadCacheConfig.ResourceTypes is not set.
+var defaultReadCacheResourceTypes = []string{
+	"organizations",
+	"projects",
+	"workspaces",
+	"runs",
+	"plans",
+	"applies",
+	"teams",
+	"agent-pools",
+	"policy-sets",
+	"registry-modules",
+}
+
+// ContextWithoutCache returns a context that, when passed to a request, makes
+// it bypass the cache configured with Config.ReadCache: its response is read
+// from the API and not stored, e.g. to read a resource that must be current.
+func ContextWithoutCache(parentCtx context.Context) context.Context {
+	return context.WithValue(parentCtx, contextWithoutCacheKey, true)
+}
+
+// contextWithoutCacheKeyType is the type of the internal key used to mark a
+// context with ContextWithoutCache.
+type contextWithoutCacheKeyType struct{}
+
+// contextWithoutCacheKey is the internal key used to mark a context with
+// ContextWithoutCache.
+var contextWithoutCacheKey contextWithoutCacheKeyType
+
+// DiskCache is a Cache storing its values as files in a directory, so cached
+// responses are shared by the processes using the directory and survive
+// their restarts. The responses can contain sensitive data, so the directory
+// and its files are only accessible to their owner.
+type DiskCache struct {
+	dir string
+}
+
+// NewDiskCache returns a DiskCache storing its values in dir, which is
+// created if it does not exist.
+func NewDiskCache(dir string) (*DiskCache, error) {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create cache directory: %w", err)
+	}
+	return &DiskCache{dir: dir}, nil
+}
+
+// Get returns the value stored for the key, if any.
+func (c *DiskCache) Get(key string) ([]byte, bool) {
+	value, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return nil, false
+	}
+	return value, true
+}
+
+// Set stores the value for the key. The value is written to a temporary file
+// first and then renamed, so concurrent readers never see a partial value.
+func (c *DiskCache) Set(key string, value []byte) {
+	f, err := os.CreateTemp(c.dir, ".tmp-*")
+	if err != nil {
+		return
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.Write(value); err != nil {
+		f.Close()
+		return
+	}
+	if err := f.Close(); err != nil {
+		return
+	}
+	_ = os.Rename(f.Name(), c.path(key))
+}
+
+// path returns the path of the file of a key, named after its hash as keys
+// contain characters that are not valid in file names.
+func (c *DiskCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:]))
+}
+
+// cachedResponse is the cached form of the response of a GET request.
+type cachedResponse struct {
+	Header   http.Header `json:"header"`
+	Body     []byte      `json:"body"`
+	StoredAt time.Time   `json:"stored_at"`
+}
+
+// cachingTransport is the http.RoundTripper caching the responses of GET
+// requests, as configured with Config.ReadCache.
+type cachingTransport struct {
+	next    http.RoundTripper
+	config  ReadCacheConfig
+	baseURL *url.URL
+	now     func() time.Time
+}
+
+// RoundTrip serves a GET request from the cache when its cached response is
+// fresh, revalidates the cached response when it is stale, and otherwise
+// sends the request, caching its response. POST, PATCH and DELETE requests
+// are sent and drop the cached responses of their type of resource when they
+// succeed. Requests for other types of resource, outside of the API or
+// following a redirect are sent as is.
+func (t *cachingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if bypass, _ := req.Context().Value(contextWithoutCacheKey).(bool); bypass {
+		return t.next.RoundTrip(req)
+	}
+
+	path, ok := t.apiPath(req)
+	if !ok {
+		return t.next.RoundTrip(req)
+	}
+
+	switch req.Method {
+	case "GET":
+	case "POST", "PATCH", "DELETE":
+		resp, err := t.next.RoundTrip(req)
+		if err == nil && resp.StatusCode < 300 {
+			t.invalidate(modifiedResourceType(path))
+		}
+		return resp, err
+	default:
+		return t.next.RoundTrip(req)
+	}
+
+	typ := resourceType(path)
+	if !t.cached(typ) {
+		return t.next.RoundTrip(req)
+	}
+
+	key := t.cacheKey(req, typ)
+	ttl := t.ttl(typ)
+	cached := t.get(key)
+	if cached != nil && t.now().Sub(cached.StoredAt) < ttl {
+		return cached.response(req), nil
+	}
+
+	if etag := cachedETag(cached); etag != "" {
+		// Revalidate the cached response on a copy of the request, as a
+		// RoundTripper must not modify the request.
+		req = req.Clone(req.Context())
+		req.Header.Set("If-None-Match", etag)
+	}
+
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusNotModified && cached != nil:
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+
+		cached.StoredAt = t.now()
+		t.set(key, cached)
+		return cached.response(req), nil
+
+	case resp.StatusCode == http.StatusOK && (ttl > 0 || resp.Header.Get("ETag") != ""):
+		body, err := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		resp.Body = io.NopCloser(bytes.NewReader(body))
+
+		t.set(key, &cachedResponse{
+			Header:   resp.Header,
+			Body:     body,
+			StoredAt: t.now(),
+		})
+	}
+
+	return resp, nil
+}
+
+// apiPath returns the path of a request relative to the base path of the
+// API, and whether the request is a request to the API, rather than a
+// download or a request following a redirect.
+func (t *cachingTransport) apiPath(req *http.Request) (string, bool) {
+	if req.Response != nil || req.URL.Host != t.baseURL.Host || !strings.HasPrefix(req.URL.Path, t.baseURL.Path) {
+		return "", false
+	}
+	return strings.TrimPrefix(req.URL.Path, t.baseURL.Path), true
+}
+
+// cached reports whether the responses of a type of resource are cached.
+func (t *cachingTransport) cached(typ string) bool {
+	types := t.config.ResourceTypes
+	if types == nil {
+		types = defaultReadCacheResourceTypes
+	}
+	for _, cached := range types {
+		if cached == typ {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheKey identifies the responses that can be shared: the responses of the
+// same URL, in the same format, for the same API token, since the last change
+// to their type of resource. The token is hashed so it is not stored in the
+// cache.
+func (t *cachingTransport) cacheKey(req *http.Request, typ string) string {
+	token := sha256.Sum256([]byte(req.Header.Get("Authorization")))
+	generation, _ := t.config.Cache.Get(generationKey(typ))
+	return fmt.Sprintf("%s\n%s\n%s\n%s", req.URL.String(), req.Header.Get("Accept"), hex.EncodeToString(token[:]), generation)
+}
+
+// invalidate drops the cached responses of a type of resource by starting a
+// new generation of their cache keys.
+func (t *cachingTransport) invalidate(typ string) {
+	var generation [16]byte
+	if _, err := rand.Read(generation[:]); err != nil {
+		return
+	}
+	t.config.Cache.Set(generationKey(typ), []byte(hex.EncodeToString(generation[:])))
+}
+
+// generationKey is the key of the current generation of the cache keys of a
+// type of resource.
+func generationKey(typ string) string {
+	return "generation\n" + typ
+}
+
+// ttl returns the TTL of a type of resource.
+func (t *cachingTransport) ttl(typ string) time.Duration {
+	if ttl, ok := t.config.TTLs[typ]; ok {
+		return ttl
+	}
+	return t.config.TTL
+}
+
+func (t *cachingTransport) get(key string) *cachedResponse {
+	value, ok := t.config.Cache.Get(key)
+	if !ok {
+		return nil
+	}
+
+	cached := &cachedResponse{}
+	if err := json.Unmarshal(value, cached); err != nil {
+		return nil
+	}
+	return cached
+}
+
+func (t *cachingTransport) set(key string, cached *cachedResponse) {
+	value, err := json.Marshal(cached)
+	if err != nil {
+		return
+	}
+	t.config.Cache.Set(key, value)
+}
+
+// response returns the cached response as the response of the request.
+func (c *cachedResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.Body)),
+		ContentLength: int64(len(c.Body)),
+		Request:       req,
+	}
+}
+
+func cachedETag(cached *cachedResponse) string {
+	if cached == nil {
+		return ""
+	}
+	return cached.Header.Get("ETag")
+}
+
+// resourceType returns the type of resource of an API path relative to the
+// base path: its last collection. The segments of a path alternate between
+// collections and IDs, e.g. organizations/:org/workspaces.
+func resourceType(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments)%2 == 1 {
+		return segments[len(segments)-1]
+	}
+	return segments[len(segments)-2]
+}
+
+// modifiedResourceType returns the type of resource modified by a request to
+// an API path relative to the base path. Actions and relationships modify
+// the resource they belong to, e.g. workspaces for
+// workspaces/:id/actions/lock.
+func modifiedResourceType(path string) string {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if n := len(segments); n >= 4 && (segments[n-2] == "actions" || segments[n-2] == "relationships") {
+		segments = segments[:n-2]
+	}
+	return resourceType(strings.Join(segments, "/"))
+}
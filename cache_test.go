@@ -0,0 +1,252 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_ReadCache(t *testing.T) {
+	// The fake API serves a workspace and a run with ETags, answering
+	// matching If-None-Match requests with a 304.
+	var mu sync.Mutex
+	names := map[string]string{"ws-1": "network", "run-1": "pending"}
+	requests := make(map[string]int)
+	var notModified int
+	var revalidated bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		requests[r.Method+" "+r.URL.Path]++
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/workspaces/ws-1":
+			etag := `"` + names["ws-1"] + `"`
+			revalidated = r.Header.Get("If-None-Match") != ""
+			if r.Header.Get("If-None-Match") == etag {
+				notModified++
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			w.Header().Set("ETag", etag)
+			_, _ = w.Write([]byte(`{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"` + names["ws-1"] + `"}}}`))
+		case "PATCH /api/v2/workspaces/ws-1":
+			names["ws-1"] = "network-2"
+			_, _ = w.Write([]byte(`{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"network-2"}}}`))
+		case "GET /api/v2/runs/run-1":
+			_, _ = w.Write([]byte(`{"data":{"id":"run-1","type":"runs","attributes":{"status":"` + names["run-1"] + `"}}}`))
+		case "GET /api/v2/organizations/acme/projects":
+			_, _ = w.Write([]byte(`{"data":[{"id":"prj-1","type":"projects","attributes":{"name":"default"}}]}`))
+		case "POST /api/v2/organizations/acme/projects":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = w.Write([]byte(`{"data":{"id":"prj-2","type":"projects","attributes":{"name":"network"}}}`))
+		case "GET /api/v2/state-versions/sv-1":
+			_, _ = w.Write([]byte(`{"data":{"id":"sv-1","type":"state-versions","attributes":{"serial":1}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	cache, err := NewDiskCache(t.TempDir())
+	require.NoError(t, err)
+
+	client, err := NewClient(&Config{
+		Address:    srv.URL,
+		Token:      "insert-your-token-here",
+		HTTPClient: srv.Client(),
+		ReadCache: &ReadCacheConfig{
+			Cache: cache,
+			TTLs: map[string]time.Duration{
+				"runs":           time.Minute,
+				"projects":       time.Minute,
+				"state-versions": time.Minute,
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	client.http.HTTPClient.Transport.(*cachingTransport).now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+	ctx := context.Background()
+
+	t.Run("revalidates a response with an ETag", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			w, err := client.Workspaces.ReadByID(ctx, "ws-1")
+			require.NoError(t, err)
+			assert.Equal(t, "network", w.Name)
+		}
+		assert.Equal(t, 3, requests["GET /api/v2/workspaces/ws-1"])
+		assert.Equal(t, 2, notModified)
+	})
+
+	t.Run("drops the response of an updated resource", func(t *testing.T) {
+		_, err := client.Workspaces.UpdateByID(ctx, "ws-1", WorkspaceUpdateOptions{Name: String("network-2")})
+		require.NoError(t, err)
+
+		w, err := client.Workspaces.ReadByID(ctx, "ws-1")
+		require.NoError(t, err)
+		assert.Equal(t, "network-2", w.Name)
+		assert.False(t, revalidated)
+	})
+
+	t.Run("serves a fresh response without a request", func(t *testing.T) {
+		r, err := client.Runs.Read(ctx, "run-1")
+		require.NoError(t, err)
+		assert.Equal(t, RunPending, r.Status)
+
+		mu.Lock()
+		names["run-1"] = "planning"
+		mu.Unlock()
+
+		r, err = client.Runs.Read(ctx, "run-1")
+		require.NoError(t, err)
+		assert.Equal(t, RunPending, r.Status)
+		assert.Equal(t, 1, requests["GET /api/v2/runs/run-1"])
+	})
+
+	t.Run("bypasses the cache with the context", func(t *testing.T) {
+		r, err := client.Runs.Read(ContextWithoutCache(ctx), "run-1")
+		require.NoError(t, err)
+		assert.Equal(t, RunPlanning, r.Status)
+		assert.Equal(t, 2, requests["GET /api/v2/runs/run-1"])
+	})
+
+	t.Run("reads a stale response again", func(t *testing.T) {
+		mu.Lock()
+		now = now.Add(2 * time.Minute)
+		mu.Unlock()
+
+		r, err := client.Runs.Read(ctx, "run-1")
+		require.NoError(t, err)
+		assert.Equal(t, RunPlanning, r.Status)
+		assert.Equal(t, 3, requests["GET /api/v2/runs/run-1"])
+	})
+
+	t.Run("drops the responses of a created type of resource", func(t *testing.T) {
+		_, err := client.Projects.List(ctx, "acme", nil)
+		require.NoError(t, err)
+		_, err = client.Projects.List(ctx, "acme", nil)
+		require.NoError(t, err)
+		assert.Equal(t, 1, requests["GET /api/v2/organizations/acme/projects"])
+
+		_, err = client.Projects.Create(ctx, "acme", ProjectCreateOptions{Name: "network"})
+		require.NoError(t, err)
+
+		_, err = client.Projects.List(ctx, "acme", nil)
+		require.NoError(t, err)
+		assert.Equal(t, 2, requests["GET /api/v2/organizations/acme/projects"])
+	})
+
+	t.Run("does not cache other types of resource", func(t *testing.T) {
+		for i := 0; i < 2; i++ {
+			_, err := client.StateVersions.Read(ctx, "sv-1")
+			require.NoError(t, err)
+		}
+		assert.Equal(t, 2, requests["GET /api/v2/state-versions/sv-1"])
+	})
+
+	t.Run("without a cache", func(t *testing.T) {
+		_, err := NewClient(&Config{
+			Address:   srv.URL,
+			Token:     "insert-your-token-here",
+			ReadCache: &ReadCacheConfig{},
+		})
+		assert.Equal(t, ErrRequiredCache, err)
+	})
+}
+
+func TestClient_ReadCacheCoalesceReads(t *testing.T) {
+	var mu sync.Mutex
+	status := "pending"
+	var requests int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		requests++
+		_, _ = w.Write([]byte(`{"data":{"id":"run-1","type":"runs","attributes":{"status":"` + status + `"}}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	cache, err := NewDiskCache(t.TempDir())
+	require.NoError(t, err)
+
+	client, err := NewClient(&Config{
+		Address:       srv.URL,
+		Token:         "insert-your-token-here",
+		HTTPClient:    srv.Client(),
+		CoalesceReads: true,
+		ReadCache: &ReadCacheConfig{
+			Cache: cache,
+			TTLs:  map[string]time.Duration{"runs": time.Minute},
+		},
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	r, err := client.Runs.Read(ctx, "run-1")
+	require.NoError(t, err)
+	assert.Equal(t, RunPending, r.Status)
+
+	mu.Lock()
+	status = "planning"
+	mu.Unlock()
+
+	r, err = client.Runs.Read(ctx, "run-1")
+	require.NoError(t, err)
+	assert.Equal(t, RunPending, r.Status)
+	assert.Equal(t, 1, requests)
+
+	r, err = client.Runs.Read(ContextWithoutCache(ctx), "run-1")
+	require.NoError(t, err)
+	assert.Equal(t, RunPlanning, r.Status)
+	assert.Equal(t, 2, requests)
+
+	t.Run("does not share a response between cached and uncached reads", func(t *testing.T) {
+		req, err := client.NewRequest("GET", "runs/run-1", nil)
+		require.NoError(t, err)
+		assert.NotEqual(t, req.coalescingKey(ctx), req.coalescingKey(ContextWithoutCache(ctx)))
+	})
+}
+
+func TestResourceType(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "workspaces", resourceType("organizations/acme/workspaces"))
+	assert.Equal(t, "workspaces", resourceType("workspaces/ws-1"))
+	assert.Equal(t, "runs", resourceType("/workspaces/ws-1/runs"))
+}
+
+func TestModifiedResourceType(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "workspaces", modifiedResourceType("organizations/acme/workspaces"))
+	assert.Equal(t, "workspaces", modifiedResourceType("workspaces/ws-1/actions/lock"))
+	assert.Equal(t, "workspaces", modifiedResourceType("workspaces/ws-1/relationships/tags"))
+	assert.Equal(t, "runs", modifiedResourceType("runs/run-1/actions/apply"))
+}
@@ -0,0 +1,300 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Campaigns = (*campaigns)(nil)
+
+// Campaigns describes the methods to roll out a change to many workspaces of
+// an organization, built on the workspace and run endpoints of the API.
+type Campaigns interface {
+	// UpgradeTerraformVersion upgrades the Terraform version of the
+	// workspaces of an organization in waves, halting after a wave in which
+	// a workspace failed to upgrade.
+	UpgradeTerraformVersion(ctx context.Context, organization string, options CampaignOptions) (*CampaignResult, error)
+}
+
+// campaigns implements Campaigns.
+type campaigns struct {
+	client *Client
+}
+
+// CampaignWorkspaceStatus represents the status of a workspace in a campaign.
+type CampaignWorkspaceStatus string
+
+// List all available campaign workspace statuses.
+const (
+	CampaignWorkspacePending  CampaignWorkspaceStatus = "pending"
+	CampaignWorkspaceSkipped  CampaignWorkspaceStatus = "skipped"
+	CampaignWorkspaceUpgraded CampaignWorkspaceStatus = "upgraded"
+	CampaignWorkspaceFailed   CampaignWorkspaceStatus = "failed"
+)
+
+// CampaignOptions represents the options for upgrading the Terraform version
+// of the workspaces of an organization.
+type CampaignOptions struct {
+	// Required: The Terraform version to upgrade the workspaces to.
+	TargetVersion string
+
+	// Optional: The workspaces to upgrade. Defaults to all the workspaces of
+	// the organization.
+	Filter *WorkspaceFilter
+
+	// Optional: The number of workspaces upgraded concurrently in a wave.
+	// Defaults to 10.
+	BatchSize int
+
+	// Optional: Only upgrade a workspace once a speculative plan of its
+	// current configuration version with the target version has finished
+	// successfully.
+	RequireSuccessfulPlan bool
+
+	// Optional: The interval at which the status of a speculative plan is
+	// polled. Defaults to a backoff between 3 and 5 seconds, or to
+	// Config.PollingBackoff.
+	PollInterval time.Duration
+
+	// Optional: A function called each time the upgrade of a workspace has
+	// settled. Calls are never concurrent.
+	OnProgress func(CampaignProgress)
+}
+
+// CampaignProgress represents the progress of a campaign, reported each time
+// the upgrade of a workspace has settled.
+type CampaignProgress struct {
+	// The wave of the workspace, starting at 1, or 0 for a skipped workspace.
+	Wave int
+
+	// The number of waves of the campaign.
+	Waves int
+
+	// The number of workspaces settled so far, and the number of workspaces
+	// of the campaign.
+	Completed int
+	Total     int
+
+	// The outcome for the workspace.
+	Result *CampaignWorkspaceResult
+}
+
+// CampaignResult represents the outcome of a campaign.
+type CampaignResult struct {
+	// The outcome for each workspace, in the order the workspaces were
+	// listed. Workspaces not reached because the campaign halted are pending.
+	Workspaces []*CampaignWorkspaceResult
+
+	// Halted is true if the campaign stopped after a wave in which a
+	// workspace failed to upgrade.
+	Halted bool
+}
+
+// CampaignWorkspaceResult represents the outcome of a campaign for a single
+// workspace.
+type CampaignWorkspaceResult struct {
+	// The workspace, as updated when it was upgraded.
+	Workspace *Workspace
+
+	// The Terraform version of the workspace before the campaign.
+	PreviousVersion string
+
+	Status CampaignWorkspaceStatus
+
+	// The speculative plan of the workspace, if one was queued.
+	Run *Run
+
+	// Err is the error returned while upgrading the workspace, if any.
+	Err error
+}
+
+// Failed returns the outcomes of the workspaces that failed to upgrade.
+func (r *CampaignResult) Failed() []*CampaignWorkspaceResult {
+	var failed []*CampaignWorkspaceResult
+	for _, w := range r.Workspaces {
+		if w.Status == CampaignWorkspaceFailed {
+			failed = append(failed, w)
+		}
+	}
+	return failed
+}
+
+// UpgradeTerraformVersion lists the workspaces of the organization matching
+// options.Filter and sets their Terraform version to options.TargetVersion.
+// Workspaces already on the target version are skipped. The others are
+// upgraded in waves of options.BatchSize workspaces, the workspaces of a wave
+// being upgraded concurrently.
+//
+// When options.RequireSuccessfulPlan is set, a speculative plan of the
+// current configuration version of each workspace is queued with the target
+// version first, and the workspace is only upgraded once that plan has
+// finished successfully. A workspace whose plan failed keeps its version.
+//
+// If a workspace of a wave fails to upgrade, the campaign halts once the
+// wave has settled, leaving the workspaces of the following waves pending.
+// The outcome for every workspace is returned in the result, and the error is
+// only set if the workspaces could not be listed or ctx is done.
+func (s *campaigns) UpgradeTerraformVersion(ctx context.Context, organization string, options CampaignOptions) (*CampaignResult, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	workspaces, err := listWorkspacesByFilter(ctx, s.client, organization, options.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	batchSize := options.BatchSize
+	if batchSize == 0 {
+		batchSize = 10
+	}
+
+	result := &CampaignResult{}
+	var pending []*CampaignWorkspaceResult
+	for _, ws := range workspaces {
+		w := &CampaignWorkspaceResult{
+			Workspace:       ws,
+			PreviousVersion: ws.TerraformVersion,
+			Status:          CampaignWorkspacePending,
+		}
+		if ws.TerraformVersion == options.TargetVersion {
+			w.Status = CampaignWorkspaceSkipped
+		} else {
+			pending = append(pending, w)
+		}
+		result.Workspaces = append(result.Workspaces, w)
+	}
+
+	waves := (len(pending) + batchSize - 1) / batchSize
+
+	var mu sync.Mutex
+	completed := 0
+	report := func(wave int, w *CampaignWorkspaceResult) {
+		mu.Lock()
+		defer mu.Unlock()
+		completed++
+		if options.OnProgress != nil {
+			options.OnProgress(CampaignProgress{
+				Wave:      wave,
+				Waves:     waves,
+				Completed: completed,
+				Total:     len(result.Workspaces),
+				Result:    w,
+			})
+		}
+	}
+
+	for _, w := range result.Workspaces {
+		if w.Status == CampaignWorkspaceSkipped {
+			report(0, w)
+		}
+	}
+
+	for wave := 1; len(pending) > 0; wave++ {
+		batch := pending
+		if len(batch) > batchSize {
+			batch = batch[:batchSize]
+		}
+		pending = pending[len(batch):]
+
+		forEachConcurrently(len(batch), batchSize, func(i int) {
+			s.upgrade(ctx, batch[i], options)
+			report(wave, batch[i])
+		})
+
+		if err := ctx.Err(); err != nil {
+			return result, err
+		}
+
+		for _, w := range batch {
+			if w.Status == CampaignWorkspaceFailed {
+				result.Halted = true
+				return result, nil
+			}
+		}
+	}
+
+	return result, nil
+}
+
+// upgrade upgrades the Terraform version of a single workspace, after a
+// successful speculative plan if required, and records the outcome.
+func (s *campaigns) upgrade(ctx context.Context, w *CampaignWorkspaceResult, options CampaignOptions) {
+	w.Status = CampaignWorkspaceFailed
+
+	if options.RequireSuccessfulPlan {
+		w.Run, w.Err = s.plan(ctx, w.Workspace, options)
+		if w.Err != nil {
+			return
+		}
+		if w.Run.Status != RunPlannedAndFinished {
+			w.Err = fmt.Errorf("%w: run %s is %s", ErrCampaignPlanFailed, w.Run.ID, w.Run.Status)
+			return
+		}
+	}
+
+	ws, err := s.client.Workspaces.UpdateByID(ctx, w.Workspace.ID, WorkspaceUpdateOptions{
+		TerraformVersion: String(options.TargetVersion),
+	})
+	if err != nil {
+		w.Err = err
+		return
+	}
+
+	w.Workspace = ws
+	w.Status = CampaignWorkspaceUpgraded
+}
+
+// plan queues a speculative plan of a workspace with the target version and
+// waits until it has stopped.
+func (s *campaigns) plan(ctx context.Context, ws *Workspace, options CampaignOptions) (*Run, error) {
+	r, err := s.client.Runs.Create(ctx, RunCreateOptions{
+		Workspace:        ws,
+		PlanOnly:         Bool(true),
+		TerraformVersion: String(options.TargetVersion),
+		Message:          String(fmt.Sprintf("Speculative plan for the upgrade to Terraform %s", options.TargetVersion)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for reads := 0; !r.Status.IsTerminal(); reads++ {
+		b := s.client.pollingBackoff(pollBackoff)
+		if options.PollInterval > 0 {
+			b = Backoff{Min: options.PollInterval}
+		}
+		if err := b.Wait(ctx, reads, nil); err != nil {
+			return r, err
+		}
+
+		if r, err = s.client.Runs.Read(ContextWithoutCache(ctx), r.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	return r, nil
+}
+
+func (o CampaignOptions) valid() error {
+	if !validString(&o.TargetVersion) {
+		return ErrRequiredTargetVersion
+	}
+	if o.Filter != nil {
+		if err := o.Filter.valid(); err != nil {
+			return err
+		}
+	}
+	if o.BatchSize < 0 {
+		return ErrInvalidBatchSize
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newCampaignTestClient returns a client connected to a fake API server
+// serving four workspaces. ws-2 is already on Terraform 1.9.0, and the
+// speculative plans of ws-3 error. It returns the workspaces updated so far.
+func newCampaignTestClient(t *testing.T) (*Client, func() []string) {
+	t.Helper()
+
+	var mu sync.Mutex
+	var updated []string
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		var body struct {
+			Data struct {
+				Attributes    map[string]interface{} `json:"attributes"`
+				Relationships map[string]struct {
+					Data struct {
+						ID string `json:"id"`
+					} `json:"data"`
+				} `json:"relationships"`
+			} `json:"data"`
+		}
+		if r.Body != nil {
+			b, _ := io.ReadAll(r.Body)
+			_ = json.Unmarshal(b, &body)
+		}
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		path := strings.TrimPrefix(r.URL.Path, "/api/v2/")
+		switch {
+		case path == "ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && path == "organizations/acme/workspaces":
+			fmt.Fprint(w, `{"data":[
+				{"id":"ws-1","type":"workspaces","attributes":{"name":"one","terraform-version":"1.8.0"}},
+				{"id":"ws-2","type":"workspaces","attributes":{"name":"two","terraform-version":"1.9.0"}},
+				{"id":"ws-3","type":"workspaces","attributes":{"name":"three","terraform-version":"1.8.0"}},
+				{"id":"ws-4","type":"workspaces","attributes":{"name":"four","terraform-version":"1.7.5"}}
+			]}`)
+		case r.Method == "POST" && path == "runs":
+			assert.Equal(t, true, body.Data.Attributes["plan-only"])
+			assert.Equal(t, "1.9.0", body.Data.Attributes["terraform-version"])
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data":{"id":"run-%s","type":"runs","attributes":{"status":"pending"}}}`, body.Data.Relationships["workspace"].Data.ID)
+		case r.Method == "GET" && strings.HasPrefix(path, "runs/"):
+			status := RunPlannedAndFinished
+			if path == "runs/run-ws-3" {
+				status = RunErrored
+			}
+			fmt.Fprintf(w, `{"data":{"id":"%s","type":"runs","attributes":{"status":"%s"}}}`, strings.TrimPrefix(path, "runs/"), status)
+		case r.Method == "PATCH" && strings.HasPrefix(path, "workspaces/"):
+			id := strings.TrimPrefix(path, "workspaces/")
+			updated = append(updated, id)
+			fmt.Fprintf(w, `{"data":{"id":"%s","type":"workspaces","attributes":{"terraform-version":"%s"}}}`, id, body.Data.Attributes["terraform-version"])
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{
+		Address:    srv.URL,
+		Token:      "insert-your-token-here",
+		HTTPClient: srv.Client(),
+	})
+	require.NoError(t, err)
+
+	return client, func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]string(nil), updated...)
+	}
+}
+
+func TestCampaignsUpgradeTerraformVersion(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("halts after a failed plan", func(t *testing.T) {
+		client, updated := newCampaignTestClient(t)
+
+		var progress []CampaignProgress
+		result, err := client.Campaigns.UpgradeTerraformVersion(ctx, "acme", CampaignOptions{
+			TargetVersion:         "1.9.0",
+			BatchSize:             1,
+			RequireSuccessfulPlan: true,
+			PollInterval:          time.Millisecond,
+			OnProgress: func(p CampaignProgress) {
+				progress = append(progress, p)
+			},
+		})
+		require.NoError(t, err)
+		assert.True(t, result.Halted)
+		assert.Equal(t, []string{"ws-1"}, updated())
+
+		require.Len(t, result.Workspaces, 4)
+		assert.Equal(t, CampaignWorkspaceUpgraded, result.Workspaces[0].Status)
+		assert.Equal(t, "1.8.0", result.Workspaces[0].PreviousVersion)
+		assert.Equal(t, "1.9.0", result.Workspaces[0].Workspace.TerraformVersion)
+		assert.Equal(t, "run-ws-1", result.Workspaces[0].Run.ID)
+		assert.Equal(t, CampaignWorkspaceSkipped, result.Workspaces[1].Status)
+		assert.Equal(t, CampaignWorkspaceFailed, result.Workspaces[2].Status)
+		assert.ErrorIs(t, result.Workspaces[2].Err, ErrCampaignPlanFailed)
+		assert.Equal(t, CampaignWorkspacePending, result.Workspaces[3].Status)
+
+		failed := result.Failed()
+		require.Len(t, failed, 1)
+		assert.Equal(t, "ws-3", failed[0].Workspace.ID)
+
+		require.Len(t, progress, 3)
+		assert.Equal(t, 0, progress[0].Wave)
+		assert.Equal(t, "ws-2", progress[0].Result.Workspace.ID)
+		assert.Equal(t, 1, progress[1].Wave)
+		assert.Equal(t, 3, progress[1].Waves)
+		assert.Equal(t, 2, progress[2].Wave)
+		assert.Equal(t, 3, progress[2].Completed)
+		assert.Equal(t, 4, progress[2].Total)
+	})
+
+	t.Run("without plans", func(t *testing.T) {
+		client, updated := newCampaignTestClient(t)
+
+		result, err := client.Campaigns.UpgradeTerraformVersion(ctx, "acme", CampaignOptions{
+			TargetVersion: "1.9.0",
+		})
+		require.NoError(t, err)
+		assert.False(t, result.Halted)
+		assert.Empty(t, result.Failed())
+		assert.ElementsMatch(t, []string{"ws-1", "ws-3", "ws-4"}, updated())
+
+		for _, w := range result.Workspaces {
+			assert.Nil(t, w.Run)
+			assert.Equal(t, "1.9.0", w.Workspace.TerraformVersion)
+		}
+	})
+
+	t.Run("with invalid options", func(t *testing.T) {
+		client, _ := newCampaignTestClient(t)
+
+		_, err := client.Campaigns.UpgradeTerraformVersion(ctx, "acme", CampaignOptions{})
+		assert.Equal(t, ErrRequiredTargetVersion, err)
+
+		_, err = client.Campaigns.UpgradeTerraformVersion(ctx, "acme", CampaignOptions{TargetVersion: "1.9.0", BatchSize: -1})
+		assert.Equal(t, ErrInvalidBatchSize, err)
+
+		_, err = client.Campaigns.UpgradeTerraformVersion(ctx, "acme", CampaignOptions{TargetVersion: "1.9.0", Filter: &WorkspaceFilter{}})
+		assert.Equal(t, ErrRequiredWorkspaceFilter, err)
+
+		_, err = client.Campaigns.UpgradeTerraformVersion(ctx, badIdentifier, CampaignOptions{TargetVersion: "1.9.0"})
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
@@ -43,6 +43,9 @@ type CommentList struct {
 type Comment struct {
 	ID   string `jsonapi:"primary,comments"`
 	Body string `jsonapi:"attr,body"`
+
+	// Relations
+	RunEvent *RunEvent `jsonapi:"relation,run-event"`
 }
 
 type CommentCreateOptions struct {
@@ -64,6 +64,47 @@ func TestCommentsList(t *testing.T) {
 	})
 }
 
+func TestCommentsRead(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	defer wTestCleanup()
+
+	rTest, rTestCleanup := createRun(t, client, wTest)
+	defer rTestCleanup()
+
+	cTest, err := client.Comments.Create(ctx, rTest.ID, CommentCreateOptions{
+		Body: "Approved via ChatOps",
+	})
+	require.NoError(t, err)
+
+	t.Run("when the comment exists", func(t *testing.T) {
+		c, err := client.Comments.Read(ctx, cTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, cTest.ID, c.ID)
+		assert.Equal(t, cTest.Body, c.Body)
+
+		require.NotNil(t, c.RunEvent)
+		assert.NotEmpty(t, c.RunEvent.ID)
+	})
+
+	t.Run("when the comment does not exist", func(t *testing.T) {
+		c, err := client.Comments.Read(ctx, "nonexisting")
+		assert.Nil(t, c)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a valid comment ID", func(t *testing.T) {
+		c, err := client.Comments.Read(ctx, badIdentifier)
+		assert.Nil(t, c)
+		assert.EqualError(t, err, ErrInvalidCommentID.Error())
+	})
+}
+
 func commentItemsContainsBody(items []*Comment, body string) bool {
 	hasBody := false
 	for _, item := range items {
@@ -4,11 +4,15 @@
 package tfe
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
+	"strings"
 	"time"
 )
 
@@ -41,6 +45,14 @@ type ConfigurationVersions interface {
 	// ReadWithOptions reads a configuration version by its ID using the options supplied
 	ReadWithOptions(ctx context.Context, cvID string, options *ConfigurationVersionReadOptions) (*ConfigurationVersion, error)
 
+	// ReadIngressAttributes reads the commit information of a configuration
+	// version sourced from VCS.
+	ReadIngressAttributes(ctx context.Context, cvID string) (*IngressAttributes, error)
+
+	// ReadReadme reads the README of a configuration version from its
+	// archive, along with its metadata.
+	ReadReadme(ctx context.Context, cvID string) (*ConfigurationReadme, error)
+
 	// Upload packages and uploads Terraform configuration files. It requires
 	// the upload URL from a configuration version and the full path to the
 	// configuration files on disk.
@@ -49,6 +61,11 @@ type ConfigurationVersions interface {
 	// Upload a tar gzip archive to the specified configuration version upload URL.
 	UploadTarGzip(ctx context.Context, url string, archive io.Reader) error
 
+	// UploadTarGzipWithOptions uploads a tar gzip archive like UploadTarGzip,
+	// attempting the upload again when it fails with a transient error and
+	// verifying the archive against a checksum first.
+	UploadTarGzipWithOptions(ctx context.Context, url string, archive io.ReadSeeker, options UploadOptions) error
+
 	// Archive a configuration version. This can only be done on configuration versions that
 	// were created with the API or CLI, are in an uploaded state, and have no runs in progress.
 	Archive(ctx context.Context, cvID string) error
@@ -56,6 +73,14 @@ type ConfigurationVersions interface {
 	// Download a configuration version.  Only configuration versions in the uploaded state may be downloaded.
 	Download(ctx context.Context, cvID string) ([]byte, error)
 
+	// DownloadTo streams the archive of a configuration version to dst. Only
+	// configuration versions in the uploaded state may be downloaded.
+	DownloadTo(ctx context.Context, cvID string, dst io.Writer) error
+
+	// DownloadCurrent streams the archive of the current configuration
+	// version of a workspace to dst and returns the configuration version.
+	DownloadCurrent(ctx context.Context, workspaceID string, dst io.Writer) (*ConfigurationVersion, error)
+
 	// SoftDeleteBackingData soft deletes the configuration version's backing data
 	// **Note: This functionality is only available in Terraform Enterprise.**
 	SoftDeleteBackingData(ctx context.Context, svID string) error
@@ -74,6 +99,52 @@ type configurationVersions struct {
 	client *Client
 }
 
+// ConfigurationVersionPackPreview describes the archive Upload would create
+// from a directory of configuration files.
+type ConfigurationVersionPackPreview struct {
+	// The files included in the archive, in the order they are packed.
+	Files []*ConfigurationVersionPackFile
+	// The total size of the included files in bytes, before compression.
+	Size int64
+	// The size of the compressed archive that would be uploaded, in bytes.
+	CompressedSize int64
+}
+
+// ConfigurationVersionPackFile represents a file included in a configuration
+// version archive.
+type ConfigurationVersionPackFile struct {
+	// The path of the file, relative to the packed directory.
+	Path string
+	// The size of the file in bytes. Symlinks have a size of 0.
+	Size int64
+}
+
+// ReadmeFormat represents the format of a README.
+type ReadmeFormat string
+
+// List of available README formats.
+const (
+	ReadmeMarkdown ReadmeFormat = "markdown"
+)
+
+// ConfigurationReadme represents the README of a configuration version, the
+// README.md at the root of its configuration, which is the README displayed
+// for the workspace of its latest run.
+type ConfigurationReadme struct {
+	// The ID of the configuration version the README was read from.
+	ConfigurationVersionID string
+
+	// The path of the README in the configuration, e.g. "README.md".
+	Path string
+
+	Format      ReadmeFormat
+	RawMarkdown string
+
+	// When the README was last modified, as recorded in the archive of the
+	// configuration version.
+	UpdatedAt time.Time
+}
+
 // ConfigurationStatus represents a configuration version status.
 type ConfigurationStatus string
 
@@ -148,6 +219,9 @@ type ConfigurationVersionReadOptions struct {
 	// Optional: A list of relations to include. See available resources:
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/configuration-versions#available-related-resources
 	Include []ConfigVerIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // ConfigurationVersionListOptions represents the options for listing
@@ -157,6 +231,9 @@ type ConfigurationVersionListOptions struct {
 	// Optional: A list of relations to include. See available resources:
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/configuration-versions#available-related-resources
 	Include []ConfigVerIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // ConfigurationVersionCreateOptions represents the options for creating a
@@ -201,6 +278,9 @@ type IngressAttributes struct {
 	SenderAvatarURL   string `jsonapi:"attr,sender-avatar-url"`
 	SenderHTMLURL     string `jsonapi:"attr,sender-html-url"`
 
+	// Relations
+	CreatedBy *User `jsonapi:"relation,created-by,omitempty"`
+
 	// Links
 	Links map[string]interface{} `jsonapi:"links,omitempty"`
 }
@@ -300,6 +380,92 @@ func (s *configurationVersions) ReadWithOptions(ctx context.Context, cvID string
 	return cv, nil
 }
 
+// ReadIngressAttributes reads the commit information of a configuration
+// version sourced from VCS, such as its commit SHA, branch, sender and
+// compare URL. The same information can be read along with the configuration
+// version by including ConfigVerIngressAttributes with ReadWithOptions. It
+// returns ErrResourceNotFound for a configuration version not sourced from
+// VCS.
+func (s *configurationVersions) ReadIngressAttributes(ctx context.Context, cvID string) (*IngressAttributes, error) {
+	if !validStringID(&cvID) {
+		return nil, ErrInvalidConfigVersionID
+	}
+
+	u := fmt.Sprintf("configuration-versions/%s/ingress-attributes", url.PathEscape(cvID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	ia := &IngressAttributes{}
+	err = req.Do(ctx, ia)
+	if err != nil {
+		return nil, err
+	}
+
+	return ia, nil
+}
+
+// ReadReadme reads the README of a configuration version from its archive,
+// which is streamed until the README is found. Only configuration versions in
+// the uploaded state can be read. It returns ErrResourceNotFound for a
+// configuration without a README.
+//
+// The README cannot be written through the API: to update it, upload a new
+// configuration version with the updated README.md.
+func (s *configurationVersions) ReadReadme(ctx context.Context, cvID string) (*ConfigurationReadme, error) {
+	if !validStringID(&cvID) {
+		return nil, ErrInvalidConfigVersionID
+	}
+
+	u := fmt.Sprintf("configuration-versions/%s/download", url.PathEscape(cvID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	gzipR, err := gzip.NewReader(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the configuration version archive: %w", err)
+	}
+	defer gzipR.Close()
+
+	tarR := tar.NewReader(gzipR)
+	for {
+		header, err := tarR.Next()
+		if errors.Is(err, io.EOF) {
+			return nil, ErrResourceNotFound
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the configuration version archive: %w", err)
+		}
+
+		name := strings.TrimPrefix(header.Name, "./")
+		if header.Typeflag != tar.TypeReg || !strings.EqualFold(name, "README.md") {
+			continue
+		}
+
+		content, err := io.ReadAll(tarR)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the configuration version archive: %w", err)
+		}
+
+		return &ConfigurationReadme{
+			ConfigurationVersionID: cvID,
+			Path:                   name,
+			Format:                 ReadmeMarkdown,
+			RawMarkdown:            string(content),
+			UpdatedAt:              header.ModTime.UTC(),
+		}, nil
+	}
+}
+
 // Upload packages and uploads Terraform configuration files. It requires the
 // upload URL from a configuration version and the path to the configuration
 // files on disk.
@@ -312,6 +478,50 @@ func (s *configurationVersions) Upload(ctx context.Context, uploadURL, path stri
 	return s.UploadTarGzip(ctx, uploadURL, body)
 }
 
+// PreviewConfigurationPack packages the configuration files at the given path
+// with the same packer used by ConfigurationVersions.Upload and lists the
+// contents of the resulting archive, without uploading it. Files excluded by
+// .terraformignore are not listed.
+func PreviewConfigurationPack(path string) (*ConfigurationVersionPackPreview, error) {
+	body, err := packContents(path)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := &ConfigurationVersionPackPreview{
+		CompressedSize: int64(body.Len()),
+	}
+
+	gzipR, err := gzip.NewReader(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the packed archive: %w", err)
+	}
+	defer gzipR.Close()
+
+	tarR := tar.NewReader(gzipR)
+	for {
+		header, err := tarR.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to read the packed archive: %w", err)
+		}
+
+		if header.Typeflag == tar.TypeDir {
+			continue
+		}
+
+		preview.Files = append(preview.Files, &ConfigurationVersionPackFile{
+			Path: header.Name,
+			Size: header.Size,
+		})
+		preview.Size += header.Size
+	}
+
+	return preview, nil
+}
+
 // UploadTarGzip is used to upload Terraform configuration files contained a tar gzip archive.
 // Any stream implementing io.Reader can be passed into this method. This method is also
 // particularly useful for tar streams created by non-default go-slug configurations.
@@ -322,6 +532,13 @@ func (s *configurationVersions) UploadTarGzip(ctx context.Context, uploadURL str
 	return s.client.doForeignPUTRequest(ctx, uploadURL, archive)
 }
 
+// UploadTarGzipWithOptions uploads a tar gzip archive like UploadTarGzip. The
+// archive is read from its start for each attempt, so it is not held in
+// memory, which makes this method suited to large archives read from a file.
+func (s *configurationVersions) UploadTarGzipWithOptions(ctx context.Context, uploadURL string, archive io.ReadSeeker, options UploadOptions) error {
+	return s.client.doForeignPUTRequestWithOptions(ctx, uploadURL, archive, options)
+}
+
 // Archive a configuration version. This can only be done on configuration versions that
 // were created with the API or CLI, are in an uploaded state, and have no runs in progress.
 func (s *configurationVersions) Archive(ctx context.Context, cvID string) error {
@@ -350,23 +567,63 @@ func (o *ConfigurationVersionListOptions) valid() error {
 
 // Download a configuration version.  Only configuration versions in the uploaded state may be downloaded.
 func (s *configurationVersions) Download(ctx context.Context, cvID string) ([]byte, error) {
+	var buf bytes.Buffer
+	err := s.DownloadTo(ctx, cvID, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DownloadTo streams the archive of a configuration version, a gzipped tarball
+// of the uploaded configuration, to dst without buffering it in memory.
+func (s *configurationVersions) DownloadTo(ctx context.Context, cvID string, dst io.Writer) error {
 	if !validStringID(&cvID) {
-		return nil, ErrInvalidConfigVersionID
+		return ErrInvalidConfigVersionID
+	}
+	if dst == nil {
+		return ErrRequiredWriter
 	}
 
 	u := fmt.Sprintf("configuration-versions/%s/download", url.PathEscape(cvID))
 	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, dst)
+}
+
+// DownloadCurrent reads the workspace to find its current configuration
+// version and streams its archive to dst, e.g. to back up or scan exactly
+// the configuration used by the latest run.
+func (s *configurationVersions) DownloadCurrent(ctx context.Context, workspaceID string, dst io.Writer) (*ConfigurationVersion, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	if dst == nil {
+		return nil, ErrRequiredWriter
+	}
+
+	w, err := s.client.Workspaces.ReadByID(ctx, workspaceID)
 	if err != nil {
 		return nil, err
 	}
+	if w.CurrentConfigurationVersion == nil || w.CurrentConfigurationVersion.ID == "" {
+		return nil, ErrWorkspaceNoConfigurationVersion
+	}
 
-	var buf bytes.Buffer
-	err = req.Do(ctx, &buf)
+	cv, err := s.Read(ctx, w.CurrentConfigurationVersion.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	return buf.Bytes(), nil
+	if err := s.DownloadTo(ctx, cv.ID, dst); err != nil {
+		return nil, err
+	}
+
+	return cv, nil
 }
 
 func (s *configurationVersions) SoftDeleteBackingData(ctx context.Context, cvID string) error {
@@ -4,11 +4,21 @@
 package tfe
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -425,6 +435,263 @@ func TestConfigurationVersionsDownload(t *testing.T) {
 		assert.Nil(t, cvFile)
 		assert.EqualError(t, err, ErrResourceNotFound.Error())
 	})
+
+	t.Run("streaming to a writer", func(t *testing.T) {
+		uploadedCv, uploadedCvCleanup := createUploadedConfigurationVersion(t, client, nil)
+		defer uploadedCvCleanup()
+
+		expectedCvFile, err := client.ConfigurationVersions.Download(ctx, uploadedCv.ID)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, client.ConfigurationVersions.DownloadTo(ctx, uploadedCv.ID, &buf))
+		assert.True(t, bytes.Equal(expectedCvFile, buf.Bytes()), "Configuration version should match")
+	})
+}
+
+func TestConfigurationVersionsDownloadCurrent(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-123":                    `{"data":{"id":"ws-123","type":"workspaces","relationships":{"current-configuration-version":{"data":{"id":"cv-1","type":"configuration-versions"}}}}}`,
+		"GET /api/v2/workspaces/ws-new":                    `{"data":{"id":"ws-new","type":"workspaces","relationships":{"current-configuration-version":{"data":null}}}}`,
+		"GET /api/v2/configuration-versions/cv-1":          `{"data":{"id":"cv-1","type":"configuration-versions","attributes":{"status":"uploaded"}}}`,
+		"GET /api/v2/configuration-versions/cv-1/download": "archive",
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("with a current configuration version", func(t *testing.T) {
+		var buf bytes.Buffer
+		cv, err := client.ConfigurationVersions.DownloadCurrent(ctx, "ws-123", &buf)
+		require.NoError(t, err)
+		assert.Equal(t, "cv-1", cv.ID)
+		assert.Equal(t, ConfigurationUploaded, cv.Status)
+		assert.Equal(t, "archive", buf.String())
+	})
+
+	t.Run("without a current configuration version", func(t *testing.T) {
+		var buf bytes.Buffer
+		cv, err := client.ConfigurationVersions.DownloadCurrent(ctx, "ws-new", &buf)
+		assert.Nil(t, cv)
+		assert.Equal(t, ErrWorkspaceNoConfigurationVersion, err)
+	})
+
+	t.Run("without a writer", func(t *testing.T) {
+		err := client.ConfigurationVersions.DownloadTo(ctx, "cv-1", nil)
+		assert.Equal(t, ErrRequiredWriter, err)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		var buf bytes.Buffer
+		cv, err := client.ConfigurationVersions.DownloadCurrent(ctx, badIdentifier, &buf)
+		assert.Nil(t, cv)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestConfigurationVersionsReadReadme(t *testing.T) {
+	t.Parallel()
+
+	updatedAt := time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC)
+	archive := func(files map[string]string) string {
+		var buf bytes.Buffer
+		gzipW := gzip.NewWriter(&buf)
+		tarW := tar.NewWriter(gzipW)
+		for name, content := range files {
+			require.NoError(t, tarW.WriteHeader(&tar.Header{
+				Name:     name,
+				Mode:     0o644,
+				Size:     int64(len(content)),
+				ModTime:  updatedAt,
+				Typeflag: tar.TypeReg,
+			}))
+			_, err := tarW.Write([]byte(content))
+			require.NoError(t, err)
+		}
+		require.NoError(t, tarW.Close())
+		require.NoError(t, gzipW.Close())
+		return buf.String()
+	}
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-123":                    `{"data":{"id":"ws-123","type":"workspaces","relationships":{"current-configuration-version":{"data":{"id":"cv-1","type":"configuration-versions"}}}}}`,
+		"GET /api/v2/workspaces/ws-new":                    `{"data":{"id":"ws-new","type":"workspaces","relationships":{"current-configuration-version":{"data":null}}}}`,
+		"GET /api/v2/configuration-versions/cv-1":          `{"data":{"id":"cv-1","type":"configuration-versions","attributes":{"status":"uploaded"}}}`,
+		"GET /api/v2/configuration-versions/cv-1/download": archive(map[string]string{"main.tf": "", "./Readme.md": "# Network"}),
+		"GET /api/v2/configuration-versions/cv-2/download": archive(map[string]string{"main.tf": "", "docs/README.md": "# Docs"}),
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("with a README", func(t *testing.T) {
+		readme, err := client.ConfigurationVersions.ReadReadme(ctx, "cv-1")
+		require.NoError(t, err)
+		assert.Equal(t, &ConfigurationReadme{
+			ConfigurationVersionID: "cv-1",
+			Path:                   "Readme.md",
+			Format:                 ReadmeMarkdown,
+			RawMarkdown:            "# Network",
+			UpdatedAt:              updatedAt,
+		}, readme)
+	})
+
+	t.Run("without a README at the root", func(t *testing.T) {
+		readme, err := client.ConfigurationVersions.ReadReadme(ctx, "cv-2")
+		assert.Nil(t, readme)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("of a workspace", func(t *testing.T) {
+		readme, err := client.Workspaces.ReadReadme(ctx, "ws-123")
+		require.NoError(t, err)
+		assert.Equal(t, "cv-1", readme.ConfigurationVersionID)
+		assert.Equal(t, "# Network", readme.RawMarkdown)
+	})
+
+	t.Run("of a workspace without a configuration version", func(t *testing.T) {
+		readme, err := client.Workspaces.ReadReadme(ctx, "ws-new")
+		assert.Nil(t, readme)
+		assert.Equal(t, ErrWorkspaceNoConfigurationVersion, err)
+	})
+
+	t.Run("without a valid configuration version ID", func(t *testing.T) {
+		readme, err := client.ConfigurationVersions.ReadReadme(ctx, badIdentifier)
+		assert.Nil(t, readme)
+		assert.EqualError(t, err, ErrInvalidConfigVersionID.Error())
+	})
+}
+
+func TestConfigurationVersionsReadIngressAttributes(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/configuration-versions/cv-1/ingress-attributes": `{"data":{"id":"ia-1","type":"ingress-attributes","attributes":{
+			"branch":"main","commit-sha":"abc123","commit-message":"Bump module",
+			"compare-url":"https://github.com/acme/app/compare/111...abc123",
+			"sender-username":"octocat","is-pull-request":false},
+			"relationships":{"created-by":{"data":{"id":"user-1","type":"users"}}}}}`,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("with a configuration version sourced from VCS", func(t *testing.T) {
+		ia, err := client.ConfigurationVersions.ReadIngressAttributes(ctx, "cv-1")
+		require.NoError(t, err)
+		assert.Equal(t, "ia-1", ia.ID)
+		assert.Equal(t, "main", ia.Branch)
+		assert.Equal(t, "abc123", ia.CommitSHA)
+		assert.Equal(t, "Bump module", ia.CommitMessage)
+		assert.Equal(t, "https://github.com/acme/app/compare/111...abc123", ia.CompareURL)
+		assert.Equal(t, "octocat", ia.SenderUsername)
+		require.NotNil(t, ia.CreatedBy)
+		assert.Equal(t, "user-1", ia.CreatedBy.ID)
+	})
+
+	t.Run("with an uploaded configuration version", func(t *testing.T) {
+		_, err := client.ConfigurationVersions.ReadIngressAttributes(ctx, "cv-2")
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("without a valid configuration version ID", func(t *testing.T) {
+		_, err := client.ConfigurationVersions.ReadIngressAttributes(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidConfigVersionID, err)
+	})
+}
+
+func TestConfigurationVersionsUploadTarGzipWithOptions(t *testing.T) {
+	t.Parallel()
+
+	// The fake upload URL fails the first upload with a server error, and
+	// records the body and length of the following ones.
+	var mu sync.Mutex
+	var attempts int
+	var uploaded []byte
+	var contentLength int64
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		uploaded, _ = io.ReadAll(r.Body)
+		contentLength = r.ContentLength
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{
+		Address:    srv.URL,
+		Token:      "insert-your-token-here",
+		HTTPClient: srv.Client(),
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	content := bytes.Repeat([]byte("archive"), 1024)
+	sum := sha256.Sum256(content)
+	checksum := hex.EncodeToString(sum[:])
+
+	archive, err := os.Create(filepath.Join(t.TempDir(), "archive.tar.gz"))
+	require.NoError(t, err)
+	t.Cleanup(func() { archive.Close() })
+	_, err = archive.Write(content)
+	require.NoError(t, err)
+
+	reset := func() {
+		mu.Lock()
+		defer mu.Unlock()
+		attempts, uploaded, contentLength = 0, nil, 0
+	}
+
+	t.Run("with retries and a matching checksum", func(t *testing.T) {
+		reset()
+		err := client.ConfigurationVersions.UploadTarGzipWithOptions(ctx, srv.URL+"/upload", archive, UploadOptions{
+			Retries:  2,
+			Checksum: checksum,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 2, attempts)
+		assert.Equal(t, content, uploaded)
+		assert.Equal(t, int64(len(content)), contentLength)
+	})
+
+	t.Run("without retries", func(t *testing.T) {
+		reset()
+		err := client.ConfigurationVersions.UploadTarGzipWithOptions(ctx, srv.URL+"/upload", archive, UploadOptions{})
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, http.StatusServiceUnavailable, apiErr.StatusCode)
+		assert.Equal(t, 1, attempts)
+	})
+
+	t.Run("with a checksum that does not match", func(t *testing.T) {
+		reset()
+		err := client.ConfigurationVersions.UploadTarGzipWithOptions(ctx, srv.URL+"/upload", archive, UploadOptions{
+			Checksum: strings.Repeat("0", 64),
+		})
+		assert.ErrorIs(t, err, ErrChecksumMismatch)
+		assert.Equal(t, 0, attempts)
+	})
+
+	t.Run("with invalid options", func(t *testing.T) {
+		err := client.ConfigurationVersions.UploadTarGzipWithOptions(ctx, srv.URL+"/upload", archive, UploadOptions{
+			Checksum: "not-a-checksum",
+		})
+		assert.Equal(t, ErrInvalidChecksum, err)
+
+		err = client.ConfigurationVersions.UploadTarGzipWithOptions(ctx, srv.URL+"/upload", archive, UploadOptions{
+			Retries: -1,
+		})
+		assert.Equal(t, ErrInvalidRetries, err)
+	})
 }
 
 func TestConfigurationVersions_Unmarshal(t *testing.T) {
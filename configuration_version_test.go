@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewConfigurationPack(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	files := map[string]string{
+		"main.tf":           `resource "null_resource" "test" {}`,
+		"modules/a/main.tf": `variable "a" {}`,
+		"secrets.tfvars":    `password = "hunter2"`,
+		".terraformignore":  "*.tfvars\n",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	t.Run("with a valid path", func(t *testing.T) {
+		preview, err := PreviewConfigurationPack(dir)
+		require.NoError(t, err)
+
+		paths := make(map[string]int64)
+		for _, f := range preview.Files {
+			paths[f.Path] = f.Size
+		}
+		assert.Equal(t, int64(len(files["main.tf"])), paths["main.tf"])
+		assert.Equal(t, int64(len(files["modules/a/main.tf"])), paths["modules/a/main.tf"])
+		assert.NotContains(t, paths, "secrets.tfvars")
+
+		var total int64
+		for _, size := range paths {
+			total += size
+		}
+		assert.Equal(t, total, preview.Size)
+		assert.Greater(t, preview.CompressedSize, int64(0))
+	})
+
+	t.Run("without a valid path", func(t *testing.T) {
+		preview, err := PreviewConfigurationPack(filepath.Join(dir, "nonexisting"))
+		assert.Nil(t, preview)
+		assert.Error(t, err)
+	})
+}
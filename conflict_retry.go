@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+// UpdateFunc returns the options to update a resource with, given its current
+// state. It may be called several times by WithConflictRetry, once per
+// attempt, and should not have side effects.
+type UpdateFunc[T any, O any] func(current T) (O, error)
+
+// WithConflictRetry implements optimistic concurrency for update calls: it
+// reads the current state of a resource, computes the update options from it
+// with mutate and applies them with update. When the update fails with a
+// conflict because the resource changed concurrently, the resource is read
+// again and the mutation reapplied, up to the given number of attempts.
+// Other errors, including those returned by read and mutate, are returned
+// immediately.
+//
+//	ws, err := tfe.WithConflictRetry(ctx, 3,
+//		func(ctx context.Context) (*tfe.Workspace, error) {
+//			return client.Workspaces.ReadByID(ctx, workspaceID)
+//		},
+//		func(current *tfe.Workspace) (tfe.WorkspaceUpdateOptions, error) {
+//			return tfe.WorkspaceUpdateOptions{Description: tfe.String(current.Description + " (managed)")}, nil
+//		},
+//		func(ctx context.Context, options tfe.WorkspaceUpdateOptions) (*tfe.Workspace, error) {
+//			return client.Workspaces.UpdateByID(ctx, workspaceID, options)
+//		},
+//	)
+func WithConflictRetry[T any, O any](
+	ctx context.Context,
+	attempts int,
+	read func(ctx context.Context) (T, error),
+	mutate UpdateFunc[T, O],
+	update func(ctx context.Context, options O) (T, error),
+) (T, error) {
+	if attempts < 1 {
+		attempts = 1
+	}
+
+	var result T
+	var err error
+	for i := 0; i < attempts; i++ {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return result, ctxErr
+		}
+
+		var current T
+		current, err = read(ctx)
+		if err != nil {
+			return result, err
+		}
+
+		var options O
+		options, err = mutate(current)
+		if err != nil {
+			return result, err
+		}
+
+		result, err = update(ctx, options)
+		if err == nil || !IsConflict(err) {
+			return result, err
+		}
+	}
+
+	return result, err
+}
+
+// IsConflict reports whether the API rejected a request because of a
+// conflicting update. That is the case for a 409 Conflict status code, except
+// for the workspace locking conflicts returned as sentinel errors such as
+// ErrWorkspaceLocked. It is also the case for a 412 Precondition Failed status
+// code, which the API returns when the If-Match header set by
+// RelationshipUpdateOptions.IfMatch does not match.
+func IsConflict(err error) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) &&
+		(apiErr.StatusCode == http.StatusConflict || apiErr.StatusCode == http.StatusPreconditionFailed)
+}
@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWithConflictRetry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	conflict := &APIError{StatusCode: http.StatusConflict, err: errors.New("conflict")}
+
+	// counter simulates a resource updated concurrently: updates based on a
+	// stale read fail with a conflict.
+	type counter struct {
+		version int
+		value   int
+	}
+
+	newRetry := func(stored *counter, concurrentWrites int) (func(context.Context) (counter, error), UpdateFunc[counter, counter], func(context.Context, counter) (counter, error)) {
+		read := func(context.Context) (counter, error) {
+			return *stored, nil
+		}
+		mutate := func(current counter) (counter, error) {
+			return counter{version: current.version, value: current.value + 1}, nil
+		}
+		update := func(_ context.Context, options counter) (counter, error) {
+			if concurrentWrites > 0 {
+				concurrentWrites--
+				stored.version++
+			}
+			if options.version != stored.version {
+				return counter{}, conflict
+			}
+			stored.version++
+			stored.value = options.value
+			return *stored, nil
+		}
+		return read, mutate, update
+	}
+
+	t.Run("without conflicts", func(t *testing.T) {
+		stored := &counter{}
+		read, mutate, update := newRetry(stored, 0)
+
+		result, err := WithConflictRetry(ctx, 3, read, mutate, update)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.value)
+	})
+
+	t.Run("when a conflict is resolved by retrying", func(t *testing.T) {
+		stored := &counter{}
+		read, mutate, update := newRetry(stored, 2)
+
+		result, err := WithConflictRetry(ctx, 3, read, mutate, update)
+		require.NoError(t, err)
+		assert.Equal(t, 1, result.value)
+		assert.Equal(t, 3, result.version)
+	})
+
+	t.Run("when the attempts are exhausted", func(t *testing.T) {
+		stored := &counter{}
+		read, mutate, update := newRetry(stored, 3)
+
+		_, err := WithConflictRetry(ctx, 3, read, mutate, update)
+		assert.True(t, IsConflict(err))
+		assert.Equal(t, 0, stored.value)
+	})
+
+	t.Run("when the mutation fails", func(t *testing.T) {
+		stored := &counter{}
+		read, _, update := newRetry(stored, 0)
+		failure := errors.New("failure")
+
+		_, err := WithConflictRetry(ctx, 3, read, func(counter) (counter, error) {
+			return counter{}, failure
+		}, update)
+		assert.Equal(t, failure, err)
+	})
+
+	t.Run("when the context is canceled", func(t *testing.T) {
+		stored := &counter{}
+		read, mutate, update := newRetry(stored, 0)
+		canceled, cancel := context.WithCancel(ctx)
+		cancel()
+
+		_, err := WithConflictRetry(canceled, 3, read, mutate, update)
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestIsConflict(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, IsConflict(&APIError{StatusCode: http.StatusConflict, err: ErrWorkspaceLocked}))
+	assert.True(t, IsConflict(fmt.Errorf("updating: %w", &APIError{StatusCode: http.StatusConflict, err: errors.New("conflict")})))
+	assert.True(t, IsConflict(&APIError{StatusCode: http.StatusPreconditionFailed, err: errors.New("precondition failed")}))
+	assert.False(t, IsConflict(&APIError{StatusCode: http.StatusNotFound, err: ErrResourceNotFound}))
+	assert.False(t, IsConflict(errors.New("conflict")))
+	assert.False(t, IsConflict(nil))
+}
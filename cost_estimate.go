@@ -6,9 +6,11 @@ package tfe
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
+	"strconv"
 	"time"
 )
 
@@ -25,6 +27,10 @@ type CostEstimates interface {
 
 	// Logs retrieves the logs of a costEstimate.
 	Logs(ctx context.Context, costEstimateID string) (io.Reader, error)
+
+	// ReadJSONOutput retrieves the output of a costEstimate, including the
+	// costs of each resource.
+	ReadJSONOutput(ctx context.Context, costEstimateID string) (*CostEstimateJSONOutput, error)
 }
 
 // costEstimates implements CostEstimates.
@@ -69,6 +75,50 @@ type CostEstimateStatusTimestamps struct {
 	SkippedDueToTargetingAt time.Time `jsonapi:"attr,skipped-due-to-targeting-at,rfc3339"`
 }
 
+// CostEstimateJSONOutput represents the JSON output of a costEstimate. Costs
+// are monthly amounts in USD, formatted as decimal strings.
+type CostEstimateJSONOutput struct {
+	PriorMonthlyCost    string                 `json:"prior-monthly-cost"`
+	ProposedMonthlyCost string                 `json:"proposed-monthly-cost"`
+	DeltaMonthlyCost    string                 `json:"delta-monthly-cost"`
+	Resources           *CostEstimateResources `json:"resources"`
+}
+
+// CostEstimateResources holds the resources of a costEstimate, split by
+// whether their cost could be estimated.
+type CostEstimateResources struct {
+	Matched   []*CostEstimateResource `json:"matched"`
+	Unmatched []*CostEstimateResource `json:"unmatched"`
+}
+
+// CostEstimateResource represents the estimated cost of a single resource.
+type CostEstimateResource struct {
+	Address             string `json:"address"`
+	Type                string `json:"type"`
+	Name                string `json:"name"`
+	PriorMonthlyCost    string `json:"prior-monthly-cost"`
+	ProposedMonthlyCost string `json:"proposed-monthly-cost"`
+	DeltaMonthlyCost    string `json:"delta-monthly-cost"`
+}
+
+// Delta parses the change of the monthly cost of the resource. Resources
+// without a delta, such as unmatched ones, have a delta of zero.
+func (r *CostEstimateResource) Delta() (float64, error) {
+	return parseCost(r.DeltaMonthlyCost)
+}
+
+// Delta parses the change of the total monthly cost of the costEstimate.
+func (o *CostEstimateJSONOutput) Delta() (float64, error) {
+	return parseCost(o.DeltaMonthlyCost)
+}
+
+func parseCost(cost string) (float64, error) {
+	if cost == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(cost, 64)
+}
+
 // Read a costEstimate by its ID.
 func (s *costEstimates) Read(ctx context.Context, costEstimateID string) (*CostEstimate, error) {
 	if !validStringID(&costEstimateID) {
@@ -99,21 +149,20 @@ func (s *costEstimates) Logs(ctx context.Context, costEstimateID string) (io.Rea
 	// Loop until the context is canceled or the cost estimate is finished
 	// running. The cost estimate logs are not streamed and so only available
 	// once the estimate is finished.
-	for {
+	b := s.client.pollingBackoff(Backoff{Min: time.Second})
+	for reads := 0; ; reads++ {
 		// Get the costEstimate to make sure it exists.
-		ce, err := s.Read(ctx, costEstimateID)
+		ce, err := s.Read(ContextWithoutCache(ctx), costEstimateID)
 		if err != nil {
 			return nil, err
 		}
 
 		switch ce.Status {
 		case CostEstimateQueued:
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(1000 * time.Millisecond):
-				continue
+			if err := b.Wait(ctx, reads, nil); err != nil {
+				return nil, err
 			}
+			continue
 		}
 
 		u := fmt.Sprintf("cost-estimates/%s/output", url.PathEscape(costEstimateID))
@@ -131,3 +180,21 @@ func (s *costEstimates) Logs(ctx context.Context, costEstimateID string) (io.Rea
 		return logs, nil
 	}
 }
+
+// ReadJSONOutput retrieves the output of a costEstimate, once it is no longer
+// queued, and decodes it. Unlike Read, which only reports the totals, the
+// output includes the cost of each resource, so it can be used to gate
+// changes on the cost of specific resources.
+func (s *costEstimates) ReadJSONOutput(ctx context.Context, costEstimateID string) (*CostEstimateJSONOutput, error) {
+	logs, err := s.Logs(ctx, costEstimateID)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &CostEstimateJSONOutput{}
+	if err := json.NewDecoder(logs).Decode(out); err != nil {
+		return nil, fmt.Errorf("failed to decode cost estimate output: %w", err)
+	}
+
+	return out, nil
+}
@@ -102,3 +102,63 @@ func TestCostEsimate_Unmarshal(t *testing.T) {
 	assert.Equal(t, ce.StatusTimestamps.QueuedAt, queuedParsedTime)
 	assert.Equal(t, ce.StatusTimestamps.ErroredAt, erroredParsedTime)
 }
+
+func TestCostEstimatesReadJSONOutput(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/cost-estimates/ce-1": `{"data":{"id":"ce-1","type":"cost-estimates","attributes":{"status":"finished"}}}`,
+		"GET /api/v2/cost-estimates/ce-1/output": `{
+			"prior-monthly-cost": "10.00",
+			"proposed-monthly-cost": "25.50",
+			"delta-monthly-cost": "15.50",
+			"resources": {
+				"matched": [{
+					"address": "aws_instance.web",
+					"type": "aws_instance",
+					"name": "web",
+					"prior-monthly-cost": "10.00",
+					"proposed-monthly-cost": "25.50",
+					"delta-monthly-cost": "15.50"
+				}],
+				"unmatched": [{
+					"address": "aws_s3_bucket.logs",
+					"type": "aws_s3_bucket",
+					"name": "logs"
+				}]
+			}
+		}`,
+	})
+	defer done()
+
+	ctx := context.Background()
+
+	t.Run("with a finished cost estimate", func(t *testing.T) {
+		out, err := client.CostEstimates.ReadJSONOutput(ctx, "ce-1")
+		require.NoError(t, err)
+
+		delta, err := out.Delta()
+		require.NoError(t, err)
+		assert.Equal(t, 15.5, delta)
+
+		require.Len(t, out.Resources.Matched, 1)
+		resource := out.Resources.Matched[0]
+		assert.Equal(t, "aws_instance.web", resource.Address)
+		assert.Equal(t, "25.50", resource.ProposedMonthlyCost)
+
+		delta, err = resource.Delta()
+		require.NoError(t, err)
+		assert.Equal(t, 15.5, delta)
+
+		require.Len(t, out.Resources.Unmatched, 1)
+		delta, err = out.Resources.Unmatched[0].Delta()
+		require.NoError(t, err)
+		assert.Zero(t, delta)
+	})
+
+	t.Run("with an invalid cost estimate ID", func(t *testing.T) {
+		out, err := client.CostEstimates.ReadJSONOutput(ctx, badIdentifier)
+		assert.Nil(t, out)
+		assert.Equal(t, ErrInvalidCostEstimateID, err)
+	})
+}
@@ -0,0 +1,228 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Diagnostics = (*diagnostics)(nil)
+
+// Diagnostics describes helpers that combine several API endpoints to
+// diagnose the state of an organization. They do not map to a single API
+// endpoint and only perform read requests.
+type Diagnostics interface {
+	// QueueHealth inspects the run queue of an organization and reports the
+	// runs that have been waiting longer than expected, classified by the
+	// most likely cause.
+	QueueHealth(ctx context.Context, organization string, options *QueueHealthOptions) (*QueueHealth, error)
+}
+
+// diagnostics implements Diagnostics.
+type diagnostics struct {
+	client *Client
+}
+
+// QueueHealthCause is the most likely reason a queued run is not progressing.
+type QueueHealthCause string
+
+// List of available queue health causes.
+const (
+	// QueueHealthCauseCapacity means there is no capacity to start the run:
+	// either the organization is at its concurrency limit or the agent pool
+	// of the workspace has no idle agents.
+	QueueHealthCauseCapacity QueueHealthCause = "capacity"
+	// QueueHealthCauseLock means the workspace of the run is locked.
+	QueueHealthCauseLock QueueHealthCause = "lock"
+	// QueueHealthCauseError means capacity is available but the run is not
+	// being picked up, or the agents that should pick it up are errored.
+	QueueHealthCauseError QueueHealthCause = "error"
+)
+
+// DefaultQueueHealthThreshold is the age after which a queued run is
+// considered stuck when no threshold is given.
+const DefaultQueueHealthThreshold = 10 * time.Minute
+
+// QueueHealthOptions represents the options for inspecting the run queue of
+// an organization.
+type QueueHealthOptions struct {
+	// Optional: The age after which a queued run is considered stuck.
+	// Defaults to DefaultQueueHealthThreshold.
+	Threshold time.Duration
+
+	// Optional: The maximum number of concurrent runs of the organization.
+	// The API does not expose this limit, so when it is not set runs of
+	// workspaces using remote execution are never attributed to a lack of
+	// capacity.
+	Concurrency int
+}
+
+// QueueHealth represents the health of the run queue of an organization.
+type QueueHealth struct {
+	Organization string
+	// The number of pending and running runs of the organization.
+	Pending int
+	Running int
+	// The number of idle agents of each agent pool used by a queued run,
+	// keyed by agent pool ID.
+	IdleAgents map[string]int
+	// The runs that have been queued longer than the threshold.
+	Findings []*QueueHealthFinding
+}
+
+// QueueHealthFinding represents a queued run that is not progressing.
+type QueueHealthFinding struct {
+	Run       *Run
+	Workspace *Workspace
+	// The agent pool of the workspace, when it uses agent execution.
+	AgentPool *AgentPool
+	// How long the run has been queued.
+	Age    time.Duration
+	Cause  QueueHealthCause
+	Detail string
+}
+
+// Healthy reports whether no queued run has been waiting longer than the
+// threshold.
+func (h *QueueHealth) Healthy() bool {
+	return len(h.Findings) == 0
+}
+
+// QueueHealth correlates the age of the queued runs of an organization with
+// the state of their workspace, the idle agents of their agent pool and the
+// concurrency of the organization.
+func (s *diagnostics) QueueHealth(ctx context.Context, organization string, options *QueueHealthOptions) (*QueueHealth, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if options == nil {
+		options = &QueueHealthOptions{}
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	threshold := options.Threshold
+	if threshold == 0 {
+		threshold = DefaultQueueHealthThreshold
+	}
+
+	capacity, err := s.client.Organizations.ReadCapacity(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	health := &QueueHealth{
+		Organization: organization,
+		Pending:      capacity.Pending,
+		Running:      capacity.Running,
+		IdleAgents:   make(map[string]int),
+	}
+
+	var queued []*Run
+	queueOpts := ReadRunQueueOptions{ListOptions: ListOptions{PageSize: 100}}
+	err = forEachPage(&queueOpts.ListOptions, func() (*Pagination, error) {
+		rq, err := s.client.Organizations.ReadRunQueue(ctx, organization, queueOpts)
+		if err != nil {
+			return nil, err
+		}
+		queued = append(queued, rq.Items...)
+		return rq.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	workspaces := make(map[string]*Workspace)
+	pools := make(map[string]map[AgentStatus]int)
+
+	for _, r := range queued {
+		age := now.Sub(r.CreatedAt)
+		if age < threshold || r.Workspace == nil {
+			continue
+		}
+
+		ws, ok := workspaces[r.Workspace.ID]
+		if !ok {
+			ws, err = s.client.Workspaces.ReadByID(ctx, r.Workspace.ID)
+			if err != nil {
+				return nil, err
+			}
+			workspaces[r.Workspace.ID] = ws
+		}
+
+		finding := &QueueHealthFinding{
+			Run:       r,
+			Workspace: ws,
+			Age:       age,
+		}
+
+		switch {
+		case ws.Locked:
+			finding.Cause = QueueHealthCauseLock
+			finding.Detail = fmt.Sprintf("workspace %s is locked", ws.Name)
+			if ws.LockedBy != nil {
+				switch {
+				case ws.LockedBy.Run != nil && ws.LockedBy.Run.ID != r.ID:
+					finding.Detail += fmt.Sprintf(" by run %s", ws.LockedBy.Run.ID)
+				case ws.LockedBy.User != nil:
+					finding.Detail += fmt.Sprintf(" by user %s", ws.LockedBy.User.ID)
+				case ws.LockedBy.Team != nil:
+					finding.Detail += fmt.Sprintf(" by team %s", ws.LockedBy.Team.ID)
+				}
+			}
+
+		case ws.ExecutionMode == "agent" && ws.AgentPool != nil:
+			finding.AgentPool = ws.AgentPool
+
+			counts, ok := pools[ws.AgentPool.ID]
+			if !ok {
+				counts, err = countAgents(ctx, s.client, ws.AgentPool.ID)
+				if err != nil {
+					return nil, err
+				}
+				pools[ws.AgentPool.ID] = counts
+				health.IdleAgents[ws.AgentPool.ID] = counts[AgentIdle]
+			}
+
+			switch {
+			case counts[AgentIdle] == 0 && counts[AgentErrored] > 0:
+				finding.Cause = QueueHealthCauseError
+				finding.Detail = fmt.Sprintf("agent pool %s has no idle agents and %d errored agents", ws.AgentPool.ID, counts[AgentErrored])
+			case counts[AgentIdle] == 0:
+				finding.Cause = QueueHealthCauseCapacity
+				finding.Detail = fmt.Sprintf("agent pool %s has no idle agents", ws.AgentPool.ID)
+			default:
+				finding.Cause = QueueHealthCauseError
+				finding.Detail = fmt.Sprintf("agent pool %s has %d idle agents but the run was not picked up", ws.AgentPool.ID, counts[AgentIdle])
+			}
+
+		case options.Concurrency > 0 && health.Running >= options.Concurrency:
+			finding.Cause = QueueHealthCauseCapacity
+			finding.Detail = fmt.Sprintf("organization is running %d of %d concurrent runs", health.Running, options.Concurrency)
+
+		default:
+			finding.Cause = QueueHealthCauseError
+			finding.Detail = "capacity is available but the run was not picked up"
+		}
+
+		health.Findings = append(health.Findings, finding)
+	}
+
+	return health, nil
+}
+
+func (o *QueueHealthOptions) valid() error {
+	if o.Threshold < 0 {
+		return ErrInvalidQueueHealthThreshold
+	}
+	if o.Concurrency < 0 {
+		return ErrInvalidQueueHealthConcurrency
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDiagnosticsQueueHealth(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	t.Run("with an empty queue", func(t *testing.T) {
+		h, err := client.Diagnostics.QueueHealth(ctx, orgTest.Name, nil)
+		require.NoError(t, err)
+		assert.Equal(t, orgTest.Name, h.Organization)
+		assert.True(t, h.Healthy())
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		h, err := client.Diagnostics.QueueHealth(ctx, badIdentifier, nil)
+		assert.Nil(t, h)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+
+	t.Run("with a negative threshold", func(t *testing.T) {
+		h, err := client.Diagnostics.QueueHealth(ctx, orgTest.Name, &QueueHealthOptions{
+			Threshold: -time.Minute,
+		})
+		assert.Nil(t, h)
+		assert.Equal(t, ErrInvalidQueueHealthThreshold, err)
+	})
+}
+
+func TestDiagnosticsQueueHealth_Classify(t *testing.T) {
+	t.Parallel()
+
+	queued := func(id, workspaceID string, createdAt time.Time) string {
+		return fmt.Sprintf(`{"id":%q,"type":"runs","attributes":{"status":"plan_queued","created-at":%q},"relationships":{"workspace":{"data":{"id":%q,"type":"workspaces"}}}}`,
+			id, createdAt.Format(time.RFC3339), workspaceID)
+	}
+	old := time.Now().Add(-time.Hour)
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/hashicorp/capacity": `{"data":{"id":"hashicorp","type":"organization-capacity","attributes":{"pending":5,"running":2}}}`,
+		"GET /api/v2/organizations/hashicorp/runs/queue": `{"data":[` +
+			queued("run-locked", "ws-locked", old) + `,` +
+			queued("run-busy", "ws-busy", old) + `,` +
+			queued("run-errored", "ws-errored", old) + `,` +
+			queued("run-remote", "ws-remote", old) + `,` +
+			queued("run-fresh", "ws-remote", time.Now()) +
+			`]}`,
+		"GET /api/v2/workspaces/ws-locked": `{"data":{"id":"ws-locked","type":"workspaces","attributes":{"name":"locked","locked":true,"execution-mode":"remote"},
+			"relationships":{"locked-by":{"data":{"id":"user-1","type":"users"}}}}}`,
+		"GET /api/v2/workspaces/ws-busy": `{"data":{"id":"ws-busy","type":"workspaces","attributes":{"name":"busy","execution-mode":"agent"},
+			"relationships":{"agent-pool":{"data":{"id":"apool-busy","type":"agent-pools"}}}}}`,
+		"GET /api/v2/workspaces/ws-errored": `{"data":{"id":"ws-errored","type":"workspaces","attributes":{"name":"errored","execution-mode":"agent"},
+			"relationships":{"agent-pool":{"data":{"id":"apool-errored","type":"agent-pools"}}}}}`,
+		"GET /api/v2/workspaces/ws-remote":             `{"data":{"id":"ws-remote","type":"workspaces","attributes":{"name":"remote","execution-mode":"remote"}}}`,
+		"GET /api/v2/agent-pools/apool-busy/agents":    `{"data":[{"id":"agent-1","type":"agents","attributes":{"status":"busy"}}]}`,
+		"GET /api/v2/agent-pools/apool-errored/agents": `{"data":[{"id":"agent-2","type":"agents","attributes":{"status":"errored"}}]}`,
+	})
+	defer done()
+
+	h, err := client.Diagnostics.QueueHealth(context.Background(), "hashicorp", &QueueHealthOptions{
+		Concurrency: 2,
+	})
+	require.NoError(t, err)
+
+	assert.False(t, h.Healthy())
+	assert.Equal(t, 5, h.Pending)
+	assert.Equal(t, 2, h.Running)
+	assert.Equal(t, map[string]int{"apool-busy": 0, "apool-errored": 0}, h.IdleAgents)
+
+	causes := make(map[string]QueueHealthCause)
+	for _, f := range h.Findings {
+		causes[f.Run.ID] = f.Cause
+		assert.GreaterOrEqual(t, f.Age, DefaultQueueHealthThreshold)
+	}
+	assert.Equal(t, map[string]QueueHealthCause{
+		"run-locked":  QueueHealthCauseLock,
+		"run-busy":    QueueHealthCauseCapacity,
+		"run-errored": QueueHealthCauseError,
+		"run-remote":  QueueHealthCauseCapacity,
+	}, causes)
+}
@@ -22,6 +22,18 @@ var (
 	// ErrNamespaceNotAuthorized is returned when a user attempts to perform an action
 	// on a namespace (organization) they do not have access to.
 	ErrNamespaceNotAuthorized = errors.New("namespace not authorized")
+
+	// ErrStopIteration can be returned by the callback of an iterator, such
+	// as IterateResources, to stop iterating without reporting an error.
+	ErrStopIteration = errors.New("stop iteration")
+
+	// ErrChecksumMismatch is returned when an archive to upload does not
+	// match the checksum it is expected to have.
+	ErrChecksumMismatch = errors.New("archive does not match the checksum")
+
+	// ErrNoToken is returned by a TokenSource that has no API token to
+	// provide.
+	ErrNoToken = errors.New("no API token found")
 )
 
 // Options/fields that cannot be defined
@@ -30,6 +42,8 @@ var (
 
 	ErrUnsupportedPrivateKey = errors.New("private Key can only be present with Azure DevOps Server service provider")
 
+	ErrUnsupportedRSAPublicKey = errors.New("RSA public key can only be present with Bitbucket Server or Bitbucket Data Center service providers")
+
 	ErrUnsupportedBothTagsRegexAndFileTriggersEnabled = errors.New(`"TagsRegex" cannot be populated when "FileTriggersEnabled" is true`)
 
 	ErrUnsupportedBothTagsRegexAndTriggerPatterns = errors.New(`"TagsRegex" and "TriggerPrefixes" cannot be populated at the same time`)
@@ -40,7 +54,19 @@ var (
 
 	ErrUnsupportedBothTriggerPatternsAndPrefixes = errors.New(`"TriggerPatterns" and "TriggerPrefixes" cannot be populated at the same time`)
 
+	ErrUnsupportedBothTerraformVersionAndConstraint = errors.New(`"TerraformVersion" and "TerraformVersionConstraint" cannot be populated at the same time`)
+
+	ErrUnsupportedBothOAuthTokenAndGHAInstallation = errors.New(`"OAuthTokenID" and "GHAInstallationID" cannot be populated at the same time`)
+
+	ErrUnsupportedDuplicateQueryParam = errors.New("a query parameter cannot be populated by more than one option at the same time")
+
+	ErrUnsupportedBothProjectAndProjectName = errors.New(`"Project" and "ProjectName" cannot be populated at the same time`)
+
+	ErrUnsupportedBothAggregatedCommitStatusAndPassingStatuses = errors.New(`"AggregatedCommitStatusEnabled" and "SendPassingStatusesForUntriggeredSpeculativePlans" cannot both be true`)
+
 	ErrUnsupportedBothNamespaceAndPrivateRegistryName = errors.New(`"Namespace" cannot be populated when "RegistryName" is "private"`)
+
+	ErrUnsupportedTransport = errors.New(`"TLSConfig", "MaxIdleConnsPerHost" and "DisableHTTP2" require the HTTP client to use an *http.Transport`)
 )
 
 // Library errors that usually indicate a bug in the implementation of go-tfe
@@ -54,6 +80,54 @@ var (
 
 // Resource Errors
 var (
+	// ErrAgentPoolOrganizationMismatch is returned when an agent pool and a
+	// workspace belong to different organizations.
+	ErrAgentPoolOrganizationMismatch = errors.New("agent pool and workspace belong to different organizations")
+
+	// ErrAgentPoolNotEntitled is returned when the organization is not
+	// entitled to use agents.
+	ErrAgentPoolNotEntitled = errors.New("organization is not entitled to use agents")
+
+	// ErrAgentPoolWorkspaceNotAllowed is returned when a workspace is not in the
+	// allowed workspaces of an agent pool that is not organization scoped.
+	ErrAgentPoolWorkspaceNotAllowed = errors.New("workspace is not allowed to use the agent pool")
+
+	// ErrRegistryModuleVersionFailed is returned when a registry module
+	// version fails to be ingested by the registry.
+	ErrRegistryModuleVersionFailed = errors.New("registry module version failed to publish")
+
+	// ErrPolicySetVersionErrored is wrapped by the *PolicySetVersionError
+	// returned when a policy set version failed to be ingested.
+	ErrPolicySetVersionErrored = errors.New("policy set version failed to be ingested")
+
+	// ErrWorkspaceNoConfigurationVersion is returned when a workspace has no
+	// current configuration version.
+	ErrWorkspaceNoConfigurationVersion = errors.New("workspace has no current configuration version")
+
+	// ErrWorkspaceRenamed is wrapped by the *WorkspaceRenamedError returned
+	// when a workspace was found under a new name.
+	ErrWorkspaceRenamed = errors.New("workspace was renamed")
+
+	// ErrAmbiguousProjectName is returned when more than one project of an
+	// organization has the name of the project to read.
+	ErrAmbiguousProjectName = errors.New("more than one project has the given name")
+
+	// ErrUnresolvedTerraformVersion is returned when no available Terraform
+	// version matches the Terraform version constraint of a workspace.
+	ErrUnresolvedTerraformVersion = errors.New("no available terraform version matches the constraint")
+
+	// ErrCampaignPlanFailed is returned when the speculative plan of a
+	// workspace did not finish successfully during a campaign.
+	ErrCampaignPlanFailed = errors.New("speculative plan did not finish successfully")
+
+	// ErrPreviewTemplateWithoutVCSRepo is returned when the template
+	// workspace of a preview is not connected to a VCS repository.
+	ErrPreviewTemplateWithoutVCSRepo = errors.New("template workspace is not connected to a VCS repository")
+
+	// ErrPreviewConfigurationErrored is returned when the configuration of
+	// a preview workspace failed to be ingested from its VCS repository.
+	ErrPreviewConfigurationErrored = errors.New("configuration of the preview workspace failed to be ingested")
+
 	// ErrWorkspaceLocked is returned when trying to lock a locked workspace.
 	ErrWorkspaceLocked = errors.New("workspace already locked")
 
@@ -129,6 +203,12 @@ var (
 
 	ErrInvalidRunTaskURL = errors.New("invalid url for run task URL")
 
+	ErrInvalidRunTaskStage = errors.New(`invalid value for stage, must be one of "pre_plan", "post_plan", "pre_apply" or "post_apply"`)
+
+	ErrInvalidRunTaskEnforcementLevel = errors.New(`invalid value for enforcement level, must be "advisory" or "mandatory"`)
+
+	ErrInvalidRunTaskWindow = errors.New("invalid value for window, must be positive")
+
 	ErrInvalidWorkspaceRunTaskID = errors.New("invalid value for workspace run task ID")
 
 	ErrInvalidWorkspaceRunTaskType = errors.New(`invalid value for type, please use "workspace-tasks"`)
@@ -149,8 +229,28 @@ var (
 
 	ErrInvalidMembershipIDs = errors.New("invalid value for organization membership ids")
 
+	ErrInvalidMembershipStatus = errors.New(`invalid value for organization membership status, must be "active" or "invited"`)
+
+	ErrInvalidQueueHealthThreshold = errors.New("invalid value for queue health threshold, must not be negative")
+
+	ErrInvalidQueueHealthConcurrency = errors.New("invalid value for queue health concurrency, must not be negative")
+
+	ErrInvalidRunStatsWindow = errors.New("invalid value for run stats window, must not be negative")
+
 	ErrInvalidOauthClientID = errors.New("invalid value for OAuth client ID")
 
+	ErrInvalidWorkspaceOperation = errors.New("invalid value for workspace operation")
+
+	ErrDuplicateVariable = errors.New("invalid value for variables, each key must be used only once per category")
+
+	ErrInvalidSAMLCertificate = errors.New("invalid value for SAML certificate, must be a PEM encoded X.509 certificate")
+
+	ErrInvalidSAMLPrivateKey = errors.New("invalid value for SAML private key, must be a PEM encoded RSA, ECDSA or Ed25519 private key")
+
+	ErrSAMLCertificateNotValid = errors.New("SAML certificate is expired or not yet valid")
+
+	ErrSAMLPrivateKeyMismatch = errors.New("SAML private key does not match the certificate")
+
 	ErrInvalidOauthTokenID = errors.New("invalid value for OAuth token ID")
 
 	ErrInvalidPolicySetID = errors.New("invalid value for policy set ID")
@@ -193,6 +293,26 @@ var (
 
 	ErrInvalidTeamProjectAccessType = errors.New("invalid type for team project access")
 
+	ErrInvalidTeamAccessType = errors.New("invalid type for team access")
+
+	ErrInvalidPRNumber = errors.New("invalid value for pull request number")
+
+	ErrInvalidPreviewTTL = errors.New("invalid value for preview TTL, it must not be negative")
+
+	ErrInvalidBatchSize = errors.New("invalid value for batch size, it must not be negative")
+
+	ErrInvalidRunsPermission = errors.New("invalid value for runs permission")
+
+	ErrInvalidVariablesPermission = errors.New("invalid value for variables permission")
+
+	ErrInvalidStateVersionsPermission = errors.New("invalid value for state versions permission")
+
+	ErrInvalidSentinelMocksPermission = errors.New("invalid value for sentinel mocks permission")
+
+	// ErrTeamAccessPermissionsRequireCustom is returned when custom workspace
+	// permissions are set along with an access type other than custom.
+	ErrTeamAccessPermissionsRequireCustom = errors.New("custom workspace permissions can only be set when access is custom")
+
 	ErrInvalidTeamID = errors.New("invalid value for team ID")
 
 	ErrInvalidUsernames = errors.New("invalid value for usernames")
@@ -233,6 +353,10 @@ var (
 
 	ErrInvalidCallbackURL = errors.New("invalid value for callback URL")
 
+	ErrInvalidTerraformVersionConstraint = errors.New("invalid value for terraform version constraint")
+
+	ErrInvalidDeprecationLink = errors.New("invalid value for deprecation link, it must be an absolute http or https URL")
+
 	ErrInvalidAccessToken = errors.New("invalid value for access token")
 
 	ErrInvalidTaskResultsCallbackStatus = fmt.Errorf("invalid value for task result status. Must be either `%s`, `%s`, or `%s`", TaskFailed, TaskPassed, TaskRunning)
@@ -270,10 +394,16 @@ var (
 
 	ErrRequiredEmail = errors.New("email is required")
 
+	ErrRequiredInvitations = errors.New("at least one invitation is required")
+
 	ErrRequiredM5 = errors.New("MD5 is required")
 
 	ErrRequiredURL = errors.New("url is required")
 
+	ErrRequiredWriter = errors.New("writer is required")
+
+	ErrRequiredDirectory = errors.New("directory is required")
+
 	ErrRequiredArchOrURLAndSha = errors.New("valid arch or url and sha is required")
 
 	ErrRequiredAPIURL = errors.New("API URL is required")
@@ -286,6 +416,10 @@ var (
 
 	ErrRequiredOauthToken = errors.New("OAuth token is required")
 
+	ErrRequiredCache = errors.New("cache is required to cache reads")
+
+	ErrRequiredRSAKeyPair = errors.New("secret and RSA public key are required together to rotate the key pair of a Bitbucket Server or Bitbucket Data Center OAuth client")
+
 	ErrRequiredOauthTokenOrGithubAppInstallationID = errors.New("either oauth token ID or github app installation ID is required")
 
 	ErrRequiredTestNumber = errors.New("TestNumber is required")
@@ -300,6 +434,10 @@ var (
 
 	ErrRequiredWorkspace = errors.New("workspace is required")
 
+	ErrRequiredTemplateWorkspace = errors.New("template workspace is required")
+
+	ErrRequiredTargetVersion = errors.New("target version is required")
+
 	ErrRequiredProject = errors.New("project is required")
 
 	ErrRequiredWorkspaceID = errors.New("workspace ID is required")
@@ -330,10 +468,14 @@ var (
 
 	ErrRequiredValue = errors.New("value is required")
 
+	ErrRequiredHashKey = errors.New("hash key is required")
+
 	ErrRequiredOrg = errors.New("organization is required")
 
 	ErrRequiredTeam = errors.New("team is required")
 
+	ErrRequiredSSOTeamID = errors.New("SSO team ID is required")
+
 	ErrRequiredStateVerListOps = errors.New("StateVersionListOptions is required")
 
 	ErrRequiredTeamAccessListOps = errors.New("TeamAccessListOptions is required")
@@ -362,6 +504,8 @@ var (
 
 	ErrRequiredWorkspacesList = errors.New("no workspaces list provided")
 
+	ErrRequiredWorkspaceFilter = errors.New("tag bindings or project are required to filter workspaces")
+
 	ErrCommentBody = errors.New("comment body is required")
 
 	ErrEmptyTeamName = errors.New("team name can not be empty")
@@ -386,10 +530,28 @@ var (
 
 	ErrRequiredTagBindings = errors.New("TagBindings are required")
 
+	ErrRequiredTagBindingKeys = errors.New("at least one tag binding key is required")
+
+	ErrRequiredAttributes = errors.New("at least one attribute is required")
+
+	ErrRequiredRelationship = errors.New("relationship is required")
+
+	ErrInvalidRelatedResource = errors.New("invalid value for related resource, both its type and ID are required")
+
 	ErrInvalidTestRunID = errors.New("invalid value for test run id")
 
 	ErrTerraformVersionValidForPlanOnly = errors.New("setting terraform-version is only valid when plan-only is set to true")
 
+	ErrInvalidRetries = errors.New("invalid value for retries, must not be negative")
+
+	ErrInvalidChecksum = errors.New("invalid value for checksum, must be a hex-encoded SHA-256 checksum")
+
+	ErrInvalidFieldsetType = errors.New("invalid value for fieldset type, must not be empty")
+
+	ErrInvalidTagBinding = errors.New("invalid value for tag binding, the key must not be empty and the key and value must not exceed 128 and 256 characters")
+
+	ErrTooManyTagBindings = errors.New("too many tag bindings, at most 10 are allowed")
+
 	ErrStateMustBeOmitted = errors.New("when uploading state, the State and JSONState strings must be omitted from options")
 
 	ErrRequiredRawState = errors.New("RawState is required")
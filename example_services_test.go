@@ -0,0 +1,395 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/http/httptest"
+)
+
+// The examples in this file run against an in-process fake of the API that
+// serves canned responses, so they are executed by `go test` without network
+// access or an API token and their output is verified.
+
+// newExampleClient returns a client connected to a fake API server. The
+// responses map is keyed by "METHOD /path" and holds the JSON:API document
+// returned for that request. Unknown requests return a 404.
+func newExampleClient(responses map[string]string) (*Client, func()) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == DefaultBasePath+PingEndpoint {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		body, ok := responses[r.Method+" "+r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		if r.Method == "POST" {
+			w.WriteHeader(http.StatusCreated)
+		}
+		_, _ = io.WriteString(w, body)
+	}))
+
+	client, err := NewClient(&Config{
+		Address:    ts.URL,
+		Token:      "insert-your-token-here",
+		HTTPClient: ts.Client(),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return client, ts.Close
+}
+
+func ExampleOrganizations_Read() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/hashicorp": `{"data":{"id":"hashicorp","type":"organizations","attributes":{"name":"hashicorp","email":"info@example.com","cost-estimation-enabled":true}}}`,
+	})
+	defer done()
+
+	org, err := client.Organizations.Read(context.Background(), "hashicorp")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(org.Name, org.Email, org.CostEstimationEnabled)
+	// Output: hashicorp info@example.com true
+}
+
+func ExampleOrganizationMemberships_List() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/hashicorp/organization-memberships": `{"data":[
+			{"id":"ou-1","type":"organization-memberships","attributes":{"email":"alice@example.com","status":"active"}},
+			{"id":"ou-2","type":"organization-memberships","attributes":{"email":"bob@example.com","status":"invited"}}
+		]}`,
+	})
+	defer done()
+
+	ml, err := client.OrganizationMemberships.List(context.Background(), "hashicorp", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, m := range ml.Items {
+		fmt.Println(m.Email, m.Status)
+	}
+	// Output:
+	// alice@example.com active
+	// bob@example.com invited
+}
+
+func ExampleProjects_List() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/hashicorp/projects": `{"data":[
+			{"id":"prj-1","type":"projects","attributes":{"name":"Default Project"}},
+			{"id":"prj-2","type":"projects","attributes":{"name":"networking"}}
+		],"meta":{"pagination":{"current-page":1,"total-pages":1,"total-count":2}}}`,
+	})
+	defer done()
+
+	pl, err := client.Projects.List(context.Background(), "hashicorp", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println("total:", pl.TotalCount)
+	for _, p := range pl.Items {
+		fmt.Println(p.ID, p.Name)
+	}
+	// Output:
+	// total: 2
+	// prj-1 Default Project
+	// prj-2 networking
+}
+
+func ExampleWorkspaces_Read() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/hashicorp/workspaces/my-app-prod": `{"data":{"id":"ws-123","type":"workspaces","attributes":{"name":"my-app-prod","auto-apply":false,"terraform-version":"1.9.0","execution-mode":"remote"}}}`,
+	})
+	defer done()
+
+	w, err := client.Workspaces.Read(context.Background(), "hashicorp", "my-app-prod")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(w.ID, w.Name, w.TerraformVersion, w.ExecutionMode)
+	// Output: ws-123 my-app-prod 1.9.0 remote
+}
+
+func ExampleVariables_Create() {
+	client, done := newExampleClient(map[string]string{
+		"POST /api/v2/workspaces/ws-123/vars": `{"data":{"id":"var-1","type":"vars","attributes":{"key":"region","value":"eu-west-1","category":"terraform","hcl":false,"sensitive":false}}}`,
+	})
+	defer done()
+
+	v, err := client.Variables.Create(context.Background(), "ws-123", VariableCreateOptions{
+		Key:      String("region"),
+		Value:    String("eu-west-1"),
+		Category: Category(CategoryTerraform),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(v.ID, v.Key, v.Value, v.Category)
+	// Output: var-1 region eu-west-1 terraform
+}
+
+func ExampleRuns_Create() {
+	client, done := newExampleClient(map[string]string{
+		"POST /api/v2/runs": `{"data":{"id":"run-1","type":"runs","attributes":{"message":"Queued by automation","status":"pending","is-destroy":false}}}`,
+	})
+	defer done()
+
+	r, err := client.Runs.Create(context.Background(), RunCreateOptions{
+		Workspace: &Workspace{ID: "ws-123"},
+		Message:   String("Queued by automation"),
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(r.ID, r.Status, r.Message)
+	// Output: run-1 pending Queued by automation
+}
+
+func ExampleRunEvents_List() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/runs/run-1/run-events": `{"data":[
+			{"id":"re-1","type":"run-events","attributes":{"action":"queued","description":"Run was queued"}},
+			{"id":"re-2","type":"run-events","attributes":{"action":"commented","description":"Approved"}}
+		]}`,
+	})
+	defer done()
+
+	rl, err := client.RunEvents.List(context.Background(), "run-1", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, e := range rl.Items {
+		fmt.Println(e.Action, "-", e.Description)
+	}
+	// Output:
+	// queued - Run was queued
+	// commented - Approved
+}
+
+func ExampleComments_Create() {
+	client, done := newExampleClient(map[string]string{
+		"POST /api/v2/runs/run-1/comments": `{"data":{"id":"wsc-1","type":"comments","attributes":{"body":"Approved in #ops"}}}`,
+	})
+	defer done()
+
+	c, err := client.Comments.Create(context.Background(), "run-1", CommentCreateOptions{
+		Body: "Approved in #ops",
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(c.ID, c.Body)
+	// Output: wsc-1 Approved in #ops
+}
+
+func ExampleTeams_Read() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/teams/team-1": `{"data":{"id":"team-1","type":"teams","attributes":{"name":"owners","visibility":"secret","users-count":3}}}`,
+	})
+	defer done()
+
+	team, err := client.Teams.Read(context.Background(), "team-1")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(team.Name, team.Visibility, team.UserCount)
+	// Output: owners secret 3
+}
+
+func ExampleAgentPools_List() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/hashicorp/agent-pools": `{"data":[
+			{"id":"apool-1","type":"agent-pools","attributes":{"name":"on-prem","agent-count":4,"organization-scoped":true}}
+		]}`,
+	})
+	defer done()
+
+	pl, err := client.AgentPools.List(context.Background(), "hashicorp", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, p := range pl.Items {
+		fmt.Println(p.Name, p.AgentCount, p.OrganizationScoped)
+	}
+	// Output: on-prem 4 true
+}
+
+func ExampleStateVersions_ReadCurrent() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-123/current-state-version": `{"data":{"id":"sv-1","type":"state-versions","attributes":{"serial":42,"status":"finalized"}}}`,
+	})
+	defer done()
+
+	sv, err := client.StateVersions.ReadCurrent(context.Background(), "ws-123")
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(sv.ID, sv.Serial, sv.Status)
+	// Output: sv-1 42 finalized
+}
+
+func ExampleUsers_ReadCurrent() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/account/details": `{"data":{"id":"user-1","type":"users","attributes":{"username":"admin","email":"admin@example.com"}}}`,
+	})
+	defer done()
+
+	u, err := client.Users.ReadCurrent(context.Background())
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(u.Username, u.Email)
+	// Output: admin admin@example.com
+}
+
+func ExampleVariableSets_List() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/hashicorp/varsets": `{"data":[
+			{"id":"varset-1","type":"varsets","attributes":{"name":"aws-credentials","global":false,"priority":true}}
+		]}`,
+	})
+	defer done()
+
+	vl, err := client.VariableSets.List(context.Background(), "hashicorp", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, vs := range vl.Items {
+		fmt.Println(vs.Name, vs.Global, vs.Priority)
+	}
+	// Output: aws-credentials false true
+}
+
+func ExampleWorkspaces_ReadByID() {
+	client, done := newExampleClient(map[string]string{})
+	defer done()
+
+	// Errors returned by the API are mapped to the errors exported by this
+	// package whenever possible.
+	_, err := client.Workspaces.ReadByID(context.Background(), "ws-missing")
+	fmt.Println(errors.Is(err, ErrResourceNotFound))
+	// Output: true
+}
+
+func ExampleClient_NewRequest() {
+	client, done := newExampleClient(map[string]string{
+		"POST /api/v2/organizations/hashicorp/widgets": `{"data":{"id":"wdg-1","type":"widgets","attributes":{"name":"blue"}}}`,
+	})
+	defer done()
+
+	// Models of an endpoint the client does not cover are tagged like the
+	// models of the services.
+	type Widget struct {
+		ID   string `jsonapi:"primary,widgets"`
+		Name string `jsonapi:"attr,name"`
+	}
+
+	req, err := client.NewRequest("POST", "organizations/hashicorp/widgets", &Widget{Name: "blue"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	w := &Widget{}
+	if err := req.Do(context.Background(), w); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(w.ID, w.Name)
+	// Output: wdg-1 blue
+}
+
+func ExampleClient_NewRequest_list() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/hashicorp/widgets": `{"data":[
+			{"id":"wdg-1","type":"widgets","attributes":{"name":"blue"}},
+			{"id":"wdg-2","type":"widgets","attributes":{"name":"light-blue"}}
+		],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2,"total-count":3}}}`,
+	})
+	defer done()
+
+	type Widget struct {
+		ID   string `jsonapi:"primary,widgets"`
+		Name string `jsonapi:"attr,name"`
+	}
+
+	// A list is decoded along with its pagination into a struct embedding
+	// *Pagination with an Items field.
+	type WidgetList struct {
+		*Pagination
+		Items []*Widget
+	}
+
+	// Query parameters are encoded from the url tags of the request body.
+	type WidgetListOptions struct {
+		ListOptions
+		Search string `url:"search[name],omitempty"`
+	}
+
+	req, err := client.NewRequest("GET", "organizations/hashicorp/widgets", &WidgetListOptions{Search: "blue"})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	wl := &WidgetList{}
+	if err := req.Do(context.Background(), wl); err != nil {
+		log.Fatal(err)
+	}
+
+	for _, w := range wl.Items {
+		fmt.Println(w.ID, w.Name)
+	}
+	fmt.Println("next page:", wl.NextPage)
+	// Output:
+	// wdg-1 blue
+	// wdg-2 light-blue
+	// next page: 2
+}
+
+func ExampleClientRequest_Do() {
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/hashicorp/widgets/wdg-1": `{"data":{"id":"wdg-1","type":"widgets","attributes":{"name":"blue"}}}`,
+	})
+	defer done()
+
+	req, err := client.NewRequest("GET", "organizations/hashicorp/widgets/wdg-1", nil)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Decoding into an io.Writer returns the raw response body.
+	var raw bytes.Buffer
+	if err := req.Do(context.Background(), &raw); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Println(raw.String())
+	// Output: {"data":{"id":"wdg-1","type":"widgets","attributes":{"name":"blue"}}}
+}
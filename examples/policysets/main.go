@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func main() {
+	ctx := context.Background()
+	client, err := tfe.NewClient(&tfe.Config{
+		RetryServerErrors: true,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create a policy set
+	ps, err := client.PolicySets.Create(ctx, "hashicorp", tfe.PolicySetCreateOptions{
+		Name: tfe.String("my-policy-set"),
+		Kind: tfe.Sentinel,
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Create a policy set version, upload the policies and wait until they
+	// are ingested
+	psv, err := client.PolicySetVersions.UploadAndWait(ctx, ps.ID, "test-fixtures/policy-set", 5*time.Minute)
+	var psvErr *tfe.PolicySetVersionError
+	if errors.As(err, &psvErr) {
+		log.Fatalf("Policies of %s could not be ingested: %s", psvErr.PolicySetVersionID, psvErr.Message)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("Policy set version %s is %s", psv.ID, psv.Status)
+}
@@ -4,11 +4,8 @@
 package main
 
 import (
-	"bufio"
 	"context"
-	"encoding/json"
 	"fmt"
-	"io"
 	"log"
 	"os"
 	"time"
@@ -17,91 +14,36 @@ import (
 )
 
 var (
-	pollInterval = 500 * time.Millisecond
+	pollBackoff = tfe.Backoff{Min: 500 * time.Millisecond, Max: 5 * time.Second}
 )
 
-// Diagnostic represents a diagnostic type message from Terraform, which is how errors
-// are usually represented.
-type Diagnostic struct {
-	Severity string           `json:"severity"`
-	Summary  string           `json:"summary"`
-	Detail   string           `json:"detail"`
-	Address  string           `json:"address,omitempty"`
-	Range    *DiagnosticRange `json:"range,omitempty"`
-}
-
-// Pos represents a position in the source code.
-type Pos struct {
-	// Line is a one-based count for the line in the indicated file.
-	Line int `json:"line"`
-
-	// Column is a one-based count of Unicode characters from the start of the line.
-	Column int `json:"column"`
-
-	// Byte is a zero-based offset into the indicated file.
-	Byte int `json:"byte"`
-}
-
-// DiagnosticRange represents the filename and position of the diagnostic subject.
-type DiagnosticRange struct {
-	Filename string `json:"filename"`
-	Start    Pos    `json:"start"`
-	End      Pos    `json:"end"`
-}
+// logRunErrors prints the error diagnostics reported by the plan or apply of
+// a run.
+func logRunErrors(ctx context.Context, client *tfe.Client, run *tfe.Run) {
+	diags, err := client.Runs.ReadDiagnostics(ctx, run.ID)
+	if err != nil {
+		log.Fatal("Failed to read run diagnostics: ", err)
+	}
 
-// For full decoding, see https://github.com/hashicorp/terraform/blob/main/internal/command/jsonformat/renderer.go
-type JSONLog struct {
-	Message    string      `json:"@message"`
-	Level      string      `json:"@level"`
-	Timestamp  string      `json:"@timestamp"`
-	Type       string      `json:"type"`
-	Diagnostic *Diagnostic `json:"diagnostic"`
-}
+	for _, diag := range diags {
+		if diag.Severity != "error" {
+			continue
+		}
 
-// Given a
-func logErrorsOnly(reader io.Reader) {
-	scanner := bufio.NewScanner(reader)
-	for scanner.Scan() {
-		var jsonLog JSONLog
-		err := json.Unmarshal([]byte(scanner.Text()), &jsonLog)
-		// It's possible this log is not encoded as JSON at all, so errors will be ignored.
-		if err == nil && jsonLog.Level == "error" {
-			fmt.Println()
-			fmt.Println("--- Error Message")
-			fmt.Println(jsonLog.Message)
+		fmt.Println()
+		fmt.Printf("--- Error during %s\n", diag.Stage)
+		fmt.Println(diag.Summary)
+		fmt.Println("---")
+		fmt.Println()
+		if diag.Detail != "" {
+			fmt.Println("--- Diagnostic Details")
+			fmt.Println(diag.Detail)
 			fmt.Println("---")
 			fmt.Println()
-			if jsonLog.Type == "diagnostic" {
-				fmt.Println("--- Diagnostic Details")
-				fmt.Println(jsonLog.Diagnostic.Detail)
-				fmt.Println("---")
-				fmt.Println()
-			}
 		}
 	}
 }
 
-func logRunErrors(ctx context.Context, client *tfe.Client, run *tfe.Run) {
-	var reader io.Reader
-	var err error
-
-	if run.Apply != nil && run.Apply.Status == tfe.ApplyErrored {
-		log.Printf("Reading apply logs from %q", run.Apply.LogReadURL)
-		reader, err = client.Applies.Logs(ctx, run.Apply.ID)
-	} else if run.Plan != nil && run.Plan.Status == tfe.PlanErrored {
-		log.Printf("Reading apply logs from %q", run.Plan.LogReadURL)
-		reader, err = client.Plans.Logs(ctx, run.Plan.ID)
-	} else {
-		log.Fatal("Failed to find an errored plan or apply.")
-	}
-
-	if err != nil {
-		log.Fatal("Failed to read error log: ", err)
-	}
-
-	logErrorsOnly(reader)
-}
-
 func readRun(ctx context.Context, client *tfe.Client, id string) *tfe.Run {
 	r, err := client.Runs.ReadWithOptions(ctx, id, &tfe.RunReadOptions{
 		Include: []tfe.RunIncludeOpt{tfe.RunApply, tfe.RunPlan},
@@ -131,8 +73,10 @@ func main() {
 	r := readRun(ctx, client, os.Args[1])
 
 poll:
-	for {
-		<-time.After(pollInterval)
+	for reads := 0; ; reads++ {
+		if err := pollBackoff.Wait(ctx, reads, nil); err != nil {
+			log.Fatal("Failed to wait for the run: ", err)
+		}
 
 		r := readRun(ctx, client, r.ID)
 
@@ -22,11 +22,15 @@ mockgen -source=admin_workspace.go -destination=mocks/admin_workspace_mocks.go -
 mockgen -source=agent.go -destination=mocks/agents.go -package=mocks
 mockgen -source=agent_pool.go -destination=mocks/agent_pool_mocks.go -package=mocks
 mockgen -source=agent_token.go -destination=mocks/agent_token_mocks.go -package=mocks
+mockgen -source=analytics.go -destination=mocks/analytics_mocks.go -package=mocks
 mockgen -source=apply.go -destination=mocks/apply_mocks.go -package=mocks
 mockgen -source=audit_trail.go -destination=mocks/audit_trail_mocks.go -package=mocks
+mockgen -source=authentication_token.go -destination=mocks/authentication_token_mocks.go -package=mocks
+mockgen -source=campaign.go -destination=mocks/campaign_mocks.go -package=mocks
 mockgen -source=comment.go -destination=mocks/comment_mocks.go -package=mocks
 mockgen -source=configuration_version.go -destination=mocks/configuration_version_mocks.go -package=mocks
 mockgen -source=cost_estimate.go -destination=mocks/cost_estimate_mocks.go -package=mocks
+mockgen -source=diagnostics.go -destination=mocks/diagnostics_mocks.go -package=mocks
 mockgen -source=github_app_installation.go -destination=mocks/github_app_installation_mocks.go -package=mocks
 mockgen -source=gpg_key.go -destination=mocks/gpg_key_mocks.go -package=mocks
 mockgen -source=ip_ranges.go -destination=mocks/ip_ranges_mocks.go -package=mocks
@@ -55,6 +59,7 @@ mockgen -source=run_trigger.go -destination=mocks/run_trigger_mocks.go -package=
 mockgen -source=ssh_key.go -destination=mocks/ssh_key_mocks.go -package=mocks
 mockgen -source=state_version.go -destination=mocks/state_version_mocks.go -package=mocks
 mockgen -source=state_version_output.go -destination=mocks/state_version_output_mocks.go -package=mocks
+mockgen -source=structured_run_output.go -destination=mocks/structured_run_output_mocks.go -package=mocks
 mockgen -source=tag.go -destination=mocks/tag_mocks.go -package=mocks
 mockgen -source=task_result.go -destination=mocks/task_result_mocks.go -package=mocks
 mockgen -source=task_stages.go -destination=mocks/task_stages_mocks.go -package=mocks
@@ -73,6 +78,7 @@ mockgen -source=variable_set_variable.go -destination=mocks/variable_set_variabl
 mockgen -source=workspace.go -destination=mocks/workspace_mocks.go -package=mocks
 mockgen -source=workspace_run_task.go -destination=mocks/workspace_run_tasks_mocks.go -package=mocks
 mockgen -source=policy_evaluation.go -destination=mocks/policy_evaluation.go -package=mocks
+mockgen -source=preview.go -destination=mocks/preview_mocks.go -package=mocks
 mockgen -source=project.go -destination=mocks/project_mocks.go -package=mocks
 mockgen -source=registry_no_code_module.go -destination=mocks/registry_no_code_module_mocks.go -package=mocks
 mockgen -source=registry_module.go -destination=mocks/registry_module_mocks.go -package=mocks
@@ -16,6 +16,26 @@ type internalRunTask struct {
 
 	Organization      *Organization               `jsonapi:"relation,organization"`
 	WorkspaceRunTasks []*internalWorkspaceRunTask `jsonapi:"relation,workspace-tasks"`
+
+	// The global configuration, when reported as a relationship rather than
+	// as an attribute. It is a polyrelation so that relationships of other
+	// types are ignored.
+	GlobalRelation *internalGlobalRunTaskChoice `jsonapi:"polyrelation,global-configuration,omitempty"`
+}
+
+// internalGlobalRunTaskChoice holds the global configuration relationship of
+// a run task.
+type internalGlobalRunTaskChoice struct {
+	GlobalRunTask *internalGlobalRunTask
+}
+
+// A private struct we need for unmarshalling the global configuration
+// relationship of a run task
+type internalGlobalRunTask struct {
+	ID               string               `jsonapi:"primary,global-run-tasks"`
+	Enabled          bool                 `jsonapi:"attr,enabled"`
+	Stages           []string             `jsonapi:"attr,stages"`
+	EnforcementLevel TaskEnforcementLevel `jsonapi:"attr,enforcement-level"`
 }
 
 // Due to https://github.com/google/jsonapi/issues/74 we must first unmarshall using map[string]interface{}
@@ -42,6 +62,22 @@ func (irt internalRunTask) ToRunTask() *RunTask {
 	}
 	obj.WorkspaceRunTasks = workspaceTasks
 
+	// Use the global configuration relationship when it was included, which
+	// is known by its enforcement level being set
+	if irt.RawGlobal == nil && irt.GlobalRelation != nil {
+		if g := irt.GlobalRelation.GlobalRunTask; g != nil && g.EnforcementLevel != "" {
+			obj.Global = &GlobalRunTask{
+				Enabled:          g.Enabled,
+				EnforcementLevel: g.EnforcementLevel,
+				Stages:           make([]Stage, len(g.Stages)),
+			}
+			for idx, stage := range g.Stages {
+				obj.Global.Stages[idx] = Stage(stage)
+			}
+		}
+		return &obj
+	}
+
 	// Check if the global configuration exists
 	if val, ok := irt.RawGlobal["enabled"]; !ok {
 		// The enabled property is required so we can assume now that the
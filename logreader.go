@@ -8,10 +8,8 @@ import (
 	"errors"
 	"fmt"
 	"io"
-	"math"
 	"net/http"
 	"net/url"
-	"time"
 )
 
 // LogReader implements io.Reader for streaming logs.
@@ -34,14 +32,13 @@ func (r *LogReader) Read(l []byte) (int, error) {
 	// Loop until we can any data, the context is canceled or the
 	// run is finsished. If we would return right away without any
 	// data, we could end up causing a io.ErrNoProgress error.
+	b := r.client.pollingBackoff(logBackoff)
 	for r.reads = 1; ; r.reads++ {
-		select {
-		case <-r.ctx.Done():
-			return 0, r.ctx.Err()
-		case <-time.After(backoff(500, 2000, r.reads)):
-			if written, err := r.read(l); !errors.Is(err, io.ErrNoProgress) {
-				return written, err
-			}
+		if err := b.Wait(r.ctx, r.reads, nil); err != nil {
+			return 0, err
+		}
+		if written, err := r.read(l); !errors.Is(err, io.ErrNoProgress) {
+			return written, err
 		}
 	}
 }
@@ -134,13 +131,3 @@ func (r *LogReader) read(l []byte) (int, error) {
 	}
 	return 0, io.ErrNoProgress
 }
-
-// backoff will perform exponential backoff based on the iteration and
-// limited by the provided min and max (in milliseconds) durations.
-func backoff(min, max float64, iter int) time.Duration {
-	backoff := math.Pow(2, float64(iter)/5) * min
-	if backoff > max {
-		backoff = max
-	}
-	return time.Duration(backoff) * time.Millisecond
-}
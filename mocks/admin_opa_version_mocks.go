@@ -55,6 +55,21 @@ func (mr *MockAdminOPAVersionsMockRecorder) Create(ctx, options any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAdminOPAVersions)(nil).Create), ctx, options)
 }
 
+// CreateOrUpdate mocks base method.
+func (m *MockAdminOPAVersions) CreateOrUpdate(ctx context.Context, options tfe.AdminOPAVersionCreateOptions) (*tfe.AdminOPAVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, options)
+	ret0, _ := ret[0].(*tfe.AdminOPAVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockAdminOPAVersionsMockRecorder) CreateOrUpdate(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockAdminOPAVersions)(nil).CreateOrUpdate), ctx, options)
+}
+
 // Delete mocks base method.
 func (m *MockAdminOPAVersions) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -55,6 +55,21 @@ func (mr *MockAdminSentinelVersionsMockRecorder) Create(ctx, options any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockAdminSentinelVersions)(nil).Create), ctx, options)
 }
 
+// CreateOrUpdate mocks base method.
+func (m *MockAdminSentinelVersions) CreateOrUpdate(ctx context.Context, options tfe.AdminSentinelVersionCreateOptions) (*tfe.AdminSentinelVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateOrUpdate", ctx, options)
+	ret0, _ := ret[0].(*tfe.AdminSentinelVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateOrUpdate indicates an expected call of CreateOrUpdate.
+func (mr *MockAdminSentinelVersionsMockRecorder) CreateOrUpdate(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateOrUpdate", reflect.TypeOf((*MockAdminSentinelVersions)(nil).CreateOrUpdate), ctx, options)
+}
+
 // Delete mocks base method.
 func (m *MockAdminSentinelVersions) Delete(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -55,6 +55,21 @@ func (mr *MockSAMLSettingsMockRecorder) Read(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockSAMLSettings)(nil).Read), ctx)
 }
 
+// ReadCertificates mocks base method.
+func (m *MockSAMLSettings) ReadCertificates(ctx context.Context) (*tfe.AdminSAMLCertificates, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCertificates", ctx)
+	ret0, _ := ret[0].(*tfe.AdminSAMLCertificates)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCertificates indicates an expected call of ReadCertificates.
+func (mr *MockSAMLSettingsMockRecorder) ReadCertificates(ctx any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCertificates", reflect.TypeOf((*MockSAMLSettings)(nil).ReadCertificates), ctx)
+}
+
 // RevokeIdpCert mocks base method.
 func (m *MockSAMLSettings) RevokeIdpCert(ctx context.Context) (*tfe.AdminSAMLSetting, error) {
 	m.ctrl.T.Helper()
@@ -70,6 +85,36 @@ func (mr *MockSAMLSettingsMockRecorder) RevokeIdpCert(ctx any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeIdpCert", reflect.TypeOf((*MockSAMLSettings)(nil).RevokeIdpCert), ctx)
 }
 
+// RotateCertificate mocks base method.
+func (m *MockSAMLSettings) RotateCertificate(ctx context.Context, certificate, privateKey string) (*tfe.AdminSAMLSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateCertificate", ctx, certificate, privateKey)
+	ret0, _ := ret[0].(*tfe.AdminSAMLSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateCertificate indicates an expected call of RotateCertificate.
+func (mr *MockSAMLSettingsMockRecorder) RotateCertificate(ctx, certificate, privateKey any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateCertificate", reflect.TypeOf((*MockSAMLSettings)(nil).RotateCertificate), ctx, certificate, privateKey)
+}
+
+// RotateIdpCert mocks base method.
+func (m *MockSAMLSettings) RotateIdpCert(ctx context.Context, idpCert string) (*tfe.AdminSAMLSetting, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateIdpCert", ctx, idpCert)
+	ret0, _ := ret[0].(*tfe.AdminSAMLSetting)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateIdpCert indicates an expected call of RotateIdpCert.
+func (mr *MockSAMLSettingsMockRecorder) RotateIdpCert(ctx, idpCert any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateIdpCert", reflect.TypeOf((*MockSAMLSettings)(nil).RotateIdpCert), ctx, idpCert)
+}
+
 // Update mocks base method.
 func (m *MockSAMLSettings) Update(ctx context.Context, options tfe.AdminSAMLSettingsUpdateOptions) (*tfe.AdminSAMLSetting, error) {
 	m.ctrl.T.Helper()
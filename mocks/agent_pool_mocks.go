@@ -40,6 +40,36 @@ func (m *MockAgentPools) EXPECT() *MockAgentPoolsMockRecorder {
 	return m.recorder
 }
 
+// AllowProject mocks base method.
+func (m *MockAgentPools) AllowProject(ctx context.Context, agentPoolID, projectID string) (*tfe.AgentPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowProject", ctx, agentPoolID, projectID)
+	ret0, _ := ret[0].(*tfe.AgentPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowProject indicates an expected call of AllowProject.
+func (mr *MockAgentPoolsMockRecorder) AllowProject(ctx, agentPoolID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowProject", reflect.TypeOf((*MockAgentPools)(nil).AllowProject), ctx, agentPoolID, projectID)
+}
+
+// AllowWorkspace mocks base method.
+func (m *MockAgentPools) AllowWorkspace(ctx context.Context, agentPoolID, workspaceID string) (*tfe.AgentPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllowWorkspace", ctx, agentPoolID, workspaceID)
+	ret0, _ := ret[0].(*tfe.AgentPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllowWorkspace indicates an expected call of AllowWorkspace.
+func (mr *MockAgentPoolsMockRecorder) AllowWorkspace(ctx, agentPoolID, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllowWorkspace", reflect.TypeOf((*MockAgentPools)(nil).AllowWorkspace), ctx, agentPoolID, workspaceID)
+}
+
 // Create mocks base method.
 func (m *MockAgentPools) Create(ctx context.Context, organization string, options tfe.AgentPoolCreateOptions) (*tfe.AgentPool, error) {
 	m.ctrl.T.Helper()
@@ -69,6 +99,36 @@ func (mr *MockAgentPoolsMockRecorder) Delete(ctx, agentPoolID any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockAgentPools)(nil).Delete), ctx, agentPoolID)
 }
 
+// DisallowProject mocks base method.
+func (m *MockAgentPools) DisallowProject(ctx context.Context, agentPoolID, projectID string) (*tfe.AgentPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisallowProject", ctx, agentPoolID, projectID)
+	ret0, _ := ret[0].(*tfe.AgentPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisallowProject indicates an expected call of DisallowProject.
+func (mr *MockAgentPoolsMockRecorder) DisallowProject(ctx, agentPoolID, projectID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisallowProject", reflect.TypeOf((*MockAgentPools)(nil).DisallowProject), ctx, agentPoolID, projectID)
+}
+
+// DisallowWorkspace mocks base method.
+func (m *MockAgentPools) DisallowWorkspace(ctx context.Context, agentPoolID, workspaceID string) (*tfe.AgentPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisallowWorkspace", ctx, agentPoolID, workspaceID)
+	ret0, _ := ret[0].(*tfe.AgentPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisallowWorkspace indicates an expected call of DisallowWorkspace.
+func (mr *MockAgentPoolsMockRecorder) DisallowWorkspace(ctx, agentPoolID, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisallowWorkspace", reflect.TypeOf((*MockAgentPools)(nil).DisallowWorkspace), ctx, agentPoolID, workspaceID)
+}
+
 // List mocks base method.
 func (m *MockAgentPools) List(ctx context.Context, organization string, options *tfe.AgentPoolListOptions) (*tfe.AgentPoolList, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +159,21 @@ func (mr *MockAgentPoolsMockRecorder) Read(ctx, agentPoolID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockAgentPools)(nil).Read), ctx, agentPoolID)
 }
 
+// ReadUtilization mocks base method.
+func (m *MockAgentPools) ReadUtilization(ctx context.Context, agentPoolID string) (*tfe.AgentPoolUtilization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadUtilization", ctx, agentPoolID)
+	ret0, _ := ret[0].(*tfe.AgentPoolUtilization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadUtilization indicates an expected call of ReadUtilization.
+func (mr *MockAgentPoolsMockRecorder) ReadUtilization(ctx, agentPoolID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadUtilization", reflect.TypeOf((*MockAgentPools)(nil).ReadUtilization), ctx, agentPoolID)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockAgentPools) ReadWithOptions(ctx context.Context, agentPoolID string, options *tfe.AgentPoolReadOptions) (*tfe.AgentPool, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +204,21 @@ func (mr *MockAgentPoolsMockRecorder) Update(ctx, agentPool, options any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockAgentPools)(nil).Update), ctx, agentPool, options)
 }
 
+// UpdateAllowedProjects mocks base method.
+func (m *MockAgentPools) UpdateAllowedProjects(ctx context.Context, agentPool string, options tfe.AgentPoolAllowedProjectsUpdateOptions) (*tfe.AgentPool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAllowedProjects", ctx, agentPool, options)
+	ret0, _ := ret[0].(*tfe.AgentPool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAllowedProjects indicates an expected call of UpdateAllowedProjects.
+func (mr *MockAgentPoolsMockRecorder) UpdateAllowedProjects(ctx, agentPool, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAllowedProjects", reflect.TypeOf((*MockAgentPools)(nil).UpdateAllowedProjects), ctx, agentPool, options)
+}
+
 // UpdateAllowedWorkspaces mocks base method.
 func (m *MockAgentPools) UpdateAllowedWorkspaces(ctx context.Context, agentPool string, options tfe.AgentPoolAllowedWorkspacesUpdateOptions) (*tfe.AgentPool, error) {
 	m.ctrl.T.Helper()
@@ -143,3 +233,17 @@ func (mr *MockAgentPoolsMockRecorder) UpdateAllowedWorkspaces(ctx, agentPool, op
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAllowedWorkspaces", reflect.TypeOf((*MockAgentPools)(nil).UpdateAllowedWorkspaces), ctx, agentPool, options)
 }
+
+// ValidateForWorkspace mocks base method.
+func (m *MockAgentPools) ValidateForWorkspace(ctx context.Context, agentPoolID, workspaceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateForWorkspace", ctx, agentPoolID, workspaceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ValidateForWorkspace indicates an expected call of ValidateForWorkspace.
+func (mr *MockAgentPoolsMockRecorder) ValidateForWorkspace(ctx, agentPoolID, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateForWorkspace", reflect.TypeOf((*MockAgentPools)(nil).ValidateForWorkspace), ctx, agentPoolID, workspaceID)
+}
@@ -100,6 +100,35 @@ func (mr *MockConfigurationVersionsMockRecorder) Download(ctx, cvID any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockConfigurationVersions)(nil).Download), ctx, cvID)
 }
 
+// DownloadCurrent mocks base method.
+func (m *MockConfigurationVersions) DownloadCurrent(ctx context.Context, workspaceID string, dst io.Writer) (*tfe.ConfigurationVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadCurrent", ctx, workspaceID, dst)
+	ret0, _ := ret[0].(*tfe.ConfigurationVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadCurrent indicates an expected call of DownloadCurrent.
+func (mr *MockConfigurationVersionsMockRecorder) DownloadCurrent(ctx, workspaceID, dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadCurrent", reflect.TypeOf((*MockConfigurationVersions)(nil).DownloadCurrent), ctx, workspaceID, dst)
+}
+
+// DownloadTo mocks base method.
+func (m *MockConfigurationVersions) DownloadTo(ctx context.Context, cvID string, dst io.Writer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadTo", ctx, cvID, dst)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadTo indicates an expected call of DownloadTo.
+func (mr *MockConfigurationVersionsMockRecorder) DownloadTo(ctx, cvID, dst any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadTo", reflect.TypeOf((*MockConfigurationVersions)(nil).DownloadTo), ctx, cvID, dst)
+}
+
 // List mocks base method.
 func (m *MockConfigurationVersions) List(ctx context.Context, workspaceID string, options *tfe.ConfigurationVersionListOptions) (*tfe.ConfigurationVersionList, error) {
 	m.ctrl.T.Helper()
@@ -144,6 +173,36 @@ func (mr *MockConfigurationVersionsMockRecorder) Read(ctx, cvID any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockConfigurationVersions)(nil).Read), ctx, cvID)
 }
 
+// ReadIngressAttributes mocks base method.
+func (m *MockConfigurationVersions) ReadIngressAttributes(ctx context.Context, cvID string) (*tfe.IngressAttributes, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadIngressAttributes", ctx, cvID)
+	ret0, _ := ret[0].(*tfe.IngressAttributes)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadIngressAttributes indicates an expected call of ReadIngressAttributes.
+func (mr *MockConfigurationVersionsMockRecorder) ReadIngressAttributes(ctx, cvID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadIngressAttributes", reflect.TypeOf((*MockConfigurationVersions)(nil).ReadIngressAttributes), ctx, cvID)
+}
+
+// ReadReadme mocks base method.
+func (m *MockConfigurationVersions) ReadReadme(ctx context.Context, cvID string) (*tfe.ConfigurationReadme, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReadme", ctx, cvID)
+	ret0, _ := ret[0].(*tfe.ConfigurationReadme)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReadme indicates an expected call of ReadReadme.
+func (mr *MockConfigurationVersionsMockRecorder) ReadReadme(ctx, cvID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReadme", reflect.TypeOf((*MockConfigurationVersions)(nil).ReadReadme), ctx, cvID)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockConfigurationVersions) ReadWithOptions(ctx context.Context, cvID string, options *tfe.ConfigurationVersionReadOptions) (*tfe.ConfigurationVersion, error) {
 	m.ctrl.T.Helper()
@@ -214,3 +273,17 @@ func (mr *MockConfigurationVersionsMockRecorder) UploadTarGzip(ctx, url, archive
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTarGzip", reflect.TypeOf((*MockConfigurationVersions)(nil).UploadTarGzip), ctx, url, archive)
 }
+
+// UploadTarGzipWithOptions mocks base method.
+func (m *MockConfigurationVersions) UploadTarGzipWithOptions(ctx context.Context, url string, archive io.ReadSeeker, options tfe.UploadOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadTarGzipWithOptions", ctx, url, archive, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadTarGzipWithOptions indicates an expected call of UploadTarGzipWithOptions.
+func (mr *MockConfigurationVersionsMockRecorder) UploadTarGzipWithOptions(ctx, url, archive, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTarGzipWithOptions", reflect.TypeOf((*MockConfigurationVersions)(nil).UploadTarGzipWithOptions), ctx, url, archive, options)
+}
@@ -70,3 +70,18 @@ func (mr *MockCostEstimatesMockRecorder) Read(ctx, costEstimateID any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockCostEstimates)(nil).Read), ctx, costEstimateID)
 }
+
+// ReadJSONOutput mocks base method.
+func (m *MockCostEstimates) ReadJSONOutput(ctx context.Context, costEstimateID string) (*tfe.CostEstimateJSONOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadJSONOutput", ctx, costEstimateID)
+	ret0, _ := ret[0].(*tfe.CostEstimateJSONOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadJSONOutput indicates an expected call of ReadJSONOutput.
+func (mr *MockCostEstimatesMockRecorder) ReadJSONOutput(ctx, costEstimateID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadJSONOutput", reflect.TypeOf((*MockCostEstimates)(nil).ReadJSONOutput), ctx, costEstimateID)
+}
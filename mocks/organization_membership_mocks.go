@@ -69,6 +69,21 @@ func (mr *MockOrganizationMembershipsMockRecorder) Delete(ctx, organizationMembe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockOrganizationMemberships)(nil).Delete), ctx, organizationMembershipID)
 }
 
+// InviteBatch mocks base method.
+func (m *MockOrganizationMemberships) InviteBatch(ctx context.Context, organization string, options tfe.OrganizationMembershipInviteBatchOptions) ([]*tfe.OrganizationMembershipInviteResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InviteBatch", ctx, organization, options)
+	ret0, _ := ret[0].([]*tfe.OrganizationMembershipInviteResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// InviteBatch indicates an expected call of InviteBatch.
+func (mr *MockOrganizationMembershipsMockRecorder) InviteBatch(ctx, organization, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InviteBatch", reflect.TypeOf((*MockOrganizationMemberships)(nil).InviteBatch), ctx, organization, options)
+}
+
 // List mocks base method.
 func (m *MockOrganizationMemberships) List(ctx context.Context, organization string, options *tfe.OrganizationMembershipListOptions) (*tfe.OrganizationMembershipList, error) {
 	m.ctrl.T.Helper()
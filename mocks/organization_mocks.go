@@ -158,6 +158,21 @@ func (mr *MockOrganizationsMockRecorder) ReadDataRetentionPolicyChoice(ctx, orga
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDataRetentionPolicyChoice", reflect.TypeOf((*MockOrganizations)(nil).ReadDataRetentionPolicyChoice), ctx, organization)
 }
 
+// ReadDefaultSettings mocks base method.
+func (m *MockOrganizations) ReadDefaultSettings(ctx context.Context, organization string) (*tfe.OrganizationDefaultSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDefaultSettings", ctx, organization)
+	ret0, _ := ret[0].(*tfe.OrganizationDefaultSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDefaultSettings indicates an expected call of ReadDefaultSettings.
+func (mr *MockOrganizationsMockRecorder) ReadDefaultSettings(ctx, organization any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDefaultSettings", reflect.TypeOf((*MockOrganizations)(nil).ReadDefaultSettings), ctx, organization)
+}
+
 // ReadEntitlements mocks base method.
 func (m *MockOrganizations) ReadEntitlements(ctx context.Context, organization string) (*tfe.Entitlements, error) {
 	m.ctrl.T.Helper()
@@ -188,6 +203,21 @@ func (mr *MockOrganizationsMockRecorder) ReadRunQueue(ctx, organization, options
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadRunQueue", reflect.TypeOf((*MockOrganizations)(nil).ReadRunQueue), ctx, organization, options)
 }
 
+// ReadTagBindingUsage mocks base method.
+func (m *MockOrganizations) ReadTagBindingUsage(ctx context.Context, organization string) ([]*tfe.TagBindingKeyUsage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadTagBindingUsage", ctx, organization)
+	ret0, _ := ret[0].([]*tfe.TagBindingKeyUsage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadTagBindingUsage indicates an expected call of ReadTagBindingUsage.
+func (mr *MockOrganizationsMockRecorder) ReadTagBindingUsage(ctx, organization any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadTagBindingUsage", reflect.TypeOf((*MockOrganizations)(nil).ReadTagBindingUsage), ctx, organization)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockOrganizations) ReadWithOptions(ctx context.Context, organization string, options tfe.OrganizationReadOptions) (*tfe.Organization, error) {
 	m.ctrl.T.Helper()
@@ -203,6 +233,21 @@ func (mr *MockOrganizationsMockRecorder) ReadWithOptions(ctx, organization, opti
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockOrganizations)(nil).ReadWithOptions), ctx, organization, options)
 }
 
+// ReadWorkspaceSummary mocks base method.
+func (m *MockOrganizations) ReadWorkspaceSummary(ctx context.Context, organization string) (*tfe.OrganizationWorkspaceSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWorkspaceSummary", ctx, organization)
+	ret0, _ := ret[0].(*tfe.OrganizationWorkspaceSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWorkspaceSummary indicates an expected call of ReadWorkspaceSummary.
+func (mr *MockOrganizationsMockRecorder) ReadWorkspaceSummary(ctx, organization any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWorkspaceSummary", reflect.TypeOf((*MockOrganizations)(nil).ReadWorkspaceSummary), ctx, organization)
+}
+
 // SetDataRetentionPolicy mocks base method.
 func (m *MockOrganizations) SetDataRetentionPolicy(ctx context.Context, organization string, options tfe.DataRetentionPolicySetOptions) (*tfe.DataRetentionPolicy, error) {
 	m.ctrl.T.Helper()
@@ -262,3 +307,48 @@ func (mr *MockOrganizationsMockRecorder) Update(ctx, organization, options any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockOrganizations)(nil).Update), ctx, organization, options)
 }
+
+// UpdateAttributes mocks base method.
+func (m *MockOrganizations) UpdateAttributes(ctx context.Context, organization string, attributes map[string]any) (*tfe.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttributes", ctx, organization, attributes)
+	ret0, _ := ret[0].(*tfe.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttributes indicates an expected call of UpdateAttributes.
+func (mr *MockOrganizationsMockRecorder) UpdateAttributes(ctx, organization, attributes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttributes", reflect.TypeOf((*MockOrganizations)(nil).UpdateAttributes), ctx, organization, attributes)
+}
+
+// UpdateDefaultSettings mocks base method.
+func (m *MockOrganizations) UpdateDefaultSettings(ctx context.Context, organization string, options tfe.OrganizationDefaultSettingsOptions) (*tfe.OrganizationDefaultSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDefaultSettings", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.OrganizationDefaultSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDefaultSettings indicates an expected call of UpdateDefaultSettings.
+func (mr *MockOrganizationsMockRecorder) UpdateDefaultSettings(ctx, organization, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDefaultSettings", reflect.TypeOf((*MockOrganizations)(nil).UpdateDefaultSettings), ctx, organization, options)
+}
+
+// UpdateRelationship mocks base method.
+func (m *MockOrganizations) UpdateRelationship(ctx context.Context, organization string, options tfe.RelationshipUpdateOptions) (*tfe.Organization, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRelationship", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.Organization)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRelationship indicates an expected call of UpdateRelationship.
+func (mr *MockOrganizationsMockRecorder) UpdateRelationship(ctx, organization, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRelationship", reflect.TypeOf((*MockOrganizations)(nil).UpdateRelationship), ctx, organization, options)
+}
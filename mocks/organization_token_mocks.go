@@ -127,3 +127,18 @@ func (mr *MockOrganizationTokensMockRecorder) ReadWithOptions(ctx, organization,
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockOrganizationTokens)(nil).ReadWithOptions), ctx, organization, options)
 }
+
+// Rotate mocks base method.
+func (m *MockOrganizationTokens) Rotate(ctx context.Context, organization string, options tfe.OrganizationTokenCreateOptions) (*tfe.OrganizationTokenRotation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, organization, options)
+	ret0, _ := ret[0].(*tfe.OrganizationTokenRotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockOrganizationTokensMockRecorder) Rotate(ctx, organization, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockOrganizationTokens)(nil).Rotate), ctx, organization, options)
+}
@@ -11,6 +11,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -84,6 +85,35 @@ func (mr *MockPlanExportsMockRecorder) Download(ctx, planExportID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockPlanExports)(nil).Download), ctx, planExportID)
 }
 
+// DownloadReader mocks base method.
+func (m *MockPlanExports) DownloadReader(ctx context.Context, planExportID string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadReader", ctx, planExportID)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadReader indicates an expected call of DownloadReader.
+func (mr *MockPlanExportsMockRecorder) DownloadReader(ctx, planExportID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadReader", reflect.TypeOf((*MockPlanExports)(nil).DownloadReader), ctx, planExportID)
+}
+
+// DownloadTo mocks base method.
+func (m *MockPlanExports) DownloadTo(ctx context.Context, planExportID, dir string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadTo", ctx, planExportID, dir)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DownloadTo indicates an expected call of DownloadTo.
+func (mr *MockPlanExportsMockRecorder) DownloadTo(ctx, planExportID, dir any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadTo", reflect.TypeOf((*MockPlanExports)(nil).DownloadTo), ctx, planExportID, dir)
+}
+
 // Read mocks base method.
 func (m *MockPlanExports) Read(ctx context.Context, planExportID string) (*tfe.PlanExport, error) {
 	m.ctrl.T.Helper()
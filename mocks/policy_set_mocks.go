@@ -155,6 +155,21 @@ func (mr *MockPolicySetsMockRecorder) Read(ctx, policySetID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockPolicySets)(nil).Read), ctx, policySetID)
 }
 
+// ReadScope mocks base method.
+func (m *MockPolicySets) ReadScope(ctx context.Context, policySetID string) (*tfe.PolicySetScope, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadScope", ctx, policySetID)
+	ret0, _ := ret[0].(*tfe.PolicySetScope)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadScope indicates an expected call of ReadScope.
+func (mr *MockPolicySetsMockRecorder) ReadScope(ctx, policySetID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadScope", reflect.TypeOf((*MockPolicySets)(nil).ReadScope), ctx, policySetID)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockPolicySets) ReadWithOptions(ctx context.Context, policySetID string, options *tfe.PolicySetReadOptions) (*tfe.PolicySet, error) {
 	m.ctrl.T.Helper()
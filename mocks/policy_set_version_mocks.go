@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	gomock "go.uber.org/mock/gomock"
@@ -83,3 +84,18 @@ func (mr *MockPolicySetVersionsMockRecorder) Upload(ctx, psv, path any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Upload", reflect.TypeOf((*MockPolicySetVersions)(nil).Upload), ctx, psv, path)
 }
+
+// UploadAndWait mocks base method.
+func (m *MockPolicySetVersions) UploadAndWait(ctx context.Context, policySetID, path string, timeout time.Duration) (*tfe.PolicySetVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadAndWait", ctx, policySetID, path, timeout)
+	ret0, _ := ret[0].(*tfe.PolicySetVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadAndWait indicates an expected call of UploadAndWait.
+func (mr *MockPolicySetVersionsMockRecorder) UploadAndWait(ctx, policySetID, path, timeout any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadAndWait", reflect.TypeOf((*MockPolicySetVersions)(nil).UploadAndWait), ctx, policySetID, path, timeout)
+}
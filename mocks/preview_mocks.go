@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: preview.go
+//
+// Generated by this command:
+//
+//	mockgen -source=preview.go -destination=mocks/preview_mocks.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	tfe "github.com/hashicorp/go-tfe"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPreviews is a mock of Previews interface.
+type MockPreviews struct {
+	ctrl     *gomock.Controller
+	recorder *MockPreviewsMockRecorder
+}
+
+// MockPreviewsMockRecorder is the mock recorder for MockPreviews.
+type MockPreviewsMockRecorder struct {
+	mock *MockPreviews
+}
+
+// NewMockPreviews creates a new mock instance.
+func NewMockPreviews(ctrl *gomock.Controller) *MockPreviews {
+	mock := &MockPreviews{ctrl: ctrl}
+	mock.recorder = &MockPreviewsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPreviews) EXPECT() *MockPreviewsMockRecorder {
+	return m.recorder
+}
+
+// CreateForPR mocks base method.
+func (m *MockPreviews) CreateForPR(ctx context.Context, options tfe.PreviewOptions) (*tfe.Preview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateForPR", ctx, options)
+	ret0, _ := ret[0].(*tfe.Preview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateForPR indicates an expected call of CreateForPR.
+func (mr *MockPreviewsMockRecorder) CreateForPR(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateForPR", reflect.TypeOf((*MockPreviews)(nil).CreateForPR), ctx, options)
+}
+
+// DestroyForPR mocks base method.
+func (m *MockPreviews) DestroyForPR(ctx context.Context, options tfe.PreviewOptions) (*tfe.Preview, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyForPR", ctx, options)
+	ret0, _ := ret[0].(*tfe.Preview)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyForPR indicates an expected call of DestroyForPR.
+func (mr *MockPreviewsMockRecorder) DestroyForPR(ctx, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyForPR", reflect.TypeOf((*MockPreviews)(nil).DestroyForPR), ctx, options)
+}
@@ -114,18 +114,18 @@ func (mr *MockProjectsMockRecorder) List(ctx, organization, options any) *gomock
 }
 
 // ListEffectiveTagBindings mocks base method.
-func (m *MockProjects) ListEffectiveTagBindings(ctx context.Context, workspaceID string) ([]*tfe.EffectiveTagBinding, error) {
+func (m *MockProjects) ListEffectiveTagBindings(ctx context.Context, projectID string) ([]*tfe.EffectiveTagBinding, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEffectiveTagBindings", ctx, workspaceID)
+	ret := m.ctrl.Call(m, "ListEffectiveTagBindings", ctx, projectID)
 	ret0, _ := ret[0].([]*tfe.EffectiveTagBinding)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // ListEffectiveTagBindings indicates an expected call of ListEffectiveTagBindings.
-func (mr *MockProjectsMockRecorder) ListEffectiveTagBindings(ctx, workspaceID any) *gomock.Call {
+func (mr *MockProjectsMockRecorder) ListEffectiveTagBindings(ctx, projectID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEffectiveTagBindings", reflect.TypeOf((*MockProjects)(nil).ListEffectiveTagBindings), ctx, workspaceID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEffectiveTagBindings", reflect.TypeOf((*MockProjects)(nil).ListEffectiveTagBindings), ctx, projectID)
 }
 
 // ListTagBindings mocks base method.
@@ -143,6 +143,21 @@ func (mr *MockProjectsMockRecorder) ListTagBindings(ctx, projectID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagBindings", reflect.TypeOf((*MockProjects)(nil).ListTagBindings), ctx, projectID)
 }
 
+// MoveWorkspaces mocks base method.
+func (m *MockProjects) MoveWorkspaces(ctx context.Context, projectID string, workspaces []*tfe.Workspace) ([]*tfe.ProjectMoveWorkspaceResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveWorkspaces", ctx, projectID, workspaces)
+	ret0, _ := ret[0].([]*tfe.ProjectMoveWorkspaceResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveWorkspaces indicates an expected call of MoveWorkspaces.
+func (mr *MockProjectsMockRecorder) MoveWorkspaces(ctx, projectID, workspaces any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveWorkspaces", reflect.TypeOf((*MockProjects)(nil).MoveWorkspaces), ctx, projectID, workspaces)
+}
+
 // Read mocks base method.
 func (m *MockProjects) Read(ctx context.Context, projectID string) (*tfe.Project, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +173,50 @@ func (mr *MockProjectsMockRecorder) Read(ctx, projectID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockProjects)(nil).Read), ctx, projectID)
 }
 
+// ReadByName mocks base method.
+func (m *MockProjects) ReadByName(ctx context.Context, organization, name string) (*tfe.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadByName", ctx, organization, name)
+	ret0, _ := ret[0].(*tfe.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadByName indicates an expected call of ReadByName.
+func (mr *MockProjectsMockRecorder) ReadByName(ctx, organization, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByName", reflect.TypeOf((*MockProjects)(nil).ReadByName), ctx, organization, name)
+}
+
+// ReadWithOptions mocks base method.
+func (m *MockProjects) ReadWithOptions(ctx context.Context, projectID string, options *tfe.ProjectReadOptions) (*tfe.Project, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWithOptions", ctx, projectID, options)
+	ret0, _ := ret[0].(*tfe.Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWithOptions indicates an expected call of ReadWithOptions.
+func (mr *MockProjectsMockRecorder) ReadWithOptions(ctx, projectID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockProjects)(nil).ReadWithOptions), ctx, projectID, options)
+}
+
+// RemoveTagBindings mocks base method.
+func (m *MockProjects) RemoveTagBindings(ctx context.Context, projectID string, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTagBindings", ctx, projectID, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTagBindings indicates an expected call of RemoveTagBindings.
+func (mr *MockProjectsMockRecorder) RemoveTagBindings(ctx, projectID, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagBindings", reflect.TypeOf((*MockProjects)(nil).RemoveTagBindings), ctx, projectID, keys)
+}
+
 // Update mocks base method.
 func (m *MockProjects) Update(ctx context.Context, projectID string, options tfe.ProjectUpdateOptions) (*tfe.Project, error) {
 	m.ctrl.T.Helper()
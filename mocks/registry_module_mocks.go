@@ -142,6 +142,21 @@ func (mr *MockRegistryModulesMockRecorder) DeleteVersion(ctx, moduleID, version
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVersion", reflect.TypeOf((*MockRegistryModules)(nil).DeleteVersion), ctx, moduleID, version)
 }
 
+// DeprecateVersion mocks base method.
+func (m *MockRegistryModules) DeprecateVersion(ctx context.Context, moduleID tfe.RegistryModuleID, version string, options tfe.RegistryModuleVersionDeprecateOptions) (*tfe.RegistryModuleVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeprecateVersion", ctx, moduleID, version, options)
+	ret0, _ := ret[0].(*tfe.RegistryModuleVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeprecateVersion indicates an expected call of DeprecateVersion.
+func (mr *MockRegistryModulesMockRecorder) DeprecateVersion(ctx, moduleID, version, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeprecateVersion", reflect.TypeOf((*MockRegistryModules)(nil).DeprecateVersion), ctx, moduleID, version, options)
+}
+
 // List mocks base method.
 func (m *MockRegistryModules) List(ctx context.Context, organization string, options *tfe.RegistryModuleListOptions) (*tfe.RegistryModuleList, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +187,36 @@ func (mr *MockRegistryModulesMockRecorder) ListCommits(ctx, moduleID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCommits", reflect.TypeOf((*MockRegistryModules)(nil).ListCommits), ctx, moduleID)
 }
 
+// PublishLocalVersion mocks base method.
+func (m *MockRegistryModules) PublishLocalVersion(ctx context.Context, moduleID tfe.RegistryModuleID, version, sourceDir string) (*tfe.RegistryModuleVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PublishLocalVersion", ctx, moduleID, version, sourceDir)
+	ret0, _ := ret[0].(*tfe.RegistryModuleVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PublishLocalVersion indicates an expected call of PublishLocalVersion.
+func (mr *MockRegistryModulesMockRecorder) PublishLocalVersion(ctx, moduleID, version, sourceDir any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublishLocalVersion", reflect.TypeOf((*MockRegistryModules)(nil).PublishLocalVersion), ctx, moduleID, version, sourceDir)
+}
+
+// QueueReleaseRuns mocks base method.
+func (m *MockRegistryModules) QueueReleaseRuns(ctx context.Context, moduleID tfe.RegistryModuleID, options tfe.RegistryModuleReleaseRunsOptions) ([]*tfe.RegistryModuleReleaseRun, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueReleaseRuns", ctx, moduleID, options)
+	ret0, _ := ret[0].([]*tfe.RegistryModuleReleaseRun)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueReleaseRuns indicates an expected call of QueueReleaseRuns.
+func (mr *MockRegistryModulesMockRecorder) QueueReleaseRuns(ctx, moduleID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueReleaseRuns", reflect.TypeOf((*MockRegistryModules)(nil).QueueReleaseRuns), ctx, moduleID, options)
+}
+
 // Read mocks base method.
 func (m *MockRegistryModules) Read(ctx context.Context, moduleID tfe.RegistryModuleID) (*tfe.RegistryModule, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +247,21 @@ func (mr *MockRegistryModulesMockRecorder) ReadVersion(ctx, moduleID, version an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadVersion", reflect.TypeOf((*MockRegistryModules)(nil).ReadVersion), ctx, moduleID, version)
 }
 
+// RevertDeprecation mocks base method.
+func (m *MockRegistryModules) RevertDeprecation(ctx context.Context, moduleID tfe.RegistryModuleID, version string) (*tfe.RegistryModuleVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertDeprecation", ctx, moduleID, version)
+	ret0, _ := ret[0].(*tfe.RegistryModuleVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertDeprecation indicates an expected call of RevertDeprecation.
+func (mr *MockRegistryModulesMockRecorder) RevertDeprecation(ctx, moduleID, version any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertDeprecation", reflect.TypeOf((*MockRegistryModules)(nil).RevertDeprecation), ctx, moduleID, version)
+}
+
 // Update mocks base method.
 func (m *MockRegistryModules) Update(ctx context.Context, moduleID tfe.RegistryModuleID, options tfe.RegistryModuleUpdateOptions) (*tfe.RegistryModule, error) {
 	m.ctrl.T.Helper()
@@ -244,3 +304,17 @@ func (mr *MockRegistryModulesMockRecorder) UploadTarGzip(ctx, url, r any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTarGzip", reflect.TypeOf((*MockRegistryModules)(nil).UploadTarGzip), ctx, url, r)
 }
+
+// UploadTarGzipWithOptions mocks base method.
+func (m *MockRegistryModules) UploadTarGzipWithOptions(ctx context.Context, url string, r io.ReadSeeker, options tfe.UploadOptions) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadTarGzipWithOptions", ctx, url, r, options)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UploadTarGzipWithOptions indicates an expected call of UploadTarGzipWithOptions.
+func (mr *MockRegistryModulesMockRecorder) UploadTarGzipWithOptions(ctx, url, r, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTarGzipWithOptions", reflect.TypeOf((*MockRegistryModules)(nil).UploadTarGzipWithOptions), ctx, url, r, options)
+}
@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	gomock "go.uber.org/mock/gomock"
@@ -68,6 +69,21 @@ func (mr *MockRunsMockRecorder) Cancel(ctx, runID, options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockRuns)(nil).Cancel), ctx, runID, options)
 }
 
+// CancelOrDiscardAndWait mocks base method.
+func (m *MockRuns) CancelOrDiscardAndWait(ctx context.Context, runID string, options tfe.RunCancelOrDiscardOptions) (*tfe.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelOrDiscardAndWait", ctx, runID, options)
+	ret0, _ := ret[0].(*tfe.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelOrDiscardAndWait indicates an expected call of CancelOrDiscardAndWait.
+func (mr *MockRunsMockRecorder) CancelOrDiscardAndWait(ctx, runID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelOrDiscardAndWait", reflect.TypeOf((*MockRuns)(nil).CancelOrDiscardAndWait), ctx, runID, options)
+}
+
 // Create mocks base method.
 func (m *MockRuns) Create(ctx context.Context, options tfe.RunCreateOptions) (*tfe.Run, error) {
 	m.ctrl.T.Helper()
@@ -97,6 +113,21 @@ func (mr *MockRunsMockRecorder) Discard(ctx, runID, options any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Discard", reflect.TypeOf((*MockRuns)(nil).Discard), ctx, runID, options)
 }
 
+// DiscardOlderThan mocks base method.
+func (m *MockRuns) DiscardOlderThan(ctx context.Context, workspaceID string, before time.Time, options tfe.RunDiscardOptions) ([]*tfe.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscardOlderThan", ctx, workspaceID, before, options)
+	ret0, _ := ret[0].([]*tfe.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscardOlderThan indicates an expected call of DiscardOlderThan.
+func (mr *MockRunsMockRecorder) DiscardOlderThan(ctx, workspaceID, before, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscardOlderThan", reflect.TypeOf((*MockRuns)(nil).DiscardOlderThan), ctx, workspaceID, before, options)
+}
+
 // ForceCancel mocks base method.
 func (m *MockRuns) ForceCancel(ctx context.Context, runID string, options tfe.RunForceCancelOptions) error {
 	m.ctrl.T.Helper()
@@ -140,6 +171,21 @@ func (mr *MockRunsMockRecorder) List(ctx, workspaceID, options any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRuns)(nil).List), ctx, workspaceID, options)
 }
 
+// ListOlderThan mocks base method.
+func (m *MockRuns) ListOlderThan(ctx context.Context, workspaceID string, before time.Time, options *tfe.RunListOptions) ([]*tfe.Run, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOlderThan", ctx, workspaceID, before, options)
+	ret0, _ := ret[0].([]*tfe.Run)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOlderThan indicates an expected call of ListOlderThan.
+func (mr *MockRunsMockRecorder) ListOlderThan(ctx, workspaceID, before, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOlderThan", reflect.TypeOf((*MockRuns)(nil).ListOlderThan), ctx, workspaceID, before, options)
+}
+
 // Read mocks base method.
 func (m *MockRuns) Read(ctx context.Context, runID string) (*tfe.Run, error) {
 	m.ctrl.T.Helper()
@@ -155,6 +201,21 @@ func (mr *MockRunsMockRecorder) Read(ctx, runID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockRuns)(nil).Read), ctx, runID)
 }
 
+// ReadDiagnostics mocks base method.
+func (m *MockRuns) ReadDiagnostics(ctx context.Context, runID string) ([]*tfe.RunDiagnostic, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadDiagnostics", ctx, runID)
+	ret0, _ := ret[0].([]*tfe.RunDiagnostic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadDiagnostics indicates an expected call of ReadDiagnostics.
+func (mr *MockRunsMockRecorder) ReadDiagnostics(ctx, runID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDiagnostics", reflect.TypeOf((*MockRuns)(nil).ReadDiagnostics), ctx, runID)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockRuns) ReadWithOptions(ctx context.Context, runID string, options *tfe.RunReadOptions) (*tfe.Run, error) {
 	m.ctrl.T.Helper()
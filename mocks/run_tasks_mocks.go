@@ -12,6 +12,7 @@ package mocks
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	gomock "go.uber.org/mock/gomock"
@@ -114,6 +115,21 @@ func (mr *MockRunTasksMockRecorder) Read(ctx, runTaskID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockRunTasks)(nil).Read), ctx, runTaskID)
 }
 
+// ReadGlobalConfiguration mocks base method.
+func (m *MockRunTasks) ReadGlobalConfiguration(ctx context.Context, runTaskID string) (*tfe.GlobalRunTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadGlobalConfiguration", ctx, runTaskID)
+	ret0, _ := ret[0].(*tfe.GlobalRunTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadGlobalConfiguration indicates an expected call of ReadGlobalConfiguration.
+func (mr *MockRunTasksMockRecorder) ReadGlobalConfiguration(ctx, runTaskID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadGlobalConfiguration", reflect.TypeOf((*MockRunTasks)(nil).ReadGlobalConfiguration), ctx, runTaskID)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockRunTasks) ReadWithOptions(ctx context.Context, runTaskID string, options *tfe.RunTaskReadOptions) (*tfe.RunTask, error) {
 	m.ctrl.T.Helper()
@@ -129,6 +145,21 @@ func (mr *MockRunTasksMockRecorder) ReadWithOptions(ctx, runTaskID, options any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockRunTasks)(nil).ReadWithOptions), ctx, runTaskID, options)
 }
 
+// RecentExecutions mocks base method.
+func (m *MockRunTasks) RecentExecutions(ctx context.Context, runTaskID string, window time.Duration) ([]*tfe.RunTaskExecution, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecentExecutions", ctx, runTaskID, window)
+	ret0, _ := ret[0].([]*tfe.RunTaskExecution)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecentExecutions indicates an expected call of RecentExecutions.
+func (mr *MockRunTasksMockRecorder) RecentExecutions(ctx, runTaskID, window any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecentExecutions", reflect.TypeOf((*MockRunTasks)(nil).RecentExecutions), ctx, runTaskID, window)
+}
+
 // Update mocks base method.
 func (m *MockRunTasks) Update(ctx context.Context, runTaskID string, options tfe.RunTaskUpdateOptions) (*tfe.RunTask, error) {
 	m.ctrl.T.Helper()
@@ -143,3 +174,18 @@ func (mr *MockRunTasksMockRecorder) Update(ctx, runTaskID, options any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockRunTasks)(nil).Update), ctx, runTaskID, options)
 }
+
+// UpdateGlobalConfiguration mocks base method.
+func (m *MockRunTasks) UpdateGlobalConfiguration(ctx context.Context, runTaskID string, options tfe.GlobalRunTaskOptions) (*tfe.GlobalRunTask, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGlobalConfiguration", ctx, runTaskID, options)
+	ret0, _ := ret[0].(*tfe.GlobalRunTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGlobalConfiguration indicates an expected call of UpdateGlobalConfiguration.
+func (mr *MockRunTasksMockRecorder) UpdateGlobalConfiguration(ctx, runTaskID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalConfiguration", reflect.TypeOf((*MockRunTasks)(nil).UpdateGlobalConfiguration), ctx, runTaskID, options)
+}
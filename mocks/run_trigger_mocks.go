@@ -40,6 +40,21 @@ func (m *MockRunTriggers) EXPECT() *MockRunTriggersMockRecorder {
 	return m.recorder
 }
 
+// BulkCreate mocks base method.
+func (m *MockRunTriggers) BulkCreate(ctx context.Context, workspaceID string, options tfe.RunTriggerBulkCreateOptions) ([]*tfe.RunTriggerCreateResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BulkCreate", ctx, workspaceID, options)
+	ret0, _ := ret[0].([]*tfe.RunTriggerCreateResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// BulkCreate indicates an expected call of BulkCreate.
+func (mr *MockRunTriggersMockRecorder) BulkCreate(ctx, workspaceID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BulkCreate", reflect.TypeOf((*MockRunTriggers)(nil).BulkCreate), ctx, workspaceID, options)
+}
+
 // Create mocks base method.
 func (m *MockRunTriggers) Create(ctx context.Context, workspaceID string, options tfe.RunTriggerCreateOptions) (*tfe.RunTrigger, error) {
 	m.ctrl.T.Helper()
@@ -84,6 +99,36 @@ func (mr *MockRunTriggersMockRecorder) List(ctx, workspaceID, options any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockRunTriggers)(nil).List), ctx, workspaceID, options)
 }
 
+// ListInbound mocks base method.
+func (m *MockRunTriggers) ListInbound(ctx context.Context, workspaceID string, options *tfe.RunTriggerListInboundOptions) ([]*tfe.RunTrigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInbound", ctx, workspaceID, options)
+	ret0, _ := ret[0].([]*tfe.RunTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInbound indicates an expected call of ListInbound.
+func (mr *MockRunTriggersMockRecorder) ListInbound(ctx, workspaceID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInbound", reflect.TypeOf((*MockRunTriggers)(nil).ListInbound), ctx, workspaceID, options)
+}
+
+// ListOutbound mocks base method.
+func (m *MockRunTriggers) ListOutbound(ctx context.Context, workspaceID string) ([]*tfe.RunTrigger, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOutbound", ctx, workspaceID)
+	ret0, _ := ret[0].([]*tfe.RunTrigger)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOutbound indicates an expected call of ListOutbound.
+func (mr *MockRunTriggersMockRecorder) ListOutbound(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOutbound", reflect.TypeOf((*MockRunTriggers)(nil).ListOutbound), ctx, workspaceID)
+}
+
 // Read mocks base method.
 func (m *MockRunTriggers) Read(ctx context.Context, RunTriggerID string) (*tfe.RunTrigger, error) {
 	m.ctrl.T.Helper()
@@ -11,6 +11,7 @@ package mocks
 
 import (
 	context "context"
+	io "io"
 	reflect "reflect"
 
 	tfe "github.com/hashicorp/go-tfe"
@@ -70,6 +71,21 @@ func (mr *MockStateVersionsMockRecorder) Download(ctx, url any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Download", reflect.TypeOf((*MockStateVersions)(nil).Download), ctx, url)
 }
 
+// DownloadStream mocks base method.
+func (m *MockStateVersions) DownloadStream(ctx context.Context, url string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadStream", ctx, url)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadStream indicates an expected call of DownloadStream.
+func (mr *MockStateVersionsMockRecorder) DownloadStream(ctx, url any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadStream", reflect.TypeOf((*MockStateVersions)(nil).DownloadStream), ctx, url)
+}
+
 // List mocks base method.
 func (m *MockStateVersions) List(ctx context.Context, options *tfe.StateVersionListOptions) (*tfe.StateVersionList, error) {
 	m.ctrl.T.Helper()
@@ -144,6 +160,21 @@ func (mr *MockStateVersionsMockRecorder) ReadCurrent(ctx, workspaceID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCurrent", reflect.TypeOf((*MockStateVersions)(nil).ReadCurrent), ctx, workspaceID)
 }
 
+// ReadCurrentSummary mocks base method.
+func (m *MockStateVersions) ReadCurrentSummary(ctx context.Context, workspaceID string) (*tfe.StateSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCurrentSummary", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.StateSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCurrentSummary indicates an expected call of ReadCurrentSummary.
+func (mr *MockStateVersionsMockRecorder) ReadCurrentSummary(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCurrentSummary", reflect.TypeOf((*MockStateVersions)(nil).ReadCurrentSummary), ctx, workspaceID)
+}
+
 // ReadCurrentWithOptions mocks base method.
 func (m *MockStateVersions) ReadCurrentWithOptions(ctx context.Context, workspaceID string, options *tfe.StateVersionCurrentOptions) (*tfe.StateVersion, error) {
 	m.ctrl.T.Helper()
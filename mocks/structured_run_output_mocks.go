@@ -0,0 +1,71 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: structured_run_output.go
+//
+// Generated by this command:
+//
+//	mockgen -source=structured_run_output.go -destination=mocks/structured_run_output_mocks.go -package=mocks
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	tfe "github.com/hashicorp/go-tfe"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockStructuredRunOutputs is a mock of StructuredRunOutputs interface.
+type MockStructuredRunOutputs struct {
+	ctrl     *gomock.Controller
+	recorder *MockStructuredRunOutputsMockRecorder
+}
+
+// MockStructuredRunOutputsMockRecorder is the mock recorder for MockStructuredRunOutputs.
+type MockStructuredRunOutputsMockRecorder struct {
+	mock *MockStructuredRunOutputs
+}
+
+// NewMockStructuredRunOutputs creates a new mock instance.
+func NewMockStructuredRunOutputs(ctrl *gomock.Controller) *MockStructuredRunOutputs {
+	mock := &MockStructuredRunOutputs{ctrl: ctrl}
+	mock.recorder = &MockStructuredRunOutputsMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStructuredRunOutputs) EXPECT() *MockStructuredRunOutputsMockRecorder {
+	return m.recorder
+}
+
+// ListResourceChanges mocks base method.
+func (m *MockStructuredRunOutputs) ListResourceChanges(ctx context.Context, planID string, options *tfe.PlanResourceChangeListOptions) (*tfe.PlanResourceChangeList, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceChanges", ctx, planID, options)
+	ret0, _ := ret[0].(*tfe.PlanResourceChangeList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceChanges indicates an expected call of ListResourceChanges.
+func (mr *MockStructuredRunOutputsMockRecorder) ListResourceChanges(ctx, planID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceChanges", reflect.TypeOf((*MockStructuredRunOutputs)(nil).ListResourceChanges), ctx, planID, options)
+}
+
+// ReadPlan mocks base method.
+func (m *MockStructuredRunOutputs) ReadPlan(ctx context.Context, planID string) (*tfe.RedactedPlan, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadPlan", ctx, planID)
+	ret0, _ := ret[0].(*tfe.RedactedPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadPlan indicates an expected call of ReadPlan.
+func (mr *MockStructuredRunOutputsMockRecorder) ReadPlan(ctx, planID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadPlan", reflect.TypeOf((*MockStructuredRunOutputs)(nil).ReadPlan), ctx, planID)
+}
@@ -40,6 +40,21 @@ func (m *MockTaskStages) EXPECT() *MockTaskStagesMockRecorder {
 	return m.recorder
 }
 
+// AwaitCompletion mocks base method.
+func (m *MockTaskStages) AwaitCompletion(ctx context.Context, taskStageID string) (*tfe.TaskStage, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AwaitCompletion", ctx, taskStageID)
+	ret0, _ := ret[0].(*tfe.TaskStage)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AwaitCompletion indicates an expected call of AwaitCompletion.
+func (mr *MockTaskStagesMockRecorder) AwaitCompletion(ctx, taskStageID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AwaitCompletion", reflect.TypeOf((*MockTaskStages)(nil).AwaitCompletion), ctx, taskStageID)
+}
+
 // List mocks base method.
 func (m *MockTaskStages) List(ctx context.Context, runID string, options *tfe.TaskStageListOptions) (*tfe.TaskStageList, error) {
 	m.ctrl.T.Helper()
@@ -84,6 +84,21 @@ func (mr *MockTeamsMockRecorder) List(ctx, organization, options any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockTeams)(nil).List), ctx, organization, options)
 }
 
+// MapSSOTeamIDs mocks base method.
+func (m *MockTeams) MapSSOTeamIDs(ctx context.Context, organization string, mapping map[string]string) ([]*tfe.TeamSSOMappingResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MapSSOTeamIDs", ctx, organization, mapping)
+	ret0, _ := ret[0].([]*tfe.TeamSSOMappingResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MapSSOTeamIDs indicates an expected call of MapSSOTeamIDs.
+func (mr *MockTeamsMockRecorder) MapSSOTeamIDs(ctx, organization, mapping any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MapSSOTeamIDs", reflect.TypeOf((*MockTeams)(nil).MapSSOTeamIDs), ctx, organization, mapping)
+}
+
 // Read mocks base method.
 func (m *MockTeams) Read(ctx context.Context, teamID string) (*tfe.Team, error) {
 	m.ctrl.T.Helper()
@@ -99,6 +114,36 @@ func (mr *MockTeamsMockRecorder) Read(ctx, teamID any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTeams)(nil).Read), ctx, teamID)
 }
 
+// ReadBySSOTeamID mocks base method.
+func (m *MockTeams) ReadBySSOTeamID(ctx context.Context, organization, ssoTeamID string) (*tfe.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadBySSOTeamID", ctx, organization, ssoTeamID)
+	ret0, _ := ret[0].(*tfe.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadBySSOTeamID indicates an expected call of ReadBySSOTeamID.
+func (mr *MockTeamsMockRecorder) ReadBySSOTeamID(ctx, organization, ssoTeamID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadBySSOTeamID", reflect.TypeOf((*MockTeams)(nil).ReadBySSOTeamID), ctx, organization, ssoTeamID)
+}
+
+// ReadWithOptions mocks base method.
+func (m *MockTeams) ReadWithOptions(ctx context.Context, teamID string, options *tfe.TeamReadOptions) (*tfe.Team, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadWithOptions", ctx, teamID, options)
+	ret0, _ := ret[0].(*tfe.Team)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadWithOptions indicates an expected call of ReadWithOptions.
+func (mr *MockTeamsMockRecorder) ReadWithOptions(ctx, teamID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadWithOptions", reflect.TypeOf((*MockTeams)(nil).ReadWithOptions), ctx, teamID, options)
+}
+
 // Update mocks base method.
 func (m *MockTeams) Update(ctx context.Context, teamID string, options tfe.TeamUpdateOptions) (*tfe.Team, error) {
 	m.ctrl.T.Helper()
@@ -128,3 +128,18 @@ func (mr *MockTestRunsMockRecorder) Read(ctx, moduleID, testRunID any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockTestRuns)(nil).Read), ctx, moduleID, testRunID)
 }
+
+// ReadResults mocks base method.
+func (m *MockTestRuns) ReadResults(ctx context.Context, moduleID tfe.RegistryModuleID, testRunID string) (*tfe.TestRunResults, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadResults", ctx, moduleID, testRunID)
+	ret0, _ := ret[0].(*tfe.TestRunResults)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadResults indicates an expected call of ReadResults.
+func (mr *MockTestRunsMockRecorder) ReadResults(ctx, moduleID, testRunID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadResults", reflect.TypeOf((*MockTestRuns)(nil).ReadResults), ctx, moduleID, testRunID)
+}
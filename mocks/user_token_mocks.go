@@ -98,3 +98,18 @@ func (mr *MockUserTokensMockRecorder) Read(ctx, tokenID any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockUserTokens)(nil).Read), ctx, tokenID)
 }
+
+// Rotate mocks base method.
+func (m *MockUserTokens) Rotate(ctx context.Context, userID, tokenID string, options tfe.UserTokenCreateOptions) (*tfe.UserTokenRotation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", ctx, userID, tokenID, options)
+	ret0, _ := ret[0].(*tfe.UserTokenRotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockUserTokensMockRecorder) Rotate(ctx, userID, tokenID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockUserTokens)(nil).Rotate), ctx, userID, tokenID, options)
+}
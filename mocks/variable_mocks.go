@@ -55,6 +55,21 @@ func (mr *MockVariablesMockRecorder) Create(ctx, workspaceID, options any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockVariables)(nil).Create), ctx, workspaceID, options)
 }
 
+// CreateBatch mocks base method.
+func (m *MockVariables) CreateBatch(ctx context.Context, workspaceID string, options []tfe.VariableCreateOptions) ([]*tfe.VariableBatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", ctx, workspaceID, options)
+	ret0, _ := ret[0].([]*tfe.VariableBatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockVariablesMockRecorder) CreateBatch(ctx, workspaceID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockVariables)(nil).CreateBatch), ctx, workspaceID, options)
+}
+
 // Delete mocks base method.
 func (m *MockVariables) Delete(ctx context.Context, workspaceID, variableID string) error {
 	m.ctrl.T.Helper()
@@ -99,6 +114,21 @@ func (mr *MockVariablesMockRecorder) Read(ctx, workspaceID, variableID any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockVariables)(nil).Read), ctx, workspaceID, variableID)
 }
 
+// Sync mocks base method.
+func (m *MockVariables) Sync(ctx context.Context, workspaceID string, desired []tfe.VariableCreateOptions) ([]*tfe.VariableSyncResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Sync", ctx, workspaceID, desired)
+	ret0, _ := ret[0].([]*tfe.VariableSyncResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Sync indicates an expected call of Sync.
+func (mr *MockVariablesMockRecorder) Sync(ctx, workspaceID, desired any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Sync", reflect.TypeOf((*MockVariables)(nil).Sync), ctx, workspaceID, desired)
+}
+
 // Update mocks base method.
 func (m *MockVariables) Update(ctx context.Context, workspaceID, variableID string, options tfe.VariableUpdateOptions) (*tfe.Variable, error) {
 	m.ctrl.T.Helper()
@@ -113,3 +143,48 @@ func (mr *MockVariablesMockRecorder) Update(ctx, workspaceID, variableID, option
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockVariables)(nil).Update), ctx, workspaceID, variableID, options)
 }
+
+// UpdateBatch mocks base method.
+func (m *MockVariables) UpdateBatch(ctx context.Context, workspaceID string, options []tfe.VariableBatchUpdateOptions) ([]*tfe.VariableBatchResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBatch", ctx, workspaceID, options)
+	ret0, _ := ret[0].([]*tfe.VariableBatchResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBatch indicates an expected call of UpdateBatch.
+func (mr *MockVariablesMockRecorder) UpdateBatch(ctx, workspaceID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBatch", reflect.TypeOf((*MockVariables)(nil).UpdateBatch), ctx, workspaceID, options)
+}
+
+// VerifySensitive mocks base method.
+func (m *MockVariables) VerifySensitive(ctx context.Context, workspaceID, key, expectedHash string) (tfe.SensitiveVariableStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySensitive", ctx, workspaceID, key, expectedHash)
+	ret0, _ := ret[0].(tfe.SensitiveVariableStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifySensitive indicates an expected call of VerifySensitive.
+func (mr *MockVariablesMockRecorder) VerifySensitive(ctx, workspaceID, key, expectedHash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySensitive", reflect.TypeOf((*MockVariables)(nil).VerifySensitive), ctx, workspaceID, key, expectedHash)
+}
+
+// WriteSensitive mocks base method.
+func (m *MockVariables) WriteSensitive(ctx context.Context, workspaceID string, options tfe.VariableWriteSensitiveOptions) (*tfe.Variable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WriteSensitive", ctx, workspaceID, options)
+	ret0, _ := ret[0].(*tfe.Variable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WriteSensitive indicates an expected call of WriteSensitive.
+func (mr *MockVariablesMockRecorder) WriteSensitive(ctx, workspaceID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WriteSensitive", reflect.TypeOf((*MockVariables)(nil).WriteSensitive), ctx, workspaceID, options)
+}
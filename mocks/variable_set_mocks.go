@@ -40,6 +40,21 @@ func (m *MockVariableSets) EXPECT() *MockVariableSetsMockRecorder {
 	return m.recorder
 }
 
+// ApplyByFilter mocks base method.
+func (m *MockVariableSets) ApplyByFilter(ctx context.Context, variableSetID string, filter tfe.WorkspaceFilter, options *tfe.VariableSetApplyByFilterOptions) ([]*tfe.VariableSetApplyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyByFilter", ctx, variableSetID, filter, options)
+	ret0, _ := ret[0].([]*tfe.VariableSetApplyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyByFilter indicates an expected call of ApplyByFilter.
+func (mr *MockVariableSetsMockRecorder) ApplyByFilter(ctx, variableSetID, filter, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyByFilter", reflect.TypeOf((*MockVariableSets)(nil).ApplyByFilter), ctx, variableSetID, filter, options)
+}
+
 // ApplyToProjects mocks base method.
 func (m *MockVariableSets) ApplyToProjects(ctx context.Context, variableSetID string, options tfe.VariableSetApplyToProjectsOptions) error {
 	m.ctrl.T.Helper()
@@ -97,6 +112,21 @@ func (mr *MockVariableSetsMockRecorder) Delete(ctx, variableSetID any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockVariableSets)(nil).Delete), ctx, variableSetID)
 }
 
+// EffectiveVariables mocks base method.
+func (m *MockVariableSets) EffectiveVariables(ctx context.Context, workspaceID string) ([]*tfe.EffectiveVariable, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EffectiveVariables", ctx, workspaceID)
+	ret0, _ := ret[0].([]*tfe.EffectiveVariable)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EffectiveVariables indicates an expected call of EffectiveVariables.
+func (mr *MockVariableSetsMockRecorder) EffectiveVariables(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EffectiveVariables", reflect.TypeOf((*MockVariableSets)(nil).EffectiveVariables), ctx, workspaceID)
+}
+
 // List mocks base method.
 func (m *MockVariableSets) List(ctx context.Context, organization string, options *tfe.VariableSetListOptions) (*tfe.VariableSetList, error) {
 	m.ctrl.T.Helper()
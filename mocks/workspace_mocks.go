@@ -13,6 +13,7 @@ import (
 	context "context"
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	tfe "github.com/hashicorp/go-tfe"
 	gomock "go.uber.org/mock/gomock"
@@ -99,6 +100,22 @@ func (mr *MockWorkspacesMockRecorder) AssignSSHKey(ctx, workspaceID, options any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignSSHKey", reflect.TypeOf((*MockWorkspaces)(nil).AssignSSHKey), ctx, workspaceID, options)
 }
 
+// Can mocks base method.
+func (m *MockWorkspaces) Can(ctx context.Context, workspaceID string, operation tfe.WorkspaceOperation) (bool, string, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Can", ctx, workspaceID, operation)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// Can indicates an expected call of Can.
+func (mr *MockWorkspacesMockRecorder) Can(ctx, workspaceID, operation any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Can", reflect.TypeOf((*MockWorkspaces)(nil).Can), ctx, workspaceID, operation)
+}
+
 // Create mocks base method.
 func (m *MockWorkspaces) Create(ctx context.Context, organization string, options tfe.WorkspaceCreateOptions) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -170,6 +187,20 @@ func (mr *MockWorkspacesMockRecorder) DeleteDataRetentionPolicy(ctx, workspaceID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDataRetentionPolicy", reflect.TypeOf((*MockWorkspaces)(nil).DeleteDataRetentionPolicy), ctx, workspaceID)
 }
 
+// DeleteTagBindings mocks base method.
+func (m *MockWorkspaces) DeleteTagBindings(ctx context.Context, workspaceID string, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagBindings", ctx, workspaceID, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteTagBindings indicates an expected call of DeleteTagBindings.
+func (mr *MockWorkspacesMockRecorder) DeleteTagBindings(ctx, workspaceID, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagBindings", reflect.TypeOf((*MockWorkspaces)(nil).DeleteTagBindings), ctx, workspaceID, keys)
+}
+
 // ForceUnlock mocks base method.
 func (m *MockWorkspaces) ForceUnlock(ctx context.Context, workspaceID string) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -185,6 +216,21 @@ func (mr *MockWorkspacesMockRecorder) ForceUnlock(ctx, workspaceID any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ForceUnlock", reflect.TypeOf((*MockWorkspaces)(nil).ForceUnlock), ctx, workspaceID)
 }
 
+// IsRemoteStateConsumer mocks base method.
+func (m *MockWorkspaces) IsRemoteStateConsumer(ctx context.Context, producerID, consumerID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsRemoteStateConsumer", ctx, producerID, consumerID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsRemoteStateConsumer indicates an expected call of IsRemoteStateConsumer.
+func (mr *MockWorkspacesMockRecorder) IsRemoteStateConsumer(ctx, producerID, consumerID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsRemoteStateConsumer", reflect.TypeOf((*MockWorkspaces)(nil).IsRemoteStateConsumer), ctx, producerID, consumerID)
+}
+
 // List mocks base method.
 func (m *MockWorkspaces) List(ctx context.Context, organization string, options *tfe.WorkspaceListOptions) (*tfe.WorkspaceList, error) {
 	m.ctrl.T.Helper()
@@ -200,6 +246,36 @@ func (mr *MockWorkspacesMockRecorder) List(ctx, organization, options any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockWorkspaces)(nil).List), ctx, organization, options)
 }
 
+// ListAll mocks base method.
+func (m *MockWorkspaces) ListAll(ctx context.Context, organization string, options *tfe.WorkspaceListOptions) (<-chan *tfe.Workspace, <-chan error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAll", ctx, organization, options)
+	ret0, _ := ret[0].(<-chan *tfe.Workspace)
+	ret1, _ := ret[1].(<-chan error)
+	return ret0, ret1
+}
+
+// ListAll indicates an expected call of ListAll.
+func (mr *MockWorkspacesMockRecorder) ListAll(ctx, organization, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAll", reflect.TypeOf((*MockWorkspaces)(nil).ListAll), ctx, organization, options)
+}
+
+// ListAllRemoteStateConsumers mocks base method.
+func (m *MockWorkspaces) ListAllRemoteStateConsumers(ctx context.Context, workspaceID string) ([]*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAllRemoteStateConsumers", ctx, workspaceID)
+	ret0, _ := ret[0].([]*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAllRemoteStateConsumers indicates an expected call of ListAllRemoteStateConsumers.
+func (mr *MockWorkspacesMockRecorder) ListAllRemoteStateConsumers(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAllRemoteStateConsumers", reflect.TypeOf((*MockWorkspaces)(nil).ListAllRemoteStateConsumers), ctx, workspaceID)
+}
+
 // ListEffectiveTagBindings mocks base method.
 func (m *MockWorkspaces) ListEffectiveTagBindings(ctx context.Context, workspaceID string) ([]*tfe.EffectiveTagBinding, error) {
 	m.ctrl.T.Helper()
@@ -215,6 +291,21 @@ func (mr *MockWorkspacesMockRecorder) ListEffectiveTagBindings(ctx, workspaceID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEffectiveTagBindings", reflect.TypeOf((*MockWorkspaces)(nil).ListEffectiveTagBindings), ctx, workspaceID)
 }
 
+// ListEffectiveTagBindingsWithOptions mocks base method.
+func (m *MockWorkspaces) ListEffectiveTagBindingsWithOptions(ctx context.Context, workspaceID string, options *tfe.WorkspaceEffectiveTagBindingsListOptions) ([]*tfe.EffectiveTagBinding, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEffectiveTagBindingsWithOptions", ctx, workspaceID, options)
+	ret0, _ := ret[0].([]*tfe.EffectiveTagBinding)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEffectiveTagBindingsWithOptions indicates an expected call of ListEffectiveTagBindingsWithOptions.
+func (mr *MockWorkspacesMockRecorder) ListEffectiveTagBindingsWithOptions(ctx, workspaceID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEffectiveTagBindingsWithOptions", reflect.TypeOf((*MockWorkspaces)(nil).ListEffectiveTagBindingsWithOptions), ctx, workspaceID, options)
+}
+
 // ListRemoteStateConsumers mocks base method.
 func (m *MockWorkspaces) ListRemoteStateConsumers(ctx context.Context, workspaceID string, options *tfe.RemoteStateConsumersListOptions) (*tfe.WorkspaceList, error) {
 	m.ctrl.T.Helper()
@@ -275,6 +366,21 @@ func (mr *MockWorkspacesMockRecorder) Lock(ctx, workspaceID, options any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Lock", reflect.TypeOf((*MockWorkspaces)(nil).Lock), ctx, workspaceID, options)
 }
 
+// PreviewAutoDestroy mocks base method.
+func (m *MockWorkspaces) PreviewAutoDestroy(ctx context.Context, organization string, within time.Duration) ([]*tfe.WorkspaceAutoDestroyStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PreviewAutoDestroy", ctx, organization, within)
+	ret0, _ := ret[0].([]*tfe.WorkspaceAutoDestroyStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PreviewAutoDestroy indicates an expected call of PreviewAutoDestroy.
+func (mr *MockWorkspacesMockRecorder) PreviewAutoDestroy(ctx, organization, within any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PreviewAutoDestroy", reflect.TypeOf((*MockWorkspaces)(nil).PreviewAutoDestroy), ctx, organization, within)
+}
+
 // Read mocks base method.
 func (m *MockWorkspaces) Read(ctx context.Context, organization, workspace string) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -290,6 +396,21 @@ func (mr *MockWorkspacesMockRecorder) Read(ctx, organization, workspace any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Read", reflect.TypeOf((*MockWorkspaces)(nil).Read), ctx, organization, workspace)
 }
 
+// ReadAutoDestroyStatus mocks base method.
+func (m *MockWorkspaces) ReadAutoDestroyStatus(ctx context.Context, workspaceID string) (*tfe.WorkspaceAutoDestroyStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadAutoDestroyStatus", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.WorkspaceAutoDestroyStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadAutoDestroyStatus indicates an expected call of ReadAutoDestroyStatus.
+func (mr *MockWorkspacesMockRecorder) ReadAutoDestroyStatus(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadAutoDestroyStatus", reflect.TypeOf((*MockWorkspaces)(nil).ReadAutoDestroyStatus), ctx, workspaceID)
+}
+
 // ReadByID mocks base method.
 func (m *MockWorkspaces) ReadByID(ctx context.Context, workspaceID string) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -320,6 +441,21 @@ func (mr *MockWorkspacesMockRecorder) ReadByIDWithOptions(ctx, workspaceID, opti
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadByIDWithOptions", reflect.TypeOf((*MockWorkspaces)(nil).ReadByIDWithOptions), ctx, workspaceID, options)
 }
 
+// ReadCurrentConfigurationVersion mocks base method.
+func (m *MockWorkspaces) ReadCurrentConfigurationVersion(ctx context.Context, workspaceID string) (*tfe.ConfigurationVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadCurrentConfigurationVersion", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.ConfigurationVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadCurrentConfigurationVersion indicates an expected call of ReadCurrentConfigurationVersion.
+func (mr *MockWorkspacesMockRecorder) ReadCurrentConfigurationVersion(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadCurrentConfigurationVersion", reflect.TypeOf((*MockWorkspaces)(nil).ReadCurrentConfigurationVersion), ctx, workspaceID)
+}
+
 // ReadDataRetentionPolicy mocks base method.
 func (m *MockWorkspaces) ReadDataRetentionPolicy(ctx context.Context, workspaceID string) (*tfe.DataRetentionPolicy, error) {
 	m.ctrl.T.Helper()
@@ -350,6 +486,81 @@ func (mr *MockWorkspacesMockRecorder) ReadDataRetentionPolicyChoice(ctx, workspa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadDataRetentionPolicyChoice", reflect.TypeOf((*MockWorkspaces)(nil).ReadDataRetentionPolicyChoice), ctx, workspaceID)
 }
 
+// ReadFollowRename mocks base method.
+func (m *MockWorkspaces) ReadFollowRename(ctx context.Context, organization, workspace string) (*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadFollowRename", ctx, organization, workspace)
+	ret0, _ := ret[0].(*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadFollowRename indicates an expected call of ReadFollowRename.
+func (mr *MockWorkspacesMockRecorder) ReadFollowRename(ctx, organization, workspace any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadFollowRename", reflect.TypeOf((*MockWorkspaces)(nil).ReadFollowRename), ctx, organization, workspace)
+}
+
+// ReadLockInfo mocks base method.
+func (m *MockWorkspaces) ReadLockInfo(ctx context.Context, workspaceID string) (*tfe.WorkspaceLockInfo, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadLockInfo", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.WorkspaceLockInfo)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadLockInfo indicates an expected call of ReadLockInfo.
+func (mr *MockWorkspacesMockRecorder) ReadLockInfo(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadLockInfo", reflect.TypeOf((*MockWorkspaces)(nil).ReadLockInfo), ctx, workspaceID)
+}
+
+// ReadMany mocks base method.
+func (m *MockWorkspaces) ReadMany(ctx context.Context, organization string, names []string) (*tfe.WorkspaceReadManyResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadMany", ctx, organization, names)
+	ret0, _ := ret[0].(*tfe.WorkspaceReadManyResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadMany indicates an expected call of ReadMany.
+func (mr *MockWorkspacesMockRecorder) ReadMany(ctx, organization, names any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadMany", reflect.TypeOf((*MockWorkspaces)(nil).ReadMany), ctx, organization, names)
+}
+
+// ReadOutputs mocks base method.
+func (m *MockWorkspaces) ReadOutputs(ctx context.Context, workspaceID string) (map[string]tfe.StateVersionOutput, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadOutputs", ctx, workspaceID)
+	ret0, _ := ret[0].(map[string]tfe.StateVersionOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadOutputs indicates an expected call of ReadOutputs.
+func (mr *MockWorkspacesMockRecorder) ReadOutputs(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadOutputs", reflect.TypeOf((*MockWorkspaces)(nil).ReadOutputs), ctx, workspaceID)
+}
+
+// ReadReadme mocks base method.
+func (m *MockWorkspaces) ReadReadme(ctx context.Context, workspaceID string) (*tfe.ConfigurationReadme, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadReadme", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.ConfigurationReadme)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadReadme indicates an expected call of ReadReadme.
+func (mr *MockWorkspacesMockRecorder) ReadReadme(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadReadme", reflect.TypeOf((*MockWorkspaces)(nil).ReadReadme), ctx, workspaceID)
+}
+
 // ReadWithOptions mocks base method.
 func (m *MockWorkspaces) ReadWithOptions(ctx context.Context, organization, workspace string, options *tfe.WorkspaceReadOptions) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -394,6 +605,20 @@ func (mr *MockWorkspacesMockRecorder) RemoveRemoteStateConsumers(ctx, workspaceI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRemoteStateConsumers", reflect.TypeOf((*MockWorkspaces)(nil).RemoveRemoteStateConsumers), ctx, workspaceID, options)
 }
 
+// RemoveTagBindings mocks base method.
+func (m *MockWorkspaces) RemoveTagBindings(ctx context.Context, workspaceID string, keys []string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveTagBindings", ctx, workspaceID, keys)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RemoveTagBindings indicates an expected call of RemoveTagBindings.
+func (mr *MockWorkspacesMockRecorder) RemoveTagBindings(ctx, workspaceID, keys any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveTagBindings", reflect.TypeOf((*MockWorkspaces)(nil).RemoveTagBindings), ctx, workspaceID, keys)
+}
+
 // RemoveTags mocks base method.
 func (m *MockWorkspaces) RemoveTags(ctx context.Context, workspaceID string, options tfe.WorkspaceRemoveTagsOptions) error {
 	m.ctrl.T.Helper()
@@ -438,6 +663,36 @@ func (mr *MockWorkspacesMockRecorder) RemoveVCSConnectionByID(ctx, workspaceID a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVCSConnectionByID", reflect.TypeOf((*MockWorkspaces)(nil).RemoveVCSConnectionByID), ctx, workspaceID)
 }
 
+// ReplaceRemoteStateConsumers mocks base method.
+func (m *MockWorkspaces) ReplaceRemoteStateConsumers(ctx context.Context, workspaceID string, options tfe.WorkspaceReplaceRemoteStateConsumersOptions) (*tfe.RemoteStateConsumersChanges, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceRemoteStateConsumers", ctx, workspaceID, options)
+	ret0, _ := ret[0].(*tfe.RemoteStateConsumersChanges)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceRemoteStateConsumers indicates an expected call of ReplaceRemoteStateConsumers.
+func (mr *MockWorkspacesMockRecorder) ReplaceRemoteStateConsumers(ctx, workspaceID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceRemoteStateConsumers", reflect.TypeOf((*MockWorkspaces)(nil).ReplaceRemoteStateConsumers), ctx, workspaceID, options)
+}
+
+// ResolveEffectiveTerraformVersion mocks base method.
+func (m *MockWorkspaces) ResolveEffectiveTerraformVersion(ctx context.Context, workspaceID string) (*tfe.EffectiveTerraformVersion, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveEffectiveTerraformVersion", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.EffectiveTerraformVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveEffectiveTerraformVersion indicates an expected call of ResolveEffectiveTerraformVersion.
+func (mr *MockWorkspacesMockRecorder) ResolveEffectiveTerraformVersion(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveEffectiveTerraformVersion", reflect.TypeOf((*MockWorkspaces)(nil).ResolveEffectiveTerraformVersion), ctx, workspaceID)
+}
+
 // SafeDelete mocks base method.
 func (m *MockWorkspaces) SafeDelete(ctx context.Context, organization, workspace string) error {
 	m.ctrl.T.Helper()
@@ -466,6 +721,21 @@ func (mr *MockWorkspacesMockRecorder) SafeDeleteByID(ctx, workspaceID any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SafeDeleteByID", reflect.TypeOf((*MockWorkspaces)(nil).SafeDeleteByID), ctx, workspaceID)
 }
 
+// SafeDeleteDryRun mocks base method.
+func (m *MockWorkspaces) SafeDeleteDryRun(ctx context.Context, workspaceID string) (*tfe.WorkspaceSafeDeleteCheck, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SafeDeleteDryRun", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.WorkspaceSafeDeleteCheck)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SafeDeleteDryRun indicates an expected call of SafeDeleteDryRun.
+func (mr *MockWorkspacesMockRecorder) SafeDeleteDryRun(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SafeDeleteDryRun", reflect.TypeOf((*MockWorkspaces)(nil).SafeDeleteDryRun), ctx, workspaceID)
+}
+
 // SetDataRetentionPolicy mocks base method.
 func (m *MockWorkspaces) SetDataRetentionPolicy(ctx context.Context, workspaceID string, options tfe.DataRetentionPolicySetOptions) (*tfe.DataRetentionPolicy, error) {
 	m.ctrl.T.Helper()
@@ -511,6 +781,21 @@ func (mr *MockWorkspacesMockRecorder) SetDataRetentionPolicyDontDelete(ctx, work
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetDataRetentionPolicyDontDelete", reflect.TypeOf((*MockWorkspaces)(nil).SetDataRetentionPolicyDontDelete), ctx, workspaceID, options)
 }
 
+// Snapshot mocks base method.
+func (m *MockWorkspaces) Snapshot(ctx context.Context, workspaceID string) (*tfe.WorkspaceSettings, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Snapshot", ctx, workspaceID)
+	ret0, _ := ret[0].(*tfe.WorkspaceSettings)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Snapshot indicates an expected call of Snapshot.
+func (mr *MockWorkspacesMockRecorder) Snapshot(ctx, workspaceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Snapshot", reflect.TypeOf((*MockWorkspaces)(nil).Snapshot), ctx, workspaceID)
+}
+
 // UnassignSSHKey mocks base method.
 func (m *MockWorkspaces) UnassignSSHKey(ctx context.Context, workspaceID string) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -556,6 +841,21 @@ func (mr *MockWorkspacesMockRecorder) Update(ctx, organization, workspace, optio
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWorkspaces)(nil).Update), ctx, organization, workspace, options)
 }
 
+// UpdateAttributes mocks base method.
+func (m *MockWorkspaces) UpdateAttributes(ctx context.Context, workspaceID string, attributes map[string]any) (*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAttributes", ctx, workspaceID, attributes)
+	ret0, _ := ret[0].(*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAttributes indicates an expected call of UpdateAttributes.
+func (mr *MockWorkspacesMockRecorder) UpdateAttributes(ctx, workspaceID, attributes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAttributes", reflect.TypeOf((*MockWorkspaces)(nil).UpdateAttributes), ctx, workspaceID, attributes)
+}
+
 // UpdateByID mocks base method.
 func (m *MockWorkspaces) UpdateByID(ctx context.Context, workspaceID string, options tfe.WorkspaceUpdateOptions) (*tfe.Workspace, error) {
 	m.ctrl.T.Helper()
@@ -571,6 +871,21 @@ func (mr *MockWorkspacesMockRecorder) UpdateByID(ctx, workspaceID, options any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateByID", reflect.TypeOf((*MockWorkspaces)(nil).UpdateByID), ctx, workspaceID, options)
 }
 
+// UpdateRelationship mocks base method.
+func (m *MockWorkspaces) UpdateRelationship(ctx context.Context, workspaceID string, options tfe.RelationshipUpdateOptions) (*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRelationship", ctx, workspaceID, options)
+	ret0, _ := ret[0].(*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRelationship indicates an expected call of UpdateRelationship.
+func (mr *MockWorkspacesMockRecorder) UpdateRelationship(ctx, workspaceID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRelationship", reflect.TypeOf((*MockWorkspaces)(nil).UpdateRelationship), ctx, workspaceID, options)
+}
+
 // UpdateRemoteStateConsumers mocks base method.
 func (m *MockWorkspaces) UpdateRemoteStateConsumers(ctx context.Context, workspaceID string, options tfe.WorkspaceUpdateRemoteStateConsumersOptions) error {
 	m.ctrl.T.Helper()
@@ -584,3 +899,18 @@ func (mr *MockWorkspacesMockRecorder) UpdateRemoteStateConsumers(ctx, workspaceI
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemoteStateConsumers", reflect.TypeOf((*MockWorkspaces)(nil).UpdateRemoteStateConsumers), ctx, workspaceID, options)
 }
+
+// UpdateVCSConnection mocks base method.
+func (m *MockWorkspaces) UpdateVCSConnection(ctx context.Context, workspaceID string, options tfe.WorkspaceVCSConnectionOptions) (*tfe.Workspace, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVCSConnection", ctx, workspaceID, options)
+	ret0, _ := ret[0].(*tfe.Workspace)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVCSConnection indicates an expected call of UpdateVCSConnection.
+func (mr *MockWorkspacesMockRecorder) UpdateVCSConnection(ctx, workspaceID, options any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVCSConnection", reflect.TypeOf((*MockWorkspaces)(nil).UpdateVCSConnection), ctx, workspaceID, options)
+}
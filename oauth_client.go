@@ -114,6 +114,9 @@ type OAuthClientListOptions struct {
 	ListOptions
 
 	Include []OAuthClientIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // OAuthClientReadOptions are read options.
@@ -123,6 +126,9 @@ type OAuthClientReadOptions struct {
 	// Optional: A list of relations to include. See available resources
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/oauth-clients#available-related-resources
 	Include []OAuthClientIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // OAuthClientCreateOptions represents the options for creating an OAuth client.
@@ -186,13 +192,19 @@ type OAuthClientUpdateOptions struct {
 	// Optional: The OAuth Client key.
 	Key *string `jsonapi:"attr,key,omitempty"`
 
-	// Optional: Secret key associated with this vcs provider - only available for ado_server
+	// Optional: The OAuth Client secret. For Bitbucket Server and Bitbucket
+	// Data Center, the text of the SSH private key associated with your
+	// Application Link, to be rotated together with RSAPublicKey.
 	Secret *string `jsonapi:"attr,secret,omitempty"`
 
 	// Optional: RSAPublicKey the text of the SSH public key associated with your BitBucket
-	// Server Application Link.
+	// Server Application Link - only available for bitbucket_server and
+	// bitbucket_data_center.
 	RSAPublicKey *string `jsonapi:"attr,rsa-public-key,omitempty"`
 
+	// Optional: Private key associated with this vcs provider - only available for ado_server
+	PrivateKey *string `jsonapi:"attr,private-key,omitempty"`
+
 	// Optional: The token string you were given by your VCS provider.
 	OAuthToken *string `jsonapi:"attr,oauth-token-string,omitempty"`
 
@@ -291,11 +303,22 @@ func (s *oAuthClients) ReadWithOptions(ctx context.Context, oAuthClientID string
 	return oc, err
 }
 
-// Update an OAuth client by its ID.
+// Update an OAuth client by its ID. Keys and secrets are only supported by
+// some service providers, so an update rotating them first reads the OAuth
+// client to validate them against its service provider.
 func (s *oAuthClients) Update(ctx context.Context, oAuthClientID string, options OAuthClientUpdateOptions) (*OAuthClient, error) {
 	if !validStringID(&oAuthClientID) {
 		return nil, ErrInvalidOauthClientID
 	}
+	if options.rotatesKeys() {
+		oc, err := s.Read(ctx, oAuthClientID)
+		if err != nil {
+			return nil, err
+		}
+		if err := options.valid(oc.ServiceProvider); err != nil {
+			return nil, err
+		}
+	}
 
 	u := fmt.Sprintf("oauth-clients/%s", url.PathEscape(oAuthClientID))
 	req, err := s.client.NewRequest("PATCH", u, &options)
@@ -345,9 +368,39 @@ func (o OAuthClientCreateOptions) valid() error {
 	if validString(o.PrivateKey) && *o.ServiceProvider != *ServiceProvider(ServiceProviderAzureDevOpsServer) {
 		return ErrUnsupportedPrivateKey
 	}
+	if validString(o.RSAPublicKey) && !usesRSAKeyPair(*o.ServiceProvider) {
+		return ErrUnsupportedRSAPublicKey
+	}
 	return nil
 }
 
+func (o OAuthClientUpdateOptions) rotatesKeys() bool {
+	return o.PrivateKey != nil || o.Secret != nil || o.RSAPublicKey != nil
+}
+
+func (o OAuthClientUpdateOptions) valid(serviceProvider ServiceProviderType) error {
+	if validString(o.PrivateKey) && serviceProvider != ServiceProviderAzureDevOpsServer {
+		return ErrUnsupportedPrivateKey
+	}
+	if !usesRSAKeyPair(serviceProvider) {
+		if validString(o.RSAPublicKey) {
+			return ErrUnsupportedRSAPublicKey
+		}
+		return nil
+	}
+	if validString(o.Secret) != validString(o.RSAPublicKey) {
+		return ErrRequiredRSAKeyPair
+	}
+	return nil
+}
+
+// usesRSAKeyPair reports whether the OAuth clients of a service provider
+// authenticate with an RSA key pair, whose private key is their secret.
+func usesRSAKeyPair(serviceProvider ServiceProviderType) bool {
+	return serviceProvider == ServiceProviderBitbucketServer ||
+		serviceProvider == ServiceProviderBitbucketDataCenter
+}
+
 func (o *OAuthClientListOptions) valid() error {
 	return nil
 }
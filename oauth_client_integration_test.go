@@ -6,7 +6,11 @@ package tfe
 import (
 	"context"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -710,3 +714,66 @@ func TestOAuthClientsUpdate_rsaKeyPair(t *testing.T) {
 		assert.Error(t, err, "The Consumer Key for Bitbucket Data Center must be present. Please add a value for `key`.")
 	})
 }
+
+func TestOAuthClientsUpdate_rotateKeys(t *testing.T) {
+	t.Parallel()
+
+	var updated []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/oauth-clients/oc-ado":
+			_, _ = io.WriteString(w, `{"data":{"id":"oc-ado","type":"oauth-clients","attributes":{"service-provider":"ado_server"}}}`)
+		case "GET /api/v2/oauth-clients/oc-bbdc":
+			_, _ = io.WriteString(w, `{"data":{"id":"oc-bbdc","type":"oauth-clients","attributes":{"service-provider":"bitbucket_data_center"}}}`)
+		case "PATCH /api/v2/oauth-clients/oc-ado", "PATCH /api/v2/oauth-clients/oc-bbdc":
+			updated, _ = io.ReadAll(r.Body)
+			_, _ = fmt.Fprintf(w, `{"data":{"id":%q,"type":"oauth-clients"}}`, path.Base(r.URL.Path))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("rotate the private key of an Azure DevOps Server client", func(t *testing.T) {
+		_, err := client.OAuthClients.Update(ctx, "oc-ado", OAuthClientUpdateOptions{
+			PrivateKey: String(privateKey),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, string(updated), `"private-key"`)
+	})
+
+	t.Run("rotate the key pair of a Bitbucket Data Center client", func(t *testing.T) {
+		_, err := client.OAuthClients.Update(ctx, "oc-bbdc", OAuthClientUpdateOptions{
+			Secret:       String(privateKey),
+			RSAPublicKey: String(publicKey),
+		})
+		require.NoError(t, err)
+		assert.Contains(t, string(updated), `"rsa-public-key"`)
+	})
+
+	t.Run("with half a key pair", func(t *testing.T) {
+		_, err := client.OAuthClients.Update(ctx, "oc-bbdc", OAuthClientUpdateOptions{
+			RSAPublicKey: String(publicKey),
+		})
+		assert.Equal(t, ErrRequiredRSAKeyPair, err)
+	})
+
+	t.Run("with keys unsupported by the service provider", func(t *testing.T) {
+		_, err := client.OAuthClients.Update(ctx, "oc-bbdc", OAuthClientUpdateOptions{
+			PrivateKey: String(privateKey),
+		})
+		assert.Equal(t, ErrUnsupportedPrivateKey, err)
+
+		_, err = client.OAuthClients.Update(ctx, "oc-ado", OAuthClientUpdateOptions{
+			RSAPublicKey: String(publicKey),
+		})
+		assert.Equal(t, ErrUnsupportedRSAPublicKey, err)
+	})
+}
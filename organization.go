@@ -34,6 +34,24 @@ type Organizations interface {
 	// Update attributes of an existing organization.
 	Update(ctx context.Context, organization string, options OrganizationUpdateOptions) (*Organization, error)
 
+	// UpdateAttributes sends exactly the given attributes, keyed by their API
+	// name, to partially update an existing organization. It is meant as an
+	// escape hatch for attributes not yet supported by OrganizationUpdateOptions.
+	UpdateAttributes(ctx context.Context, organization string, attributes map[string]interface{}) (*Organization, error)
+
+	// UpdateRelationship replaces a single relationship of an existing
+	// organization, such as its default agent pool, without sending any
+	// attribute.
+	UpdateRelationship(ctx context.Context, organization string, options RelationshipUpdateOptions) (*Organization, error)
+
+	// ReadDefaultSettings reads the settings an organization applies by
+	// default to its workspaces and their speculative plans.
+	ReadDefaultSettings(ctx context.Context, organization string) (*OrganizationDefaultSettings, error)
+
+	// UpdateDefaultSettings updates the settings an organization applies by
+	// default to its workspaces and their speculative plans.
+	UpdateDefaultSettings(ctx context.Context, organization string, options OrganizationDefaultSettingsOptions) (*OrganizationDefaultSettings, error)
+
 	// Delete an organization by its name.
 	Delete(ctx context.Context, organization string) error
 
@@ -43,9 +61,18 @@ type Organizations interface {
 	// ReadEntitlements shows the entitlements of an organization.
 	ReadEntitlements(ctx context.Context, organization string) (*Entitlements, error)
 
+	// ReadTagBindingUsage reports which tag binding keys and values are used
+	// in an organization, and by which projects and workspaces.
+	ReadTagBindingUsage(ctx context.Context, organization string) ([]*TagBindingKeyUsage, error)
+
 	// ReadRunQueue shows the current run queue of an organization.
 	ReadRunQueue(ctx context.Context, organization string, options ReadRunQueueOptions) (*RunQueue, error)
 
+	// ReadWorkspaceSummary summarizes the workspaces of an organization: how
+	// many there are by run status and Terraform version, and how many
+	// drifted or have failing checks.
+	ReadWorkspaceSummary(ctx context.Context, organization string) (*OrganizationWorkspaceSummary, error)
+
 	// ReadDataRetentionPolicy reads an organization's data retention policy
 	// **Note: This functionality is only available in Terraform Enterprise versions v202311-1 and v202312-1.**
 	//
@@ -147,6 +174,9 @@ type OrganizationReadOptions struct {
 	// Optional: A list of relations to include. See available resources
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/organizations#available-related-resources
 	Include []OrganizationIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // Capacity represents the current run capacity of an organization.
@@ -181,6 +211,60 @@ type RunQueue struct {
 	Items []*Run
 }
 
+// OrganizationWorkspaceSummary represents the rollup of the workspaces of an
+// organization, as read from the workspaces view of the explorer.
+type OrganizationWorkspaceSummary struct {
+	// The number of workspaces of the organization.
+	Workspaces int
+
+	// The number of workspaces by the status of their current run. Workspaces
+	// without a run are counted under the empty status.
+	ByRunStatus map[RunStatus]int
+
+	// The number of workspaces by their Terraform version.
+	ByTerraformVersion map[string]int
+
+	// The number of workspaces whose latest health assessment found drift.
+	Drifted int
+
+	// The number of resources found drifted across the workspaces.
+	ResourcesDrifted int
+
+	// The number of workspaces with failed or errored continuous validation
+	// checks.
+	ChecksFailing int
+}
+
+// explorerWorkspace is a row of the workspaces view of the explorer, which
+// reports the health of a workspace along with its settings.
+type explorerWorkspace struct {
+	ID                        string    `jsonapi:"primary,visibility-workspace"`
+	WorkspaceName             string    `jsonapi:"attr,workspace-name"`
+	WorkspaceTerraformVersion string    `jsonapi:"attr,workspace-terraform-version"`
+	CurrentRunStatus          RunStatus `jsonapi:"attr,current-run-status"`
+	Drifted                   bool      `jsonapi:"attr,drifted"`
+	ResourcesDrifted          int       `jsonapi:"attr,resources-drifted"`
+	ChecksFailed              int       `jsonapi:"attr,checks-failed"`
+	ChecksErrored             int       `jsonapi:"attr,checks-errored"`
+}
+
+type explorerWorkspaceList struct {
+	*Pagination
+	Items []*explorerWorkspace
+}
+
+// explorerQueryOptions represents the options for querying a view of the
+// explorer.
+type explorerQueryOptions struct {
+	ListOptions
+
+	// The view to query, e.g. "workspaces".
+	Type string `url:"type"`
+
+	// Optional: Only return the rows whose name is the given value.
+	Name string `url:"filter[0][name][is][0],omitempty"`
+}
+
 // OrganizationPermissions represents the organization permissions.
 type OrganizationPermissions struct {
 	CanCreateTeam               bool `jsonapi:"attr,can-create-team"`
@@ -204,6 +288,9 @@ type OrganizationListOptions struct {
 	// Optional: A query string used to filter organizations.
 	// Organizations with a name or email partially matching this value will be returned.
 	Query string `url:"q,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // OrganizationCreateOptions represents the options for creating an organization.
@@ -313,6 +400,60 @@ type OrganizationUpdateOptions struct {
 	StacksEnabled *bool `jsonapi:"attr,stacks-enabled,omitempty"`
 }
 
+// OrganizationDefaultSettings represents the settings an organization applies
+// by default to its workspaces and their speculative plans, gathered from the
+// attributes of the organization.
+//
+// The API has no organization-wide default Terraform version: new workspaces
+// use the latest version unless their TerraformVersion or
+// TerraformVersionConstraint is set.
+type OrganizationDefaultSettings struct {
+	// The execution mode of the workspaces that do not set their own,
+	// "remote", "local" or "agent".
+	ExecutionMode string
+
+	// The agent pool of the workspaces using the default "agent" execution
+	// mode. Only its ID is set.
+	AgentPool *AgentPool
+
+	// Whether pending speculative plans of outdated commits are canceled
+	// when a newer commit is pushed to the same branch.
+	SpeculativePlanManagementEnabled bool
+
+	// Whether untriggered speculative plans send passing statuses to the VCS
+	// provider.
+	SendPassingStatusesForUntriggeredSpeculativePlans bool
+
+	// Whether a single aggregated commit status is sent to the VCS provider
+	// instead of one status per workspace.
+	AggregatedCommitStatusEnabled bool
+}
+
+// OrganizationDefaultSettingsOptions represents the options for updating the
+// default settings of an organization. Settings left nil are unchanged.
+type OrganizationDefaultSettingsOptions struct {
+	// Optional: The default execution mode, "remote", "local" or "agent".
+	// Setting it to "agent" requires AgentPoolID.
+	ExecutionMode *string
+
+	// Optional: The ID of the default agent pool, which requires
+	// ExecutionMode to be "agent".
+	AgentPoolID *string
+
+	// Optional: Whether pending speculative plans of outdated commits are
+	// canceled when a newer commit is pushed to the same branch.
+	SpeculativePlanManagementEnabled *bool
+
+	// Optional: Whether untriggered speculative plans send passing statuses
+	// to the VCS provider. Must not be true along with
+	// AggregatedCommitStatusEnabled.
+	SendPassingStatusesForUntriggeredSpeculativePlans *bool
+
+	// Optional: Whether a single aggregated commit status is sent to the VCS
+	// provider.
+	AggregatedCommitStatusEnabled *bool
+}
+
 // ReadRunQueueOptions represents the options for showing the queue.
 type ReadRunQueueOptions struct {
 	ListOptions
@@ -404,6 +545,105 @@ func (s *organizations) Update(ctx context.Context, organization string, options
 	return org, nil
 }
 
+// UpdateAttributes partially updates an existing organization, sending only
+// the given attributes.
+func (s *organizations) UpdateAttributes(ctx context.Context, organization string, attributes map[string]interface{}) (*Organization, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	body, err := newAttributesPatch("organizations", "", attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("organizations/%s", url.PathEscape(organization))
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	org := &Organization{}
+	err = req.Do(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+// UpdateRelationship partially updates an existing organization, replacing
+// only the given relationship.
+func (s *organizations) UpdateRelationship(ctx context.Context, organization string, options RelationshipUpdateOptions) (*Organization, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	u := fmt.Sprintf("organizations/%s", url.PathEscape(organization))
+	req, err := s.client.newRelationshipRequest(u, "organizations", "", options)
+	if err != nil {
+		return nil, err
+	}
+
+	org := &Organization{}
+	err = req.Do(ctx, org)
+	if err != nil {
+		return nil, err
+	}
+
+	return org, nil
+}
+
+// ReadDefaultSettings reads the settings an organization applies by default
+// to its workspaces and their speculative plans.
+func (s *organizations) ReadDefaultSettings(ctx context.Context, organization string) (*OrganizationDefaultSettings, error) {
+	org, err := s.Read(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOrganizationDefaultSettings(org), nil
+}
+
+// UpdateDefaultSettings updates the settings an organization applies by
+// default to its workspaces and their speculative plans, and returns the
+// updated settings.
+func (s *organizations) UpdateDefaultSettings(ctx context.Context, organization string, options OrganizationDefaultSettingsOptions) (*OrganizationDefaultSettings, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	update := OrganizationUpdateOptions{
+		DefaultExecutionMode:                              options.ExecutionMode,
+		SpeculativePlanManagementEnabled:                  options.SpeculativePlanManagementEnabled,
+		SendPassingStatusesForUntriggeredSpeculativePlans: options.SendPassingStatusesForUntriggeredSpeculativePlans,
+		AggregatedCommitStatusEnabled:                     options.AggregatedCommitStatusEnabled,
+	}
+	if options.AgentPoolID != nil {
+		update.DefaultAgentPool = &AgentPool{ID: *options.AgentPoolID}
+	}
+
+	org, err := s.Update(ctx, organization, update)
+	if err != nil {
+		return nil, err
+	}
+
+	return newOrganizationDefaultSettings(org), nil
+}
+
+func newOrganizationDefaultSettings(org *Organization) *OrganizationDefaultSettings {
+	return &OrganizationDefaultSettings{
+		ExecutionMode:                    org.DefaultExecutionMode,
+		AgentPool:                        org.DefaultAgentPool,
+		SpeculativePlanManagementEnabled: org.SpeculativePlanManagementEnabled,
+		SendPassingStatusesForUntriggeredSpeculativePlans: org.SendPassingStatusesForUntriggeredSpeculativePlans,
+		AggregatedCommitStatusEnabled:                     org.AggregatedCommitStatusEnabled,
+	}
+}
+
 // Delete an organization by its name.
 func (s *organizations) Delete(ctx context.Context, organization string) error {
 	if !validStringID(&organization) {
@@ -482,6 +722,55 @@ func (s *organizations) ReadRunQueue(ctx context.Context, organization string, o
 	return rq, nil
 }
 
+// ReadWorkspaceSummary summarizes the workspaces of an organization from the
+// workspaces view of the explorer, reading every page of the view. The
+// explorer requires the organization to be entitled to it and the token to
+// be allowed to read the whole organization.
+func (s *organizations) ReadWorkspaceSummary(ctx context.Context, organization string) (*OrganizationWorkspaceSummary, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	summary := &OrganizationWorkspaceSummary{
+		ByRunStatus:        make(map[RunStatus]int),
+		ByTerraformVersion: make(map[string]int),
+	}
+
+	u := fmt.Sprintf("organizations/%s/explorer", url.PathEscape(organization))
+	options := &explorerQueryOptions{Type: "workspaces"}
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		req, err := s.client.NewRequest("GET", u, options)
+		if err != nil {
+			return nil, err
+		}
+
+		wl := &explorerWorkspaceList{}
+		err = req.Do(ctx, wl)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range wl.Items {
+			summary.Workspaces++
+			summary.ByRunStatus[w.CurrentRunStatus]++
+			summary.ByTerraformVersion[w.WorkspaceTerraformVersion]++
+			if w.Drifted {
+				summary.Drifted++
+			}
+			summary.ResourcesDrifted += w.ResourcesDrifted
+			if w.ChecksFailed > 0 || w.ChecksErrored > 0 {
+				summary.ChecksFailing++
+			}
+		}
+		return wl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return summary, nil
+}
+
 func (s *organizations) ReadDataRetentionPolicy(ctx context.Context, organization string) (*DataRetentionPolicy, error) {
 	if !validStringID(&organization) {
 		return nil, ErrInvalidOrg
@@ -654,6 +943,23 @@ func (o OrganizationCreateOptions) valid() error {
 	return nil
 }
 
+func (o OrganizationDefaultSettingsOptions) valid() error {
+	if o.AgentPoolID != nil && !validStringID(o.AgentPoolID) {
+		return ErrInvalidAgentPoolID
+	}
+	if o.AgentPoolID != nil && (o.ExecutionMode == nil || *o.ExecutionMode != "agent") {
+		return ErrRequiredAgentMode
+	}
+	if o.AgentPoolID == nil && (o.ExecutionMode != nil && *o.ExecutionMode == "agent") {
+		return ErrRequiredAgentPoolID
+	}
+	if o.AggregatedCommitStatusEnabled != nil && *o.AggregatedCommitStatusEnabled &&
+		o.SendPassingStatusesForUntriggeredSpeculativePlans != nil && *o.SendPassingStatusesForUntriggeredSpeculativePlans {
+		return ErrUnsupportedBothAggregatedCommitStatusAndPassingStatuses
+	}
+	return nil
+}
+
 func (s *organizations) dataRetentionPolicyLink(name string) string {
 	return fmt.Sprintf("organizations/%s/relationships/data-retention-policy", url.PathEscape(name))
 }
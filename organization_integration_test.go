@@ -7,6 +7,9 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 
@@ -390,6 +393,41 @@ func TestOrganizationsUpdate(t *testing.T) {
 	})
 }
 
+func TestOrganizationsUpdateAttributes(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	t.Run("with a subset of attributes", func(t *testing.T) {
+		org, err := client.Organizations.UpdateAttributes(ctx, orgTest.Name, map[string]interface{}{
+			"session-timeout":  3600,
+			"session-remember": 3600,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, orgTest.Name, org.Name)
+		assert.Equal(t, orgTest.Email, org.Email)
+		assert.Equal(t, 3600, org.SessionTimeout)
+		assert.Equal(t, 3600, org.SessionRemember)
+	})
+
+	t.Run("without attributes", func(t *testing.T) {
+		org, err := client.Organizations.UpdateAttributes(ctx, orgTest.Name, nil)
+		assert.Nil(t, org)
+		assert.Equal(t, ErrRequiredAttributes, err)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		org, err := client.Organizations.UpdateAttributes(ctx, badIdentifier, map[string]interface{}{
+			"session-timeout": 3600,
+		})
+		assert.Nil(t, org)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
 func TestOrganizationsDelete(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -507,6 +545,63 @@ func TestOrganizationsReadEntitlements(t *testing.T) {
 	})
 }
 
+func TestOrganizationsReadTagBindingUsage(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/acme/projects": `{"data":[
+			{"id":"prj-1","type":"projects","attributes":{"name":"platform"},
+				"relationships":{"effective-tag-bindings":{"data":[{"id":"etb-1","type":"effective-tag-bindings"}]}}}
+		],"included":[
+			{"id":"etb-1","type":"effective-tag-bindings","attributes":{"key":"team","value":"platform"}}
+		]}`,
+		"GET /api/v2/organizations/acme/workspaces": `{"data":[
+			{"id":"ws-1","type":"workspaces","attributes":{"name":"network"},
+				"relationships":{"effective-tag-bindings":{"data":[{"id":"etb-2","type":"effective-tag-bindings"},{"id":"etb-3","type":"effective-tag-bindings"}]}}},
+			{"id":"ws-2","type":"workspaces","attributes":{"name":"billing"},
+				"relationships":{"effective-tag-bindings":{"data":[{"id":"etb-4","type":"effective-tag-bindings"}]}}}
+		],"included":[
+			{"id":"etb-2","type":"effective-tag-bindings","attributes":{"key":"team","value":"platform"},"links":{"inherited-from":"/api/v2/projects/prj-1"}},
+			{"id":"etb-3","type":"effective-tag-bindings","attributes":{"key":"env","value":"prod"}},
+			{"id":"etb-4","type":"effective-tag-bindings","attributes":{"key":"env","value":"dev"}}
+		]}`,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("with projects and workspaces", func(t *testing.T) {
+		usage, err := client.Organizations.ReadTagBindingUsage(ctx, "acme")
+		require.NoError(t, err)
+		require.Len(t, usage, 2)
+
+		env := usage[0]
+		assert.Equal(t, "env", env.Key)
+		require.Len(t, env.Values, 2)
+		assert.Equal(t, "dev", env.Values[0].Value)
+		require.Len(t, env.Values[0].Workspaces, 1)
+		assert.Equal(t, "ws-2", env.Values[0].Workspaces[0].ID)
+		assert.Equal(t, "prod", env.Values[1].Value)
+		require.Len(t, env.Values[1].Workspaces, 1)
+		assert.Equal(t, "ws-1", env.Values[1].Workspaces[0].ID)
+
+		team := usage[1]
+		assert.Equal(t, "team", team.Key)
+		require.Len(t, team.Values, 1)
+		assert.Equal(t, "platform", team.Values[0].Value)
+		require.Len(t, team.Values[0].Projects, 1)
+		assert.Equal(t, "prj-1", team.Values[0].Projects[0].ID)
+		assert.Empty(t, team.Values[0].Workspaces)
+		require.Len(t, team.Values[0].InheritingWorkspaces, 1)
+		assert.Equal(t, "ws-1", team.Values[0].InheritingWorkspaces[0].ID)
+	})
+
+	t.Run("with invalid name", func(t *testing.T) {
+		usage, err := client.Organizations.ReadTagBindingUsage(ctx, badIdentifier)
+		assert.Nil(t, usage)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
 func TestOrganizationsReadRunQueue(t *testing.T) {
 	t.Skip("Capacity queues are not available in the API")
 	client := testClient(t)
@@ -844,3 +939,147 @@ func orgItemsContainsEmail(items []*Organization, email string) bool {
 
 	return hasEmail
 }
+
+func TestOrganizationsDefaultSettings(t *testing.T) {
+	t.Parallel()
+
+	var patched struct {
+		Data struct {
+			Attributes    map[string]interface{} `json:"attributes"`
+			Relationships map[string]struct {
+				Data struct {
+					ID string `json:"id"`
+				} `json:"data"`
+			} `json:"relationships"`
+		} `json:"data"`
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/organizations/acme":
+			_, _ = io.WriteString(w, `{"data":{"id":"acme","type":"organizations","attributes":{
+				"default-execution-mode":"remote","speculative-plan-management-enabled":true,
+				"send-passing-statuses-for-untriggered-speculative-plans":true}}}`)
+		case "PATCH /api/v2/organizations/acme":
+			if err := json.NewDecoder(r.Body).Decode(&patched); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			_, _ = io.WriteString(w, `{"data":{"id":"acme","type":"organizations","attributes":{
+				"default-execution-mode":"agent","aggregated-commit-status-enabled":true},
+				"relationships":{"default-agent-pool":{"data":{"id":"apool-1","type":"agent-pools"}}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("read", func(t *testing.T) {
+		settings, err := client.Organizations.ReadDefaultSettings(ctx, "acme")
+		require.NoError(t, err)
+		assert.Equal(t, &OrganizationDefaultSettings{
+			ExecutionMode:                                     "remote",
+			SpeculativePlanManagementEnabled:                  true,
+			SendPassingStatusesForUntriggeredSpeculativePlans: true,
+		}, settings)
+	})
+
+	t.Run("update", func(t *testing.T) {
+		settings, err := client.Organizations.UpdateDefaultSettings(ctx, "acme", OrganizationDefaultSettingsOptions{
+			ExecutionMode:                 String("agent"),
+			AgentPoolID:                   String("apool-1"),
+			AggregatedCommitStatusEnabled: Bool(true),
+			SendPassingStatusesForUntriggeredSpeculativePlans: Bool(false),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "agent", settings.ExecutionMode)
+		require.NotNil(t, settings.AgentPool)
+		assert.Equal(t, "apool-1", settings.AgentPool.ID)
+		assert.True(t, settings.AggregatedCommitStatusEnabled)
+
+		assert.Equal(t, map[string]interface{}{
+			"default-execution-mode":                                  "agent",
+			"aggregated-commit-status-enabled":                        true,
+			"send-passing-statuses-for-untriggered-speculative-plans": false,
+		}, patched.Data.Attributes)
+		assert.Equal(t, "apool-1", patched.Data.Relationships["default-agent-pool"].Data.ID)
+	})
+
+	t.Run("with invalid options", func(t *testing.T) {
+		_, err := client.Organizations.UpdateDefaultSettings(ctx, "acme", OrganizationDefaultSettingsOptions{
+			AgentPoolID: String("apool-1"),
+		})
+		assert.Equal(t, ErrRequiredAgentMode, err)
+
+		_, err = client.Organizations.UpdateDefaultSettings(ctx, "acme", OrganizationDefaultSettingsOptions{
+			ExecutionMode: String("agent"),
+		})
+		assert.Equal(t, ErrRequiredAgentPoolID, err)
+
+		_, err = client.Organizations.UpdateDefaultSettings(ctx, "acme", OrganizationDefaultSettingsOptions{
+			AggregatedCommitStatusEnabled:                     Bool(true),
+			SendPassingStatusesForUntriggeredSpeculativePlans: Bool(true),
+		})
+		assert.Equal(t, ErrUnsupportedBothAggregatedCommitStatusAndPassingStatuses, err)
+
+		_, err = client.Organizations.UpdateDefaultSettings(ctx, badIdentifier, OrganizationDefaultSettingsOptions{})
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
+
+func TestOrganizationsReadWorkspaceSummary(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/organizations/acme/explorer":
+			assert.Equal(t, "workspaces", r.URL.Query().Get("type"))
+			if r.URL.Query().Get("page[number]") != "2" {
+				_, _ = w.Write([]byte(`{"data":[
+					{"id":"ws-1","type":"visibility-workspace","attributes":{"workspace-terraform-version":"1.9.0","current-run-status":"applied","drifted":true,"resources-drifted":3}},
+					{"id":"ws-2","type":"visibility-workspace","attributes":{"workspace-terraform-version":"1.9.0","current-run-status":"errored","checks-failed":1}}
+				],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2,"total-count":3}}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":"ws-3","type":"visibility-workspace","attributes":{"workspace-terraform-version":"1.5.7","checks-errored":2,"drifted":true,"resources-drifted":1}}
+			],"meta":{"pagination":{"current-page":2,"prev-page":1,"total-pages":2,"total-count":3}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("with workspaces", func(t *testing.T) {
+		summary, err := client.Organizations.ReadWorkspaceSummary(ctx, "acme")
+		require.NoError(t, err)
+		assert.Equal(t, &OrganizationWorkspaceSummary{
+			Workspaces:         3,
+			ByRunStatus:        map[RunStatus]int{RunApplied: 1, RunErrored: 1, "": 1},
+			ByTerraformVersion: map[string]int{"1.9.0": 2, "1.5.7": 1},
+			Drifted:            2,
+			ResourcesDrifted:   4,
+			ChecksFailing:      2,
+		}, summary)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		summary, err := client.Organizations.ReadWorkspaceSummary(ctx, badIdentifier)
+		assert.Nil(t, summary)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
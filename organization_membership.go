@@ -24,6 +24,10 @@ type OrganizationMemberships interface {
 	// Create a new organization membership with the given options.
 	Create(ctx context.Context, organization string, options OrganizationMembershipCreateOptions) (*OrganizationMembership, error)
 
+	// InviteBatch concurrently creates an organization membership for each of
+	// the given invitations and returns the outcome for every email.
+	InviteBatch(ctx context.Context, organization string, options OrganizationMembershipInviteBatchOptions) ([]*OrganizationMembershipInviteResult, error)
+
 	// Read an organization membership by ID
 	Read(ctx context.Context, organizationMembershipID string) (*OrganizationMembership, error)
 
@@ -90,6 +94,9 @@ type OrganizationMembershipListOptions struct {
 	// Optional: A query string to search organization memberships by user name
 	// and email.
 	Query string `url:"q,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // OrganizationMembershipCreateOptions represents the options for creating an organization membership.
@@ -107,11 +114,39 @@ type OrganizationMembershipCreateOptions struct {
 	Teams []*Team `jsonapi:"relation,teams,omitempty"`
 }
 
+// OrganizationMembershipInviteBatchOptions represents the options for inviting
+// many users to an organization at once.
+type OrganizationMembershipInviteBatchOptions struct {
+	// Required: The invitations to send, one per user email. Teams can be
+	// assigned per invitation.
+	Invitations []OrganizationMembershipCreateOptions
+
+	// Optional: The maximum number of memberships to create concurrently.
+	// Defaults to 1.
+	Concurrency int
+}
+
+// OrganizationMembershipInviteResult represents the outcome of a single
+// invitation sent by InviteBatch.
+type OrganizationMembershipInviteResult struct {
+	Email string
+
+	// Membership is the created organization membership. It is nil when Err
+	// is set.
+	Membership *OrganizationMembership
+
+	// Err is the error returned while creating the membership, if any.
+	Err error
+}
+
 // OrganizationMembershipReadOptions represents the options for reading organization memberships.
 type OrganizationMembershipReadOptions struct {
 	// Optional: A list of relations to include. See available resources
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/organization-memberships#available-related-resources
 	Include []OrgMembershipIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // List all the organization memberships of the given organization.
@@ -162,6 +197,33 @@ func (s *organizationMemberships) Create(ctx context.Context, organization strin
 	return m, nil
 }
 
+// InviteBatch creates an organization membership for each invitation. The
+// memberships are created concurrently up to options.Concurrency and a failed
+// invitation does not stop the others. Results are returned in the same order
+// as options.Invitations.
+func (s *organizationMemberships) InviteBatch(ctx context.Context, organization string, options OrganizationMembershipInviteBatchOptions) ([]*OrganizationMembershipInviteResult, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	results := make([]*OrganizationMembershipInviteResult, len(options.Invitations))
+	for i, invitation := range options.Invitations {
+		results[i] = &OrganizationMembershipInviteResult{}
+		if invitation.Email != nil {
+			results[i].Email = *invitation.Email
+		}
+	}
+
+	forEachConcurrently(len(results), options.Concurrency, func(i int) {
+		results[i].Membership, results[i].Err = s.Create(ctx, organization, options.Invitations[i])
+	})
+
+	return results, nil
+}
+
 // Read an organization membership by its ID.
 func (s *organizationMemberships) Read(ctx context.Context, organizationMembershipID string) (*OrganizationMembership, error) {
 	return s.ReadWithOptions(ctx, organizationMembershipID, OrganizationMembershipReadOptions{})
@@ -222,6 +284,20 @@ func (o *OrganizationMembershipListOptions) valid() error {
 		return err
 	}
 
+	switch o.Status {
+	case "", OrganizationMembershipActive, OrganizationMembershipInvited:
+	default:
+		return ErrInvalidMembershipStatus
+	}
+
+	return nil
+}
+
+func (o OrganizationMembershipInviteBatchOptions) valid() error {
+	if len(o.Invitations) == 0 {
+		return ErrRequiredInvitations
+	}
+
 	return nil
 }
 
@@ -118,6 +118,14 @@ func TestOrganizationMembershipsList(t *testing.T) {
 		}
 	})
 
+	t.Run("with invalid status filter option", func(t *testing.T) {
+		ml, err := client.OrganizationMemberships.List(ctx, orgTest.Name, &OrganizationMembershipListOptions{
+			Status: "suspended",
+		})
+		assert.Nil(t, ml)
+		assert.Equal(t, ErrInvalidMembershipStatus, err)
+	})
+
 	t.Run("with search query string", func(t *testing.T) {
 		memTest1, memTest1Cleanup := createOrganizationMembership(t, client, orgTest)
 		t.Cleanup(memTest1Cleanup)
@@ -229,6 +237,86 @@ func TestOrganizationMembershipsCreate(t *testing.T) {
 	})
 }
 
+func TestOrganizationMembershipsInviteBatch(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	teamTest, teamTestCleanup := createTeam(t, client, orgTest)
+	t.Cleanup(teamTestCleanup)
+
+	t.Run("with valid invitations", func(t *testing.T) {
+		emails := []string{
+			fmt.Sprintf("%s@tfe.local", randomString(t)),
+			fmt.Sprintf("%s@tfe.local", randomString(t)),
+			fmt.Sprintf("%s@tfe.local", randomString(t)),
+		}
+
+		invitations := make([]OrganizationMembershipCreateOptions, 0, len(emails))
+		for _, email := range emails {
+			invitations = append(invitations, OrganizationMembershipCreateOptions{
+				Email: String(email),
+				Teams: []*Team{teamTest},
+			})
+		}
+
+		results, err := client.OrganizationMemberships.InviteBatch(ctx, orgTest.Name, OrganizationMembershipInviteBatchOptions{
+			Invitations: invitations,
+			Concurrency: 2,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, len(emails))
+
+		for i, result := range results {
+			assert.Equal(t, emails[i], result.Email)
+			require.NoError(t, result.Err)
+			require.NotNil(t, result.Membership)
+			assert.Equal(t, OrganizationMembershipInvited, result.Membership.Status)
+		}
+
+		ml, err := client.OrganizationMemberships.List(ctx, orgTest.Name, &OrganizationMembershipListOptions{
+			Emails: emails,
+		})
+		require.NoError(t, err)
+		assert.Len(t, ml.Items, len(emails))
+	})
+
+	t.Run("when some invitations fail", func(t *testing.T) {
+		email := fmt.Sprintf("%s@tfe.local", randomString(t))
+
+		results, err := client.OrganizationMemberships.InviteBatch(ctx, orgTest.Name, OrganizationMembershipInviteBatchOptions{
+			Invitations: []OrganizationMembershipCreateOptions{
+				{Email: String(email)},
+				{},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		assert.Equal(t, email, results[0].Email)
+		assert.NoError(t, results[0].Err)
+		assert.NotNil(t, results[0].Membership)
+
+		assert.Empty(t, results[1].Email)
+		assert.Equal(t, ErrRequiredEmail, results[1].Err)
+		assert.Nil(t, results[1].Membership)
+	})
+
+	t.Run("without invitations", func(t *testing.T) {
+		results, err := client.OrganizationMemberships.InviteBatch(ctx, orgTest.Name, OrganizationMembershipInviteBatchOptions{})
+		assert.Nil(t, results)
+		assert.Equal(t, ErrRequiredInvitations, err)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		results, err := client.OrganizationMemberships.InviteBatch(ctx, badIdentifier, OrganizationMembershipInviteBatchOptions{})
+		assert.Nil(t, results)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
 func TestOrganizationMembershipsRead(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -336,3 +424,41 @@ func TestOrganizationMembershipsDelete(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestOrganizationMembershipsListIncludes(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/acme/organization-memberships": `{
+			"data": [{
+				"id": "ou-1",
+				"type": "organization-memberships",
+				"attributes": {"email": "alice@example.com", "status": "active"},
+				"relationships": {
+					"user": {"data": {"id": "user-1", "type": "users"}},
+					"teams": {"data": [{"id": "team-1", "type": "teams"}, {"id": "team-2", "type": "teams"}]}
+				}
+			}],
+			"included": [
+				{"id": "user-1", "type": "users", "attributes": {"username": "alice"}},
+				{"id": "team-1", "type": "teams", "attributes": {"name": "owners"}},
+				{"id": "team-2", "type": "teams", "attributes": {"name": "developers"}}
+			],
+			"meta": {"pagination": {"current-page": 1, "total-count": 1}}
+		}`,
+	})
+	defer done()
+
+	ml, err := client.OrganizationMemberships.List(context.Background(), "acme", &OrganizationMembershipListOptions{
+		Include: []OrgMembershipIncludeOpt{OrgMembershipUser, OrgMembershipTeam},
+	})
+	require.NoError(t, err)
+	require.Len(t, ml.Items, 1)
+
+	mem := ml.Items[0]
+	require.NotNil(t, mem.User)
+	assert.Equal(t, "alice", mem.User.Username)
+	require.Len(t, mem.Teams, 2)
+	assert.Equal(t, "owners", mem.Teams[0].Name)
+	assert.Equal(t, "developers", mem.Teams[1].Name)
+}
@@ -23,8 +23,20 @@ type OrganizationTags interface {
 	// Delete tags from an organization
 	Delete(ctx context.Context, organization string, options OrganizationTagsDeleteOptions) error
 
+	// DeleteUnused deletes all tags of an organization that are not attached
+	// to any workspace and returns the deleted tags. A tag attached to a
+	// workspace while the tags are being listed can still be deleted.
+	DeleteUnused(ctx context.Context, organization string) ([]*OrganizationTag, error)
+
 	// Associate an organization's workspace with a tag
 	AddWorkspaces(ctx context.Context, tag string, options AddWorkspacesToTagOptions) error
+
+	// Dissociate an organization's workspaces from a tag
+	RemoveWorkspaces(ctx context.Context, tag string, options RemoveWorkspacesFromTagOptions) error
+
+	// ListWorkspaces lists the workspaces of an organization that have the
+	// named tag.
+	ListWorkspaces(ctx context.Context, organization, tagName string, options *ListOptions) (*WorkspaceList, error)
 }
 
 // organizationTags implements OrganizationTags.
@@ -71,6 +83,12 @@ type AddWorkspacesToTagOptions struct {
 	WorkspaceIDs []string // Required
 }
 
+// RemoveWorkspacesFromTagOptions represents the options to remove workspaces
+// from a tag
+type RemoveWorkspacesFromTagOptions struct {
+	WorkspaceIDs []string // Required
+}
+
 // this represents a single tag ID
 type tagID struct {
 	ID string `jsonapi:"primary,tags"`
@@ -126,6 +144,57 @@ func (s *organizationTags) Delete(ctx context.Context, organization string, opti
 	return req.Do(ctx, nil)
 }
 
+// DeleteUnused deletes all the tags of an organization that are not attached
+// to any workspace.
+//
+// The API cannot filter tags by their number of workspaces, nor delete a tag
+// only while it is unused, so the unused tags are found client-side by listing
+// every page before deleting any tag. This is racy: a tag attached to a
+// workspace after its page was listed is still deleted, which detaches it from
+// that workspace. Do not call DeleteUnused while tags are being attached to
+// workspaces of the organization.
+func (s *organizationTags) DeleteUnused(ctx context.Context, organization string) ([]*OrganizationTag, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	options := &OrganizationTagsListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+	}
+
+	var unused []*OrganizationTag
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		tl, err := s.List(ctx, organization, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, tag := range tl.Items {
+			if tag.InstanceCount == 0 {
+				unused = append(unused, tag)
+			}
+		}
+		return tl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(unused) == 0 {
+		return unused, nil
+	}
+
+	ids := make([]string, 0, len(unused))
+	for _, tag := range unused {
+		ids = append(ids, tag.ID)
+	}
+
+	if err := s.Delete(ctx, organization, OrganizationTagsDeleteOptions{IDs: ids}); err != nil {
+		return nil, err
+	}
+
+	return unused, nil
+}
+
 // Add workspaces to a tag
 func (s *organizationTags) AddWorkspaces(ctx context.Context, tag string, options AddWorkspacesToTagOptions) error {
 	if !validStringID(&tag) {
@@ -150,6 +219,48 @@ func (s *organizationTags) AddWorkspaces(ctx context.Context, tag string, option
 	return req.Do(ctx, nil)
 }
 
+// RemoveWorkspaces removes a tag from each of the given workspaces.
+func (s *organizationTags) RemoveWorkspaces(ctx context.Context, tag string, options RemoveWorkspacesFromTagOptions) error {
+	if !validStringID(&tag) {
+		return ErrInvalidTag
+	}
+
+	if err := options.valid(); err != nil {
+		return err
+	}
+
+	// The API only supports removing tags through the workspace relationship,
+	// so the tag is removed from one workspace at a time.
+	for _, id := range options.WorkspaceIDs {
+		err := s.client.Workspaces.RemoveTags(ctx, id, WorkspaceRemoveTagsOptions{
+			Tags: []*Tag{{ID: tag}},
+		})
+		if err != nil {
+			return fmt.Errorf("failed to remove tag from workspace %s: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// ListWorkspaces lists the workspaces of an organization that have the named
+// tag.
+func (s *organizationTags) ListWorkspaces(ctx context.Context, organization, tagName string, options *ListOptions) (*WorkspaceList, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if !validString(&tagName) {
+		return nil, ErrRequiredName
+	}
+
+	listOpts := &WorkspaceListOptions{TagNames: []string{tagName}}
+	if options != nil {
+		listOpts.ListOptions = *options
+	}
+
+	return s.client.Workspaces.List(ctx, organization, listOpts)
+}
+
 func (opts *OrganizationTagsDeleteOptions) valid() error {
 	if opts.IDs == nil || len(opts.IDs) == 0 {
 		return ErrRequiredTagID
@@ -179,3 +290,18 @@ func (w *AddWorkspacesToTagOptions) valid() error {
 
 	return nil
 }
+
+func (w *RemoveWorkspacesFromTagOptions) valid() error {
+	if len(w.WorkspaceIDs) == 0 {
+		return ErrRequiredTagWorkspaceID
+	}
+
+	for _, id := range w.WorkspaceIDs {
+		if !validStringID(&id) {
+			errorMsg := fmt.Sprintf("%s is not a valid id value", id)
+			return errors.New(errorMsg)
+		}
+	}
+
+	return nil
+}
@@ -208,3 +208,110 @@ func TestOrganizationTagsAddWorkspace(t *testing.T) {
 		assert.Equal(t, fetched.Items[0].ID, tagID)
 	})
 }
+
+func TestOrganizationTagsRemoveWorkspaces(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	workspaceTest1, workspaceTest1Cleanup := createWorkspace(t, client, orgTest)
+	defer workspaceTest1Cleanup()
+
+	workspaceTest2, workspaceTest2Cleanup := createWorkspace(t, client, orgTest)
+	defer workspaceTest2Cleanup()
+
+	for _, w := range []*Workspace{workspaceTest1, workspaceTest2} {
+		err := client.Workspaces.AddTags(ctx, w.ID, WorkspaceAddTagsOptions{
+			Tags: []*Tag{{Name: "tag0"}, {Name: "tag1"}},
+		})
+		require.NoError(t, err)
+	}
+
+	tags, err := client.OrganizationTags.List(ctx, orgTest.Name, &OrganizationTagsListOptions{
+		Query: "tag0",
+	})
+	require.NoError(t, err)
+	require.Len(t, tags.Items, 1)
+	tagID := tags.Items[0].ID
+
+	t.Run("list workspaces for a tag", func(t *testing.T) {
+		wl, err := client.OrganizationTags.ListWorkspaces(ctx, orgTest.Name, "tag0", nil)
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 2)
+	})
+
+	t.Run("remove the tag from the workspaces", func(t *testing.T) {
+		err := client.OrganizationTags.RemoveWorkspaces(ctx, tagID, RemoveWorkspacesFromTagOptions{
+			WorkspaceIDs: []string{workspaceTest1.ID, workspaceTest2.ID},
+		})
+		require.NoError(t, err)
+
+		wl, err := client.OrganizationTags.ListWorkspaces(ctx, orgTest.Name, "tag0", nil)
+		require.NoError(t, err)
+		assert.Empty(t, wl.Items)
+
+		fetched, err := client.Workspaces.ListTags(ctx, workspaceTest1.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, fetched.Items, 1)
+		assert.Equal(t, "tag1", fetched.Items[0].Name)
+	})
+
+	t.Run("without workspace IDs", func(t *testing.T) {
+		err := client.OrganizationTags.RemoveWorkspaces(ctx, tagID, RemoveWorkspacesFromTagOptions{})
+		assert.Equal(t, ErrRequiredTagWorkspaceID, err)
+	})
+
+	t.Run("with an invalid tag ID", func(t *testing.T) {
+		err := client.OrganizationTags.RemoveWorkspaces(ctx, badIdentifier, RemoveWorkspacesFromTagOptions{
+			WorkspaceIDs: []string{workspaceTest1.ID},
+		})
+		assert.Equal(t, ErrInvalidTag, err)
+	})
+
+	t.Run("listing workspaces without a tag name", func(t *testing.T) {
+		wl, err := client.OrganizationTags.ListWorkspaces(ctx, orgTest.Name, "", nil)
+		assert.Nil(t, wl)
+		assert.Equal(t, ErrRequiredName, err)
+	})
+}
+
+func TestOrganizationTagsDeleteUnused(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	workspaceTest, workspaceTestCleanup := createWorkspace(t, client, orgTest)
+	defer workspaceTestCleanup()
+
+	err := client.Workspaces.AddTags(ctx, workspaceTest.ID, WorkspaceAddTagsOptions{
+		Tags: []*Tag{{Name: "used"}, {Name: "unused"}},
+	})
+	require.NoError(t, err)
+
+	err = client.Workspaces.RemoveTags(ctx, workspaceTest.ID, WorkspaceRemoveTagsOptions{
+		Tags: []*Tag{{Name: "unused"}},
+	})
+	require.NoError(t, err)
+
+	t.Run("delete the unused tags", func(t *testing.T) {
+		deleted, err := client.OrganizationTags.DeleteUnused(ctx, orgTest.Name)
+		require.NoError(t, err)
+		require.Len(t, deleted, 1)
+		assert.Equal(t, "unused", deleted[0].Name)
+
+		tags, err := client.OrganizationTags.List(ctx, orgTest.Name, nil)
+		require.NoError(t, err)
+		require.Len(t, tags.Items, 1)
+		assert.Equal(t, "used", tags.Items[0].Name)
+	})
+
+	t.Run("when there are no unused tags", func(t *testing.T) {
+		deleted, err := client.OrganizationTags.DeleteUnused(ctx, orgTest.Name)
+		require.NoError(t, err)
+		assert.Empty(t, deleted)
+	})
+}
@@ -5,6 +5,7 @@ package tfe
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"time"
@@ -44,6 +45,9 @@ type OrganizationTokens interface {
 
 	// Delete an organization token with options.
 	DeleteWithOptions(ctx context.Context, organization string, options OrganizationTokenDeleteOptions) error
+
+	// Rotate replaces an organization token with a new token.
+	Rotate(ctx context.Context, organization string, options OrganizationTokenCreateOptions) (*OrganizationTokenRotation, error)
 }
 
 // organizationTokens implements OrganizationTokens.
@@ -62,6 +66,15 @@ type OrganizationToken struct {
 	CreatedBy   *CreatedByChoice `jsonapi:"polyrelation,created-by"`
 }
 
+// OrganizationTokenRotation is the result of rotating an organization token.
+type OrganizationTokenRotation struct {
+	// The token that was replaced, as read before it was replaced, or nil if
+	// the organization had no token.
+	Old *OrganizationToken
+	// The token that replaced it, including its secret value.
+	New *OrganizationToken
+}
+
 // OrganizationTokenCreateOptions contains the options for creating an organization token.
 type OrganizationTokenCreateOptions struct {
 	// Optional: The token's expiration date.
@@ -167,3 +180,24 @@ func (s *organizationTokens) DeleteWithOptions(ctx context.Context, organization
 
 	return req.Do(ctx, nil)
 }
+
+// Rotate reads the current organization token of the given type and replaces
+// it with a new token. As an organization has a single token of each type,
+// the old token stops working as soon as the new one is created.
+func (s *organizationTokens) Rotate(ctx context.Context, organization string, options OrganizationTokenCreateOptions) (*OrganizationTokenRotation, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	old, err := s.ReadWithOptions(ctx, organization, OrganizationTokenReadOptions{TokenType: options.TokenType})
+	if err != nil && !errors.Is(err, ErrResourceNotFound) {
+		return nil, err
+	}
+
+	created, err := s.CreateWithOptions(ctx, organization, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OrganizationTokenRotation{Old: old, New: created}, nil
+}
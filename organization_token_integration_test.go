@@ -250,3 +250,36 @@ func TestOrganizationTokensDeleteWithOptions(t *testing.T) {
 		assert.EqualError(t, err, ErrInvalidOrg.Error())
 	})
 }
+
+func TestOrganizationTokensRotate(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/acme/authentication-token":  `{"data":{"id":"at-old","type":"authentication-tokens","attributes":{}}}`,
+		"POST /api/v2/organizations/acme/authentication-token": `{"data":{"id":"at-new","type":"authentication-tokens","attributes":{"token":"secret"}}}`,
+		"POST /api/v2/organizations/new/authentication-token":  `{"data":{"id":"at-first","type":"authentication-tokens","attributes":{"token":"secret"}}}`,
+	})
+	defer done()
+
+	ctx := context.Background()
+
+	t.Run("with an existing token", func(t *testing.T) {
+		rotation, err := client.OrganizationTokens.Rotate(ctx, "acme", OrganizationTokenCreateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "at-old", rotation.Old.ID)
+		assert.Equal(t, "at-new", rotation.New.ID)
+	})
+
+	t.Run("without an existing token", func(t *testing.T) {
+		rotation, err := client.OrganizationTokens.Rotate(ctx, "new", OrganizationTokenCreateOptions{})
+		require.NoError(t, err)
+		assert.Nil(t, rotation.Old)
+		assert.Equal(t, "at-first", rotation.New.ID)
+	})
+
+	t.Run("with an invalid organization", func(t *testing.T) {
+		rotation, err := client.OrganizationTokens.Rotate(ctx, badIdentifier, OrganizationTokenCreateOptions{})
+		assert.Nil(t, rotation)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
@@ -51,6 +51,17 @@ const (
 	PlanUnreachable PlanStatus = "unreachable"
 )
 
+// IsTerminal reports whether a plan with the status has stopped, so its
+// status does not change anymore.
+func (s PlanStatus) IsTerminal() bool {
+	switch s {
+	case PlanCanceled, PlanErrored, PlanFinished, PlanUnreachable:
+		return true
+	default:
+		return false
+	}
+}
+
 // Plan represents a Terraform Enterprise plan.
 type Plan struct {
 	ID                     string                `jsonapi:"primary,plans"`
@@ -127,12 +138,7 @@ func (s *plans) Logs(ctx context.Context, planID string) (io.Reader, error) {
 			return false, err
 		}
 
-		switch p.Status {
-		case PlanCanceled, PlanErrored, PlanFinished, PlanUnreachable:
-			return true, nil
-		default:
-			return false, nil
-		}
+		return p.Status.IsTerminal(), nil
 	}
 
 	return &LogReader{
@@ -7,8 +7,11 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"time"
+
+	slug "github.com/hashicorp/go-slug"
 )
 
 // Compile-time proof of interface implementation.
@@ -30,6 +33,14 @@ type PlanExports interface {
 
 	// Download the data of an plan export.
 	Download(ctx context.Context, planExportID string) ([]byte, error)
+
+	// DownloadReader streams the data of a plan export, a gzip-compressed
+	// tar archive. The caller must close it.
+	DownloadReader(ctx context.Context, planExportID string) (io.ReadCloser, error)
+
+	// DownloadTo downloads the data of a plan export and extracts it into
+	// the given directory.
+	DownloadTo(ctx context.Context, planExportID string, dir string) error
 }
 
 // planExports implements PlanExports.
@@ -167,6 +178,46 @@ func (s *planExports) Download(ctx context.Context, planExportID string) ([]byte
 	return buf.Bytes(), nil
 }
 
+// DownloadReader streams the data of a plan export without buffering it in
+// memory. For a sentinel mock bundle, the data is a gzip-compressed tar
+// archive. The caller must close the returned reader.
+func (s *planExports) DownloadReader(ctx context.Context, planExportID string) (io.ReadCloser, error) {
+	if !validStringID(&planExportID) {
+		return nil, ErrInvalidPlanExportID
+	}
+
+	u := fmt.Sprintf("plan-exports/%s/download", url.PathEscape(planExportID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := req.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Body, nil
+}
+
+// DownloadTo downloads the data of a plan export and extracts it into dir as
+// it is downloaded, creating dir if needed. Archive entries that would be
+// extracted outside of dir, including through symlinks, are rejected with a
+// *slug.IllegalSlugError.
+func (s *planExports) DownloadTo(ctx context.Context, planExportID, dir string) error {
+	if !validString(&dir) {
+		return ErrRequiredDirectory
+	}
+
+	body, err := s.DownloadReader(ctx, planExportID)
+	if err != nil {
+		return err
+	}
+	defer body.Close()
+
+	return slug.Unpack(body, dir)
+}
+
 func (o PlanExportCreateOptions) valid() error {
 	if o.Plan == nil {
 		return ErrRequiredPlan
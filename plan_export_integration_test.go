@@ -4,12 +4,18 @@
 package tfe
 
 import (
+	"archive/tar"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
+	slug "github.com/hashicorp/go-slug"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -125,6 +131,91 @@ func TestPlanExportsDownload(t *testing.T) {
 	})
 }
 
+// tarGzip returns a gzip-compressed tar archive of the given files, keyed by
+// their path in the archive.
+func tarGzip(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(zw)
+	for name, content := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{
+			Name:     name,
+			Mode:     0o644,
+			Size:     int64(len(content)),
+			Typeflag: tar.TypeReg,
+		}))
+		_, err := tw.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, zw.Close())
+
+	return buf.String()
+}
+
+func TestPlanExportsDownloadTo(t *testing.T) {
+	t.Parallel()
+
+	bundle := tarGzip(t, map[string]string{
+		"mock-tfplan-v2.sentinel":    "resource_changes = {}",
+		"sentinel.hcl":               `mock "tfplan/v2" {}`,
+		"nested/mock-tfrun.sentinel": "id = \"run-1\"",
+	})
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/plan-exports/pe-1/download":    bundle,
+		"GET /api/v2/plan-exports/pe-evil/download": tarGzip(t, map[string]string{"../evil.sentinel": "evil"}),
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("streaming the bundle", func(t *testing.T) {
+		r, err := client.PlanExports.DownloadReader(ctx, "pe-1")
+		require.NoError(t, err)
+		defer r.Close()
+
+		data, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, bundle, string(data))
+	})
+
+	t.Run("extracting the bundle", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "mocks")
+		require.NoError(t, client.PlanExports.DownloadTo(ctx, "pe-1", dir))
+
+		data, err := os.ReadFile(filepath.Join(dir, "mock-tfplan-v2.sentinel"))
+		require.NoError(t, err)
+		assert.Equal(t, "resource_changes = {}", string(data))
+
+		data, err = os.ReadFile(filepath.Join(dir, "nested", "mock-tfrun.sentinel"))
+		require.NoError(t, err)
+		assert.Equal(t, `id = "run-1"`, string(data))
+	})
+
+	t.Run("with an entry outside of the directory", func(t *testing.T) {
+		root := t.TempDir()
+		err := client.PlanExports.DownloadTo(ctx, "pe-evil", filepath.Join(root, "mocks"))
+
+		var illegal *slug.IllegalSlugError
+		assert.ErrorAs(t, err, &illegal)
+		assert.NoFileExists(t, filepath.Join(root, "evil.sentinel"))
+	})
+
+	t.Run("with an unknown plan export", func(t *testing.T) {
+		err := client.PlanExports.DownloadTo(ctx, "pe-2", t.TempDir())
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("with invalid arguments", func(t *testing.T) {
+		_, err := client.PlanExports.DownloadReader(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidPlanExportID, err)
+
+		err = client.PlanExports.DownloadTo(ctx, "pe-1", "")
+		assert.Equal(t, ErrRequiredDirectory, err)
+	})
+}
+
 func TestPlanExport_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
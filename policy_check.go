@@ -207,20 +207,19 @@ func (s *policyChecks) Logs(ctx context.Context, policyCheckID string) (io.Reade
 	// Loop until the context is canceled or the policy check is finished
 	// running. The policy check logs are not streamed and so only available
 	// once the check is finished.
-	for {
-		pc, err := s.Read(ctx, policyCheckID)
+	b := s.client.pollingBackoff(Backoff{Min: 500 * time.Millisecond})
+	for reads := 0; ; reads++ {
+		pc, err := s.Read(ContextWithoutCache(ctx), policyCheckID)
 		if err != nil {
 			return nil, err
 		}
 
 		switch pc.Status {
 		case PolicyPending, PolicyQueued:
-			select {
-			case <-ctx.Done():
-				return nil, ctx.Err()
-			case <-time.After(500 * time.Millisecond):
-				continue
+			if err := b.Wait(ctx, reads, nil); err != nil {
+				return nil, err
 			}
+			continue
 		}
 
 		u := fmt.Sprintf("policy-checks/%s/output", url.PathEscape(policyCheckID))
@@ -39,6 +39,10 @@ type PolicySets interface {
 	// ReadWithOptions reads a policy set by its ID using the options supplied.
 	ReadWithOptions(ctx context.Context, policySetID string, options *PolicySetReadOptions) (*PolicySet, error)
 
+	// ReadScope reads the scope of a policy set: whether it is global, and
+	// the projects, workspaces and workspace exclusions it is attached to.
+	ReadScope(ctx context.Context, policySetID string) (*PolicySetScope, error)
+
 	// Update an existing policy set.
 	Update(ctx context.Context, policySetID string, options PolicySetUpdateOptions) (*PolicySet, error)
 
@@ -102,6 +106,8 @@ type PolicySet struct {
 	AgentEnabled      bool      `jsonapi:"attr,agent-enabled"`
 	PolicyToolVersion string    `jsonapi:"attr,policy-tool-version"`
 
+	Permissions *PolicySetPermissions `jsonapi:"attr,permissions"`
+
 	// Relations
 	// The organization to which the policy set belongs to.
 	Organization *Organization `jsonapi:"relation,organization"`
@@ -121,6 +127,58 @@ type PolicySet struct {
 	Projects []*Project `jsonapi:"relation,projects"`
 }
 
+// PolicySetPermissions represents the policy set permissions of the API
+// consumer.
+type PolicySetPermissions struct {
+	CanRead    bool `jsonapi:"attr,can-read"`
+	CanUpdate  bool `jsonapi:"attr,can-update"`
+	CanDestroy bool `jsonapi:"attr,can-destroy"`
+}
+
+// PolicySetScope represents the workspaces a policy set is enforced on. A
+// policy set is enforced on all the workspaces of its organization when it is
+// global, and otherwise on the workspaces it is attached to, directly or
+// through their project. Workspace exclusions take precedence over both.
+type PolicySetScope struct {
+	PolicySet *PolicySet
+
+	Global              bool
+	Projects            []*Project
+	Workspaces          []*Workspace
+	WorkspaceExclusions []*Workspace
+}
+
+// AppliesTo reports whether the policy set is enforced on the workspace. The
+// project of the workspace is compared by ID, so a workspace read without its
+// project included can be given.
+func (s *PolicySetScope) AppliesTo(w *Workspace) bool {
+	for _, excluded := range s.WorkspaceExclusions {
+		if excluded != nil && excluded.ID == w.ID {
+			return false
+		}
+	}
+
+	if s.Global {
+		return true
+	}
+
+	for _, ws := range s.Workspaces {
+		if ws != nil && ws.ID == w.ID {
+			return true
+		}
+	}
+
+	if w.Project != nil {
+		for _, p := range s.Projects {
+			if p != nil && p.ID == w.Project.ID {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 // PolicySetIncludeOpt represents the available options for include query params.
 // https://developer.hashicorp.com/terraform/cloud-docs/api-docs/policy-sets#available-related-resources
 type PolicySetIncludeOpt string
@@ -147,6 +205,9 @@ type PolicySetListOptions struct {
 	// Optional: A list of relations to include. See available resources
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/policy-sets#available-related-resources
 	Include []PolicySetIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // PolicySetReadOptions are read options.
@@ -156,6 +217,9 @@ type PolicySetReadOptions struct {
 	// Optional: A list of relations to include. See available resources
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/policy-sets#available-related-resources
 	Include []PolicySetIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // PolicySetCreateOptions represents the options for creating a new policy set.
@@ -382,6 +446,25 @@ func (s *policySets) ReadWithOptions(ctx context.Context, policySetID string, op
 	return ps, err
 }
 
+// ReadScope reads a policy set with its projects, workspaces and workspace
+// exclusions included, in a single request.
+func (s *policySets) ReadScope(ctx context.Context, policySetID string) (*PolicySetScope, error) {
+	ps, err := s.ReadWithOptions(ctx, policySetID, &PolicySetReadOptions{
+		Include: []PolicySetIncludeOpt{PolicySetProjects, PolicySetWorkspaces, PolicySetWorkspaceExclusions},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &PolicySetScope{
+		PolicySet:           ps,
+		Global:              ps.Global,
+		Projects:            ps.Projects,
+		Workspaces:          ps.Workspaces,
+		WorkspaceExclusions: ps.WorkspaceExclusions,
+	}, nil
+}
+
 // Update an existing policy set.
 func (s *policySets) Update(ctx context.Context, policySetID string, options PolicySetUpdateOptions) (*PolicySet, error) {
 	if !validStringID(&policySetID) {
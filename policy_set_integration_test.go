@@ -4,7 +4,9 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"os"
 	"regexp"
@@ -1273,3 +1275,87 @@ func TestPolicySetsDelete(t *testing.T) {
 		assert.Equal(t, err, ErrInvalidPolicySetID)
 	})
 }
+
+func TestPolicySet_Unmarshal(t *testing.T) {
+	data := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "policy-sets",
+			"id":   "polset-1234",
+			"attributes": map[string]interface{}{
+				"name": "my-set",
+				"permissions": map[string]interface{}{
+					"can-read":    true,
+					"can-update":  true,
+					"can-destroy": false,
+				},
+			},
+		},
+	}
+
+	byteData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	s := &PolicySet{}
+	err = unmarshalResponse(bytes.NewReader(byteData), s)
+	require.NoError(t, err)
+
+	assert.Equal(t, "polset-1234", s.ID)
+	require.NotNil(t, s.Permissions)
+	assert.True(t, s.Permissions.CanRead)
+	assert.True(t, s.Permissions.CanUpdate)
+	assert.False(t, s.Permissions.CanDestroy)
+}
+
+func TestPolicySetsReadScope(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/policy-sets/polset-1": `{"data":{"id":"polset-1","type":"policy-sets","attributes":{"name":"mixed","global":false},
+			"relationships":{
+				"projects":{"data":[{"id":"prj-1","type":"projects"}]},
+				"workspaces":{"data":[{"id":"ws-2","type":"workspaces"}]},
+				"workspace-exclusions":{"data":[{"id":"ws-3","type":"workspaces"}]}}},
+			"included":[
+				{"id":"prj-1","type":"projects","attributes":{"name":"platform"}},
+				{"id":"ws-2","type":"workspaces","attributes":{"name":"two"}},
+				{"id":"ws-3","type":"workspaces","attributes":{"name":"three"}}]}`,
+		"GET /api/v2/policy-sets/polset-2": `{"data":{"id":"polset-2","type":"policy-sets","attributes":{"name":"global","global":true},
+			"relationships":{"workspace-exclusions":{"data":[{"id":"ws-3","type":"workspaces"}]}}}}`,
+	})
+	defer done()
+	ctx := context.Background()
+
+	inProject := func(id, projectID string) *Workspace {
+		return &Workspace{ID: id, Project: &Project{ID: projectID}}
+	}
+
+	t.Run("with projects, workspaces and exclusions", func(t *testing.T) {
+		scope, err := client.PolicySets.ReadScope(ctx, "polset-1")
+		require.NoError(t, err)
+		assert.Equal(t, "mixed", scope.PolicySet.Name)
+		assert.False(t, scope.Global)
+		require.Len(t, scope.Projects, 1)
+		assert.Equal(t, "platform", scope.Projects[0].Name)
+		require.Len(t, scope.Workspaces, 1)
+		require.Len(t, scope.WorkspaceExclusions, 1)
+
+		assert.True(t, scope.AppliesTo(inProject("ws-1", "prj-1")), "attached through its project")
+		assert.True(t, scope.AppliesTo(inProject("ws-2", "prj-2")), "attached directly")
+		assert.False(t, scope.AppliesTo(inProject("ws-3", "prj-1")), "excluded from its project")
+		assert.False(t, scope.AppliesTo(inProject("ws-4", "prj-2")), "not attached")
+		assert.False(t, scope.AppliesTo(&Workspace{ID: "ws-5"}), "without a project")
+	})
+
+	t.Run("when global", func(t *testing.T) {
+		scope, err := client.PolicySets.ReadScope(ctx, "polset-2")
+		require.NoError(t, err)
+		assert.True(t, scope.Global)
+		assert.True(t, scope.AppliesTo(inProject("ws-1", "prj-1")))
+		assert.False(t, scope.AppliesTo(inProject("ws-3", "prj-1")))
+	})
+
+	t.Run("without a valid policy set ID", func(t *testing.T) {
+		_, err := client.PolicySets.ReadScope(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidPolicySetID, err)
+	})
+}
@@ -28,6 +28,10 @@ type PolicySetVersions interface {
 	// to the set of sentinel files, which will be packaged by hashicorp/go-slug
 	// before being uploaded.
 	Upload(ctx context.Context, psv PolicySetVersion, path string) error
+
+	// UploadAndWait creates a Policy Set Version, uploads the policy files
+	// found in path and waits until the version is ready or has errored.
+	UploadAndWait(ctx context.Context, policySetID string, path string, timeout time.Duration) (*PolicySetVersion, error)
 }
 
 // policySetVersions implements PolicySetVersions.
@@ -85,6 +89,32 @@ type PolicySetVersion struct {
 	Links map[string]interface{} `jsonapi:"links,omitempty"`
 }
 
+// PolicySetVersionError is returned by UploadAndWait along with the policy
+// set version when the version failed to be ingested. It wraps
+// ErrPolicySetVersionErrored.
+type PolicySetVersionError struct {
+	PolicySetVersionID string
+
+	// The error code and message reported by the policy set version.
+	Code    string
+	Message string
+}
+
+// Error returns a message including the error reported by the policy set
+// version.
+func (e *PolicySetVersionError) Error() string {
+	msg := e.Message
+	if msg == "" {
+		msg = e.Code
+	}
+	return fmt.Sprintf("%s: %s: %s", ErrPolicySetVersionErrored, e.PolicySetVersionID, msg)
+}
+
+// Unwrap returns ErrPolicySetVersionErrored.
+func (e *PolicySetVersionError) Unwrap() error {
+	return ErrPolicySetVersionErrored
+}
+
 func (p PolicySetVersion) uploadURL() (string, error) {
 	uploadURL, ok := p.Links["upload"].(string)
 	if !ok {
@@ -156,3 +186,50 @@ func (p *policySetVersions) Upload(ctx context.Context, psv PolicySetVersion, pa
 
 	return p.client.doForeignPUTRequest(ctx, uploadURL, body)
 }
+
+// UploadAndWait creates a Policy Set Version for the policy set, uploads the
+// policy files found in path, packaged by hashicorp/go-slug, and polls the
+// version until it is ready or has errored. If the version errored, it is
+// returned along with a *PolicySetVersionError. A zero timeout waits until ctx
+// is done.
+func (p *policySetVersions) UploadAndWait(ctx context.Context, policySetID, path string, timeout time.Duration) (*PolicySetVersion, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	psv, err := p.Create(ctx, policySetID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.Upload(ctx, *psv, path); err != nil {
+		return nil, err
+	}
+
+	quitStatus := []string{string(PolicySetVersionReady), string(PolicySetVersionErrored)}
+	var final WaitForStatusResult
+	for result := range awaitPoll(ctx, psv.ID, p.client.pollingBackoff(pollBackoff), func(ctx context.Context) (string, error) {
+		psv, err = p.Read(ctx, psv.ID)
+		if err != nil {
+			return "", err
+		}
+		return string(psv.Status), nil
+	}, quitStatus) {
+		final = result
+	}
+
+	if final.Error != nil {
+		return nil, final.Error
+	}
+	if psv.Status == PolicySetVersionErrored {
+		return psv, &PolicySetVersionError{
+			PolicySetVersionID: psv.ID,
+			Code:               psv.Error,
+			Message:            psv.ErrorMessage,
+		}
+	}
+
+	return psv, nil
+}
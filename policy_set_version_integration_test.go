@@ -5,6 +5,12 @@ package tfe
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -139,3 +145,82 @@ func TestPolicySetVersionsUploadURL(t *testing.T) {
 		assert.EqualError(t, err, "the Policy Set Version upload URL is empty")
 	})
 }
+
+func TestPolicySetVersionsUploadAndWait(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	status := map[string]PolicySetVersionStatus{}
+	var srv *httptest.Server
+	srv = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "POST" && strings.HasPrefix(r.URL.Path, "/api/v2/policy-sets/"):
+			// The ID of the version is the ID of its policy set, so the
+			// status of each version can be set below.
+			id := strings.TrimSuffix(strings.TrimPrefix(r.URL.Path, "/api/v2/policy-sets/"), "/versions")
+			status[id] = PolicySetVersionPending
+			w.WriteHeader(http.StatusCreated)
+			fmt.Fprintf(w, `{"data":{"id":"%s","type":"policy-set-versions","attributes":{"status":"pending"},
+				"links":{"upload":"%s/upload/%s"}}}`, id, srv.URL, id)
+		case r.Method == "PUT" && strings.HasPrefix(r.URL.Path, "/upload/"):
+			id := strings.TrimPrefix(r.URL.Path, "/upload/")
+			switch id {
+			case "polset-bad":
+				status[id] = PolicySetVersionErrored
+			case "polset-good":
+				status[id] = PolicySetVersionReady
+			}
+		case r.Method == "GET" && strings.HasPrefix(r.URL.Path, "/api/v2/policy-set-versions/"):
+			id := strings.TrimPrefix(r.URL.Path, "/api/v2/policy-set-versions/")
+			attributes := fmt.Sprintf(`"status":"%s"`, status[id])
+			if status[id] == PolicySetVersionErrored {
+				attributes += `,"error":"invalid_config","error-message":"sentinel.hcl: unknown block"`
+			}
+			fmt.Fprintf(w, `{"data":{"id":"%s","type":"policy-set-versions","attributes":{%s}}}`, id, attributes)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("ready", func(t *testing.T) {
+		t.Parallel()
+
+		psv, err := client.PolicySetVersions.UploadAndWait(ctx, "polset-good", "test-fixtures/policy-set-version", time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, PolicySetVersionReady, psv.Status)
+	})
+
+	t.Run("errored", func(t *testing.T) {
+		t.Parallel()
+
+		psv, err := client.PolicySetVersions.UploadAndWait(ctx, "polset-bad", "test-fixtures/policy-set-version", time.Minute)
+		require.NotNil(t, psv)
+		assert.Equal(t, PolicySetVersionErrored, psv.Status)
+		assert.ErrorIs(t, err, ErrPolicySetVersionErrored)
+
+		var psvErr *PolicySetVersionError
+		require.True(t, errors.As(err, &psvErr))
+		assert.Equal(t, "polset-bad", psvErr.PolicySetVersionID)
+		assert.Equal(t, "invalid_config", psvErr.Code)
+		assert.Equal(t, "sentinel.hcl: unknown block", psvErr.Message)
+	})
+
+	t.Run("timed out", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := client.PolicySetVersions.UploadAndWait(ctx, "polset-slow", "test-fixtures/policy-set-version", 100*time.Millisecond)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
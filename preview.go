@@ -0,0 +1,318 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// Compile-time proof of interface implementation.
+var _ Previews = (*previews)(nil)
+
+// Previews describes the methods to provision ephemeral workspaces for the
+// pull requests of a repository, built on the workspace, variable and run
+// endpoints of the API.
+type Previews interface {
+	// CreateForPR creates the preview workspace of a pull request from a
+	// template workspace and queues its initial run.
+	CreateForPR(ctx context.Context, options PreviewOptions) (*Preview, error)
+
+	// DestroyForPR queues a run destroying the resources of the preview
+	// workspace of a pull request.
+	DestroyForPR(ctx context.Context, options PreviewOptions) (*Preview, error)
+}
+
+// previews implements Previews.
+type previews struct {
+	client *Client
+}
+
+// PreviewOptions represents the options for creating and destroying the
+// preview workspace of a pull request.
+type PreviewOptions struct {
+	// Required: The name of the organization.
+	Organization string
+
+	// Required: The name of the workspace the preview workspace is created
+	// from.
+	TemplateWorkspace string
+
+	// Required: The number of the pull request.
+	PRNumber int
+
+	// Optional: The project to create the preview workspace in. Defaults to
+	// the project of the template workspace.
+	Project *Project
+
+	// Optional: The branch of the pull request, which the preview workspace
+	// tracks instead of the branch of the template workspace when the
+	// template workspace is connected to a VCS repository.
+	Branch string
+
+	// Optional: How long the preview workspace lives before its resources are
+	// automatically destroyed. Zero disables the automatic destruction.
+	TTL time.Duration
+}
+
+// Preview represents the preview workspace of a pull request.
+type Preview struct {
+	// The preview workspace.
+	Workspace *Workspace
+
+	// The variables copied from the template workspace.
+	Variables []*Variable
+
+	// The keys of the sensitive variables of the template workspace, which
+	// could not be copied as their values cannot be read.
+	SkippedVariables []string
+
+	// The run queued for the preview workspace, or nil if no run was queued.
+	Run *Run
+}
+
+// PreviewWorkspaceName returns the name of the preview workspace of a pull
+// request.
+func PreviewWorkspaceName(templateWorkspace string, prNumber int) string {
+	return fmt.Sprintf("%s-pr-%d", templateWorkspace, prNumber)
+}
+
+// CreateForPR creates the preview workspace of a pull request, named after
+// the template workspace and the number of the pull request. The preview
+// workspace gets the Terraform version, working directory, execution mode and
+// VCS repository of the template workspace, and a copy of its non-sensitive
+// variables. It applies its runs automatically and, when a TTL is set, its
+// resources are destroyed automatically once the TTL has elapsed.
+//
+// The template workspace must be connected to a VCS repository, otherwise
+// ErrPreviewTemplateWithoutVCSRepo is returned. Once the configuration of the
+// preview workspace has been ingested from the repository, a run is queued
+// with it.
+//
+// If the preview workspace cannot be fully set up, it is deleted and the
+// error is returned.
+func (s *previews) CreateForPR(ctx context.Context, options PreviewOptions) (*Preview, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	template, err := s.client.Workspaces.Read(ctx, options.Organization, options.TemplateWorkspace)
+	if err != nil {
+		return nil, err
+	}
+	if template.VCSRepo == nil {
+		return nil, ErrPreviewTemplateWithoutVCSRepo
+	}
+
+	ws, err := s.client.Workspaces.Create(ctx, options.Organization, options.workspaceCreateOptions(template))
+	if err != nil {
+		return nil, err
+	}
+
+	preview, err := s.setUp(ctx, template, ws, options)
+	if err != nil {
+		if deleteErr := s.client.Workspaces.DeleteByID(ctx, ws.ID); deleteErr != nil {
+			return nil, fmt.Errorf("%w (deleting preview workspace %s: %v)", err, ws.ID, deleteErr)
+		}
+		return nil, err
+	}
+
+	return preview, nil
+}
+
+// setUp copies the variables of the template workspace to the preview
+// workspace and queues its initial run once its configuration is ingested.
+func (s *previews) setUp(ctx context.Context, template, ws *Workspace, options PreviewOptions) (*Preview, error) {
+	preview := &Preview{Workspace: ws}
+
+	var vars []*Variable
+	listOptions := &VariableListOptions{ListOptions: ListOptions{PageSize: 100}}
+	err := forEachPage(&listOptions.ListOptions, func() (*Pagination, error) {
+		vl, err := s.client.Variables.List(ctx, template.ID, listOptions)
+		if err != nil {
+			return nil, err
+		}
+		vars = append(vars, vl.Items...)
+		return vl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var creates []VariableCreateOptions
+	for _, v := range vars {
+		if v.Sensitive {
+			preview.SkippedVariables = append(preview.SkippedVariables, v.Key)
+			continue
+		}
+		creates = append(creates, VariableCreateOptions{
+			Key:         String(v.Key),
+			Value:       String(v.Value),
+			Description: String(v.Description),
+			Category:    Category(v.Category),
+			HCL:         Bool(v.HCL),
+		})
+	}
+
+	if len(creates) > 0 {
+		results, err := s.client.Variables.CreateBatch(ctx, ws.ID, creates)
+		if err != nil {
+			return nil, err
+		}
+		for _, result := range results {
+			if result.Err != nil {
+				return nil, result.Err
+			}
+			preview.Variables = append(preview.Variables, result.Variable)
+		}
+	}
+
+	cv, err := s.awaitConfigurationVersion(ctx, ws)
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := s.client.Runs.Create(ctx, RunCreateOptions{
+		Workspace:            ws,
+		ConfigurationVersion: cv,
+		Message:              String(fmt.Sprintf("Preview of pull request #%d", options.PRNumber)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	preview.Run = run
+
+	return preview, nil
+}
+
+// awaitConfigurationVersion polls the preview workspace until the
+// configuration version ingested from its VCS repository when it was created
+// is uploaded, and returns it.
+func (s *previews) awaitConfigurationVersion(ctx context.Context, ws *Workspace) (*ConfigurationVersion, error) {
+	var cv *ConfigurationVersion
+	quitStatus := []string{string(ConfigurationUploaded), string(ConfigurationErrored)}
+
+	var final WaitForStatusResult
+	for result := range awaitPoll(ctx, ws.ID, s.client.pollingBackoff(pollBackoff), func(ctx context.Context) (string, error) {
+		cvl, err := s.client.ConfigurationVersions.List(ctx, ws.ID, &ConfigurationVersionListOptions{
+			ListOptions: ListOptions{PageSize: 1},
+		})
+		if err != nil {
+			return "", err
+		}
+		if len(cvl.Items) == 0 {
+			return "", nil
+		}
+		cv = cvl.Items[0]
+		return string(cv.Status), nil
+	}, quitStatus) {
+		final = result
+	}
+
+	if final.Error != nil {
+		return nil, final.Error
+	}
+	if cv.Status == ConfigurationErrored {
+		return nil, fmt.Errorf("%w: %s", ErrPreviewConfigurationErrored, cv.ErrorMessage)
+	}
+
+	return cv, nil
+}
+
+// DestroyForPR queues a run destroying the resources of the preview workspace
+// of a pull request, which is applied automatically. The preview workspace
+// itself is kept, so its history can still be inspected; it can be deleted
+// with Workspaces.SafeDelete once the run is applied.
+func (s *previews) DestroyForPR(ctx context.Context, options PreviewOptions) (*Preview, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	ws, err := s.client.Workspaces.Read(ctx, options.Organization, PreviewWorkspaceName(options.TemplateWorkspace, options.PRNumber))
+	if err != nil {
+		return nil, err
+	}
+
+	run, err := s.client.Runs.Create(ctx, RunCreateOptions{
+		Workspace: ws,
+		IsDestroy: Bool(true),
+		AutoApply: Bool(true),
+		Message:   String(fmt.Sprintf("Destroy preview of pull request #%d", options.PRNumber)),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &Preview{Workspace: ws, Run: run}, nil
+}
+
+// workspaceCreateOptions returns the options to create the preview workspace
+// from the template workspace.
+func (o PreviewOptions) workspaceCreateOptions(template *Workspace) WorkspaceCreateOptions {
+	create := WorkspaceCreateOptions{
+		Name:             String(PreviewWorkspaceName(o.TemplateWorkspace, o.PRNumber)),
+		Description:      String(fmt.Sprintf("Preview of pull request #%d, created from %s.", o.PRNumber, template.Name)),
+		AutoApply:        Bool(true),
+		WorkingDirectory: String(template.WorkingDirectory),
+		Project:          o.Project,
+	}
+	if create.Project == nil {
+		create.Project = template.Project
+	}
+	if template.TerraformVersion != "" {
+		create.TerraformVersion = String(template.TerraformVersion)
+	}
+
+	// Only copy the execution mode if the template workspace does not
+	// inherit it, so the preview workspace inherits it as well.
+	if template.SettingOverwrites != nil && template.SettingOverwrites.ExecutionMode != nil && *template.SettingOverwrites.ExecutionMode {
+		create.ExecutionMode = String(template.ExecutionMode)
+		if template.AgentPool != nil {
+			create.AgentPoolID = String(template.AgentPool.ID)
+		}
+	}
+
+	if vcs := template.VCSRepo; vcs != nil {
+		create.VCSRepo = &VCSRepoOptions{
+			Branch:            String(vcs.Branch),
+			Identifier:        String(vcs.Identifier),
+			IngressSubmodules: Bool(vcs.IngressSubmodules),
+		}
+		if o.Branch != "" {
+			create.VCSRepo.Branch = String(o.Branch)
+		}
+		if vcs.OAuthTokenID != "" {
+			create.VCSRepo.OAuthTokenID = String(vcs.OAuthTokenID)
+		}
+		if vcs.GHAInstallationID != "" {
+			create.VCSRepo.GHAInstallationID = String(vcs.GHAInstallationID)
+		}
+	}
+
+	if o.TTL > 0 {
+		create.AutoDestroyAt = NullableTime(time.Now().Add(o.TTL))
+	}
+
+	return create
+}
+
+func (o PreviewOptions) valid() error {
+	if !validStringID(&o.Organization) {
+		return ErrInvalidOrg
+	}
+	if !validString(&o.TemplateWorkspace) {
+		return ErrRequiredTemplateWorkspace
+	}
+	if !validStringID(&o.TemplateWorkspace) {
+		return ErrInvalidWorkspaceValue
+	}
+	if o.PRNumber <= 0 {
+		return ErrInvalidPRNumber
+	}
+	if o.TTL < 0 {
+		return ErrInvalidPreviewTTL
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPreviewsCreateForPR(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/acme/workspaces/app": `{"data":{"id":"ws-1","type":"workspaces","attributes":{
+			"name":"app","terraform-version":"1.9.0","vcs-repo":{"identifier":"acme/app","branch":"main","oauth-token-id":"ot-1"}},
+			"relationships":{"project":{"data":{"id":"prj-1","type":"projects"}}}}}`,
+		"POST /api/v2/organizations/acme/workspaces": `{"data":{"id":"ws-2","type":"workspaces","attributes":{"name":"app-pr-7"}}}`,
+		"GET /api/v2/workspaces/ws-1/vars": `{"data":[
+			{"id":"var-1","type":"vars","attributes":{"key":"region","value":"eu-west-1","category":"terraform"}},
+			{"id":"var-2","type":"vars","attributes":{"key":"token","value":"","category":"env","sensitive":true}}
+		]}`,
+		"POST /api/v2/workspaces/ws-2/vars":                  `{"data":{"id":"var-3","type":"vars","attributes":{"key":"region","value":"eu-west-1","category":"terraform"}}}`,
+		"GET /api/v2/workspaces/ws-2/configuration-versions": `{"data":[{"id":"cv-1","type":"configuration-versions","attributes":{"status":"uploaded"}}]}`,
+		"POST /api/v2/runs":                                  `{"data":{"id":"run-1","type":"runs","attributes":{"status":"pending"}}}`,
+		"GET /api/v2/organizations/acme/workspaces/app-pr-7": `{"data":{"id":"ws-2","type":"workspaces","attributes":{"name":"app-pr-7"}}}`,
+		"GET /api/v2/organizations/acme/workspaces/cli":      `{"data":{"id":"ws-3","type":"workspaces","attributes":{"name":"cli"}}}`,
+	})
+	defer done()
+	client.polling = &Backoff{Min: time.Millisecond}
+
+	ctx := context.Background()
+	options := PreviewOptions{
+		Organization:      "acme",
+		TemplateWorkspace: "app",
+		PRNumber:          7,
+		TTL:               24 * time.Hour,
+	}
+
+	t.Run("create", func(t *testing.T) {
+		preview, err := client.Previews.CreateForPR(ctx, options)
+		require.NoError(t, err)
+		assert.Equal(t, "ws-2", preview.Workspace.ID)
+		require.Len(t, preview.Variables, 1)
+		assert.Equal(t, "var-3", preview.Variables[0].ID)
+		assert.Equal(t, []string{"token"}, preview.SkippedVariables)
+		require.NotNil(t, preview.Run)
+		assert.Equal(t, "run-1", preview.Run.ID)
+	})
+
+	t.Run("destroy", func(t *testing.T) {
+		preview, err := client.Previews.DestroyForPR(ctx, options)
+		require.NoError(t, err)
+		assert.Equal(t, "ws-2", preview.Workspace.ID)
+		assert.Equal(t, "run-1", preview.Run.ID)
+	})
+
+	t.Run("with a template workspace without a VCS repository", func(t *testing.T) {
+		noVCS := options
+		noVCS.TemplateWorkspace = "cli"
+		preview, err := client.Previews.CreateForPR(ctx, noVCS)
+		assert.Nil(t, preview)
+		assert.Equal(t, ErrPreviewTemplateWithoutVCSRepo, err)
+	})
+
+	t.Run("without a template workspace", func(t *testing.T) {
+		preview, err := client.Previews.CreateForPR(ctx, PreviewOptions{Organization: "acme", PRNumber: 7})
+		assert.Nil(t, preview)
+		assert.Equal(t, ErrRequiredTemplateWorkspace, err)
+	})
+
+	t.Run("with an invalid pull request number", func(t *testing.T) {
+		preview, err := client.Previews.CreateForPR(ctx, PreviewOptions{Organization: "acme", TemplateWorkspace: "app"})
+		assert.Nil(t, preview)
+		assert.Equal(t, ErrInvalidPRNumber, err)
+	})
+
+	t.Run("with a negative TTL", func(t *testing.T) {
+		invalid := options
+		invalid.TTL = -time.Hour
+		preview, err := client.Previews.CreateForPR(ctx, invalid)
+		assert.Nil(t, preview)
+		assert.Equal(t, ErrInvalidPreviewTTL, err)
+	})
+}
+
+func TestPreviewsCreateForPRAwaitsConfiguration(t *testing.T) {
+	t.Parallel()
+
+	// The fake API ingresses the configuration of the preview workspace
+	// after a few reads of its configuration versions.
+	var mu sync.Mutex
+	var reads int
+	cvStatus := "uploaded"
+	var runBody string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		mu.Lock()
+		defer mu.Unlock()
+
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/organizations/acme/workspaces/app":
+			_, _ = io.WriteString(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app","vcs-repo":{"identifier":"acme/app","branch":"main"}}}}`)
+		case "POST /api/v2/organizations/acme/workspaces":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"id":"ws-2","type":"workspaces","attributes":{"name":"app-pr-7"}}}`)
+		case "GET /api/v2/workspaces/ws-1/vars":
+			_, _ = io.WriteString(w, `{"data":[]}`)
+		case "GET /api/v2/workspaces/ws-2/configuration-versions":
+			reads++
+			switch reads {
+			case 1:
+				_, _ = io.WriteString(w, `{"data":[]}`)
+			case 2:
+				_, _ = io.WriteString(w, `{"data":[{"id":"cv-1","type":"configuration-versions","attributes":{"status":"fetching"}}]}`)
+			default:
+				_, _ = io.WriteString(w, `{"data":[{"id":"cv-1","type":"configuration-versions","attributes":{"status":"`+cvStatus+`","error-message":"repository not found"}}]}`)
+			}
+		case "POST /api/v2/runs":
+			body, _ := io.ReadAll(r.Body)
+			runBody = string(body)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"id":"run-1","type":"runs","attributes":{"status":"pending"}}}`)
+		case "DELETE /api/v2/workspaces/ws-2":
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{
+		Address:        srv.URL,
+		Token:          "insert-your-token-here",
+		HTTPClient:     srv.Client(),
+		PollingBackoff: &Backoff{Min: time.Millisecond},
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	options := PreviewOptions{Organization: "acme", TemplateWorkspace: "app", PRNumber: 7}
+
+	preview, err := client.Previews.CreateForPR(ctx, options)
+	require.NoError(t, err)
+	require.NotNil(t, preview.Run)
+	assert.Equal(t, "run-1", preview.Run.ID)
+	assert.Equal(t, 3, reads)
+	assert.Contains(t, runBody, `"configuration-version":{"data":{"type":"configuration-versions","id":"cv-1"}}`)
+
+	t.Run("with a configuration failing to be ingested", func(t *testing.T) {
+		mu.Lock()
+		reads = 0
+		cvStatus = "errored"
+		mu.Unlock()
+
+		preview, err := client.Previews.CreateForPR(ctx, options)
+		assert.Nil(t, preview)
+		assert.ErrorIs(t, err, ErrPreviewConfigurationErrored)
+		assert.ErrorContains(t, err, "repository not found")
+	})
+}
+
+func TestPreviewOptionsWorkspaceCreateOptions(t *testing.T) {
+	t.Parallel()
+
+	template := &Workspace{
+		Name:              "app",
+		ExecutionMode:     "agent",
+		TerraformVersion:  "1.9.0",
+		WorkingDirectory:  "infra",
+		Project:           &Project{ID: "prj-1"},
+		AgentPool:         &AgentPool{ID: "apool-1"},
+		SettingOverwrites: &WorkspaceSettingOverwrites{ExecutionMode: Bool(true)},
+		VCSRepo:           &VCSRepo{Identifier: "acme/app", Branch: "main", OAuthTokenID: "ot-1"},
+	}
+
+	options := PreviewOptions{
+		Organization:      "acme",
+		TemplateWorkspace: "app",
+		PRNumber:          7,
+		Branch:            "feature",
+		TTL:               time.Hour,
+	}
+
+	before := time.Now()
+	create := options.workspaceCreateOptions(template)
+
+	assert.Equal(t, "app-pr-7", *create.Name)
+	assert.True(t, *create.AutoApply)
+	assert.Equal(t, "1.9.0", *create.TerraformVersion)
+	assert.Equal(t, "infra", *create.WorkingDirectory)
+	assert.Equal(t, "prj-1", create.Project.ID)
+	assert.Equal(t, "agent", *create.ExecutionMode)
+	assert.Equal(t, "apool-1", *create.AgentPoolID)
+	assert.Equal(t, "acme/app", *create.VCSRepo.Identifier)
+	assert.Equal(t, "feature", *create.VCSRepo.Branch)
+	assert.Equal(t, "ot-1", *create.VCSRepo.OAuthTokenID)
+
+	destroyAt, err := create.AutoDestroyAt.Get()
+	require.NoError(t, err)
+	assert.WithinDuration(t, before.Add(time.Hour), destroyAt, time.Minute)
+
+	t.Run("with an inherited execution mode", func(t *testing.T) {
+		inherited := *template
+		inherited.SettingOverwrites = &WorkspaceSettingOverwrites{ExecutionMode: Bool(false)}
+
+		create := options.workspaceCreateOptions(&inherited)
+		assert.Nil(t, create.ExecutionMode)
+		assert.Nil(t, create.AgentPoolID)
+	})
+}
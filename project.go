@@ -28,6 +28,12 @@ type Projects interface {
 	// Read a project by its ID.
 	Read(ctx context.Context, projectID string) (*Project, error)
 
+	// ReadWithOptions reads a project by its ID using the options supplied.
+	ReadWithOptions(ctx context.Context, projectID string, options *ProjectReadOptions) (*Project, error)
+
+	// ReadByName reads a project of an organization by its name.
+	ReadByName(ctx context.Context, organization string, name string) (*Project, error)
+
 	// Update a project.
 	Update(ctx context.Context, projectID string, options ProjectUpdateOptions) (*Project, error)
 
@@ -40,13 +46,20 @@ type Projects interface {
 	// ListEffectiveTagBindings lists all tag bindings associated with the project. In practice,
 	// this should be the same as ListTagBindings since projects do not currently inherit
 	// tag bindings.
-	ListEffectiveTagBindings(ctx context.Context, workspaceID string) ([]*EffectiveTagBinding, error)
+	ListEffectiveTagBindings(ctx context.Context, projectID string) ([]*EffectiveTagBinding, error)
 
 	// AddTagBindings adds or modifies the value of existing tag binding keys for a project.
 	AddTagBindings(ctx context.Context, projectID string, options ProjectAddTagBindingsOptions) ([]*TagBinding, error)
 
+	// RemoveTagBindings removes the tag bindings with the given keys from a project.
+	RemoveTagBindings(ctx context.Context, projectID string, keys []string) error
+
 	// DeleteAllTagBindings removes all existing tag bindings for a project.
 	DeleteAllTagBindings(ctx context.Context, projectID string) error
+
+	// MoveWorkspaces moves workspaces into a project and returns the outcome
+	// for every workspace.
+	MoveWorkspaces(ctx context.Context, projectID string, workspaces []*Workspace) ([]*ProjectMoveWorkspaceResult, error)
 }
 
 // projects implements Projects
@@ -70,24 +83,59 @@ type Project struct {
 
 	AutoDestroyActivityDuration jsonapi.NullableAttr[string] `jsonapi:"attr,auto-destroy-activity-duration,omitempty"`
 
+	Permissions *ProjectPermissions `jsonapi:"attr,permissions"`
+
 	// Relations
 	Organization         *Organization          `jsonapi:"relation,organization"`
 	EffectiveTagBindings []*EffectiveTagBinding `jsonapi:"relation,effective-tag-bindings"`
 }
 
+// ProjectPermissions represents the project permissions of the API consumer.
+type ProjectPermissions struct {
+	CanRead                bool `jsonapi:"attr,can-read"`
+	CanUpdate              bool `jsonapi:"attr,can-update"`
+	CanDestroy             bool `jsonapi:"attr,can-destroy"`
+	CanCreateWorkspace     bool `jsonapi:"attr,can-create-workspace"`
+	CanMoveWorkspace       bool `jsonapi:"attr,can-move-workspace"`
+	CanDeployNoCodeModules bool `jsonapi:"attr,can-deploy-no-code-modules"`
+	CanReadTeams           bool `jsonapi:"attr,can-read-teams"`
+	CanManageTags          bool `jsonapi:"attr,can-manage-tags"`
+	CanManageTeams         bool `jsonapi:"attr,can-manage-teams"`
+	CanManageVarsets       bool `jsonapi:"attr,can-manage-varsets"`
+	CanManageInHCP         bool `jsonapi:"attr,can-manage-in-hcp"`
+
+	CanManageEphemeralWorkspaces bool `jsonapi:"attr,can-manage-ephemeral-workspace-for-projects"`
+}
+
+// ProjectIncludeOpt represents the available options for include query params.
 type ProjectIncludeOpt string
 
 const (
 	ProjectEffectiveTagBindings ProjectIncludeOpt = "effective_tag_bindings"
 )
 
+// ProjectReadOptions represents the options for reading a project.
+type ProjectReadOptions struct {
+	// Optional: A list of relations to include
+	Include []ProjectIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
+}
+
 // ProjectListOptions represents the options for listing projects
 type ProjectListOptions struct {
 	ListOptions
 
+	// Optional: A list of complete project names used to filter the results.
+	// Projects matching any of the names are returned.
+	Names []string `url:"filter[names],omitempty"`
+
 	// Optional: String (complete project name) used to filter the results.
 	// If multiple, comma separated values are specified, projects matching
 	// any of the names are returned.
+	//
+	// Deprecated: Use Names instead.
 	Name string `url:"filter[names],omitempty"`
 
 	// Optional: A query string to search projects by names.
@@ -99,6 +147,9 @@ type ProjectListOptions struct {
 
 	// Optional: A list of relations to include
 	Include []ProjectIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // ProjectCreateOptions represents the options for creating a project
@@ -154,6 +205,19 @@ type ProjectAddTagBindingsOptions struct {
 	TagBindings []*TagBinding
 }
 
+// ProjectMoveWorkspaceResult represents the outcome of moving a single
+// workspace into a project with MoveWorkspaces.
+type ProjectMoveWorkspaceResult struct {
+	Workspace *Workspace
+
+	// Err is the error returned while moving the workspace, if any.
+	Err error
+}
+
+// projectMoveWorkspacesBatchSize is the number of workspaces moved into a
+// project with a single request.
+const projectMoveWorkspacesBatchSize = 100
+
 // List all projects.
 func (s *projects) List(ctx context.Context, organization string, options *ProjectListOptions) (*ProjectList, error) {
 	if !validStringID(&organization) {
@@ -207,12 +271,61 @@ func (s *projects) Create(ctx context.Context, organization string, options Proj
 
 // Read a single project by its ID.
 func (s *projects) Read(ctx context.Context, projectID string) (*Project, error) {
+	return s.ReadWithOptions(ctx, projectID, nil)
+}
+
+// ReadByName reads a project of an organization by its complete name, which
+// is matched exactly. It returns ErrResourceNotFound when no project has the
+// name, and ErrAmbiguousProjectName when more than one project has it.
+func (s *projects) ReadByName(ctx context.Context, organization, name string) (*Project, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if !validString(&name) {
+		return nil, ErrRequiredName
+	}
+
+	options := &ProjectListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+		Names:       []string{name},
+	}
+
+	var found []*Project
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		pl, err := s.List(ctx, organization, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pl.Items {
+			if p.Name == name {
+				found = append(found, p)
+			}
+		}
+		return pl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	switch len(found) {
+	case 0:
+		return nil, ErrResourceNotFound
+	case 1:
+		return found[0], nil
+	default:
+		return nil, ErrAmbiguousProjectName
+	}
+}
+
+// ReadWithOptions reads a single project by its ID using the options
+// supplied, e.g. to include its effective tag bindings.
+func (s *projects) ReadWithOptions(ctx context.Context, projectID string, options *ProjectReadOptions) (*Project, error) {
 	if !validStringID(&projectID) {
 		return nil, ErrInvalidProjectID
 	}
 
 	u := fmt.Sprintf("projects/%s", url.PathEscape(projectID))
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequest("GET", u, options)
 	if err != nil {
 		return nil, err
 	}
@@ -339,19 +452,60 @@ func (s *projects) Delete(ctx context.Context, projectID string) error {
 	return req.Do(ctx, nil)
 }
 
+// RemoveTagBindings removes the tag bindings with the given keys from a
+// project. The API only supports replacing the full set of tag bindings, so
+// the current bindings are read first and the remaining ones are written back.
+func (s *projects) RemoveTagBindings(ctx context.Context, projectID string, keys []string) error {
+	if !validStringID(&projectID) {
+		return ErrInvalidProjectID
+	}
+	if len(keys) == 0 {
+		return ErrRequiredTagBindingKeys
+	}
+
+	current, err := s.ListTagBindings(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	remove := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		remove[k] = true
+	}
+
+	remaining := make([]*TagBinding, 0, len(current))
+	for _, tb := range current {
+		if !remove[tb.Key] {
+			remaining = append(remaining, &TagBinding{Key: tb.Key, Value: tb.Value})
+		}
+	}
+
+	// Nothing to do when none of the keys are bound to the project.
+	if len(remaining) == len(current) {
+		return nil
+	}
+
+	return s.replaceTagBindings(ctx, projectID, remaining)
+}
+
 // Delete all tag bindings associated with a project.
 func (s *projects) DeleteAllTagBindings(ctx context.Context, projectID string) error {
 	if !validStringID(&projectID) {
 		return ErrInvalidProjectID
 	}
 
+	return s.replaceTagBindings(ctx, projectID, []*TagBinding{})
+}
+
+// replaceTagBindings replaces the full set of tag bindings of a project.
+func (s *projects) replaceTagBindings(ctx context.Context, projectID string, bindings []*TagBinding) error {
 	type aliasOpts struct {
 		Type        string        `jsonapi:"primary,projects"`
 		TagBindings []*TagBinding `jsonapi:"relation,tag-bindings"`
 	}
 
 	opts := &aliasOpts{
-		TagBindings: []*TagBinding{},
+		TagBindings: bindings,
 	}
 
 	u := fmt.Sprintf("projects/%s", url.PathEscape(projectID))
@@ -363,15 +517,68 @@ func (s *projects) DeleteAllTagBindings(ctx context.Context, projectID string) e
 	return req.Do(ctx, nil)
 }
 
+// MoveWorkspaces moves the workspaces into a project in batches of up to 100
+// workspaces per request. The API rejects a batch as a whole, so when a batch
+// fails its workspaces are moved one by one to report the error of each
+// workspace. Results are returned in the same order as workspaces.
+func (s *projects) MoveWorkspaces(ctx context.Context, projectID string, workspaces []*Workspace) ([]*ProjectMoveWorkspaceResult, error) {
+	if !validStringID(&projectID) {
+		return nil, ErrInvalidProjectID
+	}
+	if len(workspaces) == 0 {
+		return nil, ErrWorkspacesRequired
+	}
+	for _, ws := range workspaces {
+		if ws == nil || !validStringID(&ws.ID) {
+			return nil, ErrRequiredWorkspaceID
+		}
+	}
+
+	results := make([]*ProjectMoveWorkspaceResult, len(workspaces))
+	for start := 0; start < len(workspaces); start += projectMoveWorkspacesBatchSize {
+		end := start + projectMoveWorkspacesBatchSize
+		if end > len(workspaces) {
+			end = len(workspaces)
+		}
+		batch := workspaces[start:end]
+
+		err := s.moveWorkspaces(ctx, projectID, batch)
+		if err != nil && ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		for i, ws := range batch {
+			result := &ProjectMoveWorkspaceResult{Workspace: ws}
+			if err != nil && len(batch) > 1 {
+				result.Err = s.moveWorkspaces(ctx, projectID, []*Workspace{ws})
+			} else {
+				result.Err = err
+			}
+			results[start+i] = result
+		}
+	}
+
+	return results, nil
+}
+
+func (s *projects) moveWorkspaces(ctx context.Context, projectID string, workspaces []*Workspace) error {
+	u := fmt.Sprintf("projects/%s/relationships/workspaces", url.PathEscape(projectID))
+	req, err := s.client.NewRequest("POST", u, workspaces)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
+
 func (o ProjectCreateOptions) valid() error {
 	if !validString(&o.Name) {
 		return ErrRequiredName
 	}
-	return nil
+	return validTagBindings(o.TagBindings)
 }
 
 func (o ProjectUpdateOptions) valid() error {
-	return nil
+	return validTagBindings(o.TagBindings)
 }
 
 func (o ProjectAddTagBindingsOptions) valid() error {
@@ -379,5 +586,5 @@ func (o ProjectAddTagBindingsOptions) valid() error {
 		return ErrRequiredTagBindings
 	}
 
-	return nil
+	return validTagBindings(o.TagBindings)
 }
@@ -4,7 +4,11 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -159,6 +163,36 @@ func TestProjectsRead(t *testing.T) {
 		assert.Equal(t, orgTest.Name, w.Organization.Name)
 	})
 
+	t.Run("permissions are properly decoded", func(t *testing.T) {
+		w, err := client.Projects.Read(ctx, pTest.ID)
+		require.NoError(t, err)
+		require.NotNil(t, w.Permissions)
+		assert.True(t, w.Permissions.CanRead)
+		assert.True(t, w.Permissions.CanUpdate)
+		assert.True(t, w.Permissions.CanDestroy)
+		assert.True(t, w.Permissions.CanCreateWorkspace)
+	})
+
+	t.Run("with effective tag bindings included", func(t *testing.T) {
+		skipUnlessBeta(t)
+
+		pTagged, pTaggedCleanup := createProjectWithOptions(t, client, orgTest, ProjectCreateOptions{
+			Name: randomStringWithoutSpecialChar(t),
+			TagBindings: []*TagBinding{
+				{Key: "key1", Value: "value1"},
+			},
+		})
+		t.Cleanup(pTaggedCleanup)
+
+		p, err := client.Projects.ReadWithOptions(ctx, pTagged.ID, &ProjectReadOptions{
+			Include: []ProjectIncludeOpt{ProjectEffectiveTagBindings},
+		})
+		require.NoError(t, err)
+		require.Len(t, p.EffectiveTagBindings, 1)
+		assert.Equal(t, "key1", p.EffectiveTagBindings[0].Key)
+		assert.Equal(t, "value1", p.EffectiveTagBindings[0].Value)
+	})
+
 	t.Run("when the project does not exist", func(t *testing.T) {
 		w, err := client.Projects.Read(ctx, "nonexisting")
 		assert.Nil(t, w)
@@ -434,6 +468,100 @@ func TestProjects_DeleteAllTagBindings(t *testing.T) {
 	require.Empty(t, bindings)
 }
 
+func TestProjects_RemoveTagBindings(t *testing.T) {
+	skipUnlessBeta(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	pTest, pCleanup := createProject(t, client, nil)
+	t.Cleanup(pCleanup)
+
+	_, err := client.Projects.AddTagBindings(ctx, pTest.ID, ProjectAddTagBindingsOptions{
+		TagBindings: []*TagBinding{
+			{Key: "foo", Value: "bar"},
+			{Key: "baz", Value: "qux"},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("when removing a subset of keys", func(t *testing.T) {
+		err := client.Projects.RemoveTagBindings(ctx, pTest.ID, []string{"foo"})
+		require.NoError(t, err)
+
+		bindings, err := client.Projects.ListTagBindings(ctx, pTest.ID)
+		require.NoError(t, err)
+		require.Len(t, bindings, 1)
+		assert.Equal(t, "baz", bindings[0].Key)
+		assert.Equal(t, "qux", bindings[0].Value)
+	})
+
+	t.Run("without any keys", func(t *testing.T) {
+		err := client.Projects.RemoveTagBindings(ctx, pTest.ID, nil)
+		assert.Equal(t, ErrRequiredTagBindingKeys, err)
+	})
+
+	t.Run("without a valid project ID", func(t *testing.T) {
+		err := client.Projects.RemoveTagBindings(ctx, badIdentifier, []string{"foo"})
+		assert.EqualError(t, err, ErrInvalidProjectID.Error())
+	})
+}
+
+func TestProjectsMoveWorkspaces(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	pTest, pCleanup := createProject(t, client, orgTest)
+	t.Cleanup(pCleanup)
+
+	wTest1, wTest1Cleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTest1Cleanup)
+	wTest2, wTest2Cleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTest2Cleanup)
+
+	t.Run("with valid workspaces", func(t *testing.T) {
+		results, err := client.Projects.MoveWorkspaces(ctx, pTest.ID, []*Workspace{wTest1, wTest2})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for _, r := range results {
+			assert.NoError(t, r.Err)
+
+			w, err := client.Workspaces.ReadByID(ctx, r.Workspace.ID)
+			require.NoError(t, err)
+			assert.Equal(t, pTest.ID, w.Project.ID)
+		}
+	})
+
+	t.Run("with a workspace that does not exist", func(t *testing.T) {
+		results, err := client.Projects.MoveWorkspaces(ctx, pTest.ID, []*Workspace{wTest1, {ID: "ws-doesnotexist"}})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.NoError(t, results[0].Err)
+		assert.Error(t, results[1].Err)
+	})
+
+	t.Run("without workspaces", func(t *testing.T) {
+		results, err := client.Projects.MoveWorkspaces(ctx, pTest.ID, nil)
+		assert.Nil(t, results)
+		assert.Equal(t, ErrWorkspacesRequired, err)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		results, err := client.Projects.MoveWorkspaces(ctx, pTest.ID, []*Workspace{{ID: badIdentifier}})
+		assert.Nil(t, results)
+		assert.Equal(t, ErrRequiredWorkspaceID, err)
+	})
+
+	t.Run("without a valid project ID", func(t *testing.T) {
+		results, err := client.Projects.MoveWorkspaces(ctx, badIdentifier, []*Workspace{wTest1})
+		assert.Nil(t, results)
+		assert.EqualError(t, err, ErrInvalidProjectID.Error())
+	})
+}
+
 func TestProjectsDelete(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -491,3 +619,78 @@ func TestProjectsAutoDestroy(t *testing.T) {
 		assert.Equal(t, p.AutoDestroyActivityDuration, w.AutoDestroyActivityDuration)
 	})
 }
+
+func TestProject_Unmarshal(t *testing.T) {
+	data := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "projects",
+			"id":   "prj-1234",
+			"attributes": map[string]interface{}{
+				"name": "my-project",
+				"permissions": map[string]interface{}{
+					"can-read":             true,
+					"can-update":           true,
+					"can-destroy":          false,
+					"can-create-workspace": true,
+					"can-manage-ephemeral-workspace-for-projects": true,
+				},
+			},
+		},
+	}
+
+	byteData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	p := &Project{}
+	err = unmarshalResponse(bytes.NewReader(byteData), p)
+	require.NoError(t, err)
+
+	assert.Equal(t, "prj-1234", p.ID)
+	require.NotNil(t, p.Permissions)
+	assert.True(t, p.Permissions.CanRead)
+	assert.True(t, p.Permissions.CanUpdate)
+	assert.False(t, p.Permissions.CanDestroy)
+	assert.True(t, p.Permissions.CanCreateWorkspace)
+	assert.True(t, p.Permissions.CanManageEphemeralWorkspaces)
+	assert.False(t, p.Permissions.CanManageInHCP)
+}
+
+func TestProjectsReadByName(t *testing.T) {
+	t.Parallel()
+
+	list := func(projects ...string) string {
+		return fmt.Sprintf(`{"data":[%s],"meta":{"pagination":{"current-page":1,"next-page":null,"total-pages":1,"total-count":%d}}}`,
+			strings.Join(projects, ","), len(projects))
+	}
+	project := func(id, name string) string {
+		return fmt.Sprintf(`{"id":%q,"type":"projects","attributes":{"name":%q}}`, id, name)
+	}
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/acme/projects":  list(project("prj-1", "Platform"), project("prj-2", "platform")),
+		"GET /api/v2/organizations/twins/projects": list(project("prj-3", "Platform"), project("prj-4", "Platform")),
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("with a matching name", func(t *testing.T) {
+		p, err := client.Projects.ReadByName(ctx, "acme", "Platform")
+		require.NoError(t, err)
+		assert.Equal(t, "prj-1", p.ID)
+	})
+
+	t.Run("without a matching name", func(t *testing.T) {
+		_, err := client.Projects.ReadByName(ctx, "acme", "Data")
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("with an ambiguous name", func(t *testing.T) {
+		_, err := client.Projects.ReadByName(ctx, "twins", "Platform")
+		assert.ErrorIs(t, err, ErrAmbiguousProjectName)
+	})
+
+	t.Run("without a name", func(t *testing.T) {
+		_, err := client.Projects.ReadByName(ctx, "acme", "")
+		assert.Equal(t, ErrRequiredName, err)
+	})
+}
@@ -58,6 +58,13 @@ type RegistryModules interface {
 	// Delete a specified version for the given provider of the module
 	DeleteVersion(ctx context.Context, moduleID RegistryModuleID, version string) error
 
+	// DeprecateVersion marks a version of the module as deprecated, with an
+	// optional reason and link shown to its consumers.
+	DeprecateVersion(ctx context.Context, moduleID RegistryModuleID, version string, options RegistryModuleVersionDeprecateOptions) (*RegistryModuleVersion, error)
+
+	// RevertDeprecation removes the deprecation of a version of the module.
+	RevertDeprecation(ctx context.Context, moduleID RegistryModuleID, version string) (*RegistryModuleVersion, error)
+
 	// Update properties of a registry module
 	Update(ctx context.Context, moduleID RegistryModuleID, options RegistryModuleUpdateOptions) (*RegistryModule, error)
 
@@ -68,6 +75,20 @@ type RegistryModules interface {
 
 	// Upload a tar gzip archive to the specified configuration version upload URL.
 	UploadTarGzip(ctx context.Context, url string, r io.Reader) error
+
+	// UploadTarGzipWithOptions uploads a tar gzip archive like UploadTarGzip,
+	// attempting the upload again when it fails with a transient error and
+	// verifying the archive against a checksum first.
+	UploadTarGzipWithOptions(ctx context.Context, url string, r io.ReadSeeker, options UploadOptions) error
+
+	// PublishLocalVersion creates a registry module version, uploads the
+	// configuration files found in sourceDir and waits until the version has
+	// been ingested by the registry.
+	PublishLocalVersion(ctx context.Context, moduleID RegistryModuleID, version string, sourceDir string) (*RegistryModuleVersion, error)
+
+	// QueueReleaseRuns queues a run in every workspace that consumes the given
+	// registry module, referencing the newly published version in the run message.
+	QueueReleaseRuns(ctx context.Context, moduleID RegistryModuleID, options RegistryModuleReleaseRunsOptions) ([]*RegistryModuleReleaseRun, error)
 }
 
 // registryModules implements RegistryModules.
@@ -182,6 +203,10 @@ type RegistryModuleVersion struct {
 	CreatedAt string                      `jsonapi:"attr,created-at"`
 	UpdatedAt string                      `jsonapi:"attr,updated-at"`
 
+	// The deprecation of the version, or nil if the version is not
+	// deprecated.
+	Deprecation *RegistryModuleVersionDeprecation `jsonapi:"attr,deprecation"`
+
 	// Relations
 	RegistryModule *RegistryModule `jsonapi:"relation,registry-module"`
 
@@ -189,6 +214,87 @@ type RegistryModuleVersion struct {
 	Links map[string]interface{} `jsonapi:"links,omitempty"`
 }
 
+// RegistryModuleVersionDeprecation represents the deprecation of a registry
+// module version.
+type RegistryModuleVersionDeprecation struct {
+	Reason string `jsonapi:"attr,reason"`
+	Link   string `jsonapi:"attr,link"`
+}
+
+// RegistryModuleVersionDeprecateOptions represents the options for
+// deprecating a registry module version.
+type RegistryModuleVersionDeprecateOptions struct {
+	// Optional: Why the version is deprecated.
+	Reason *string
+
+	// Optional: A link to more information, such as the version to upgrade to.
+	Link *string
+}
+
+// registryModuleVersionDeprecationOptions sets or, when Deprecation is nil,
+// removes the deprecation of a registry module version.
+type registryModuleVersionDeprecationOptions struct {
+	Type        string                                `jsonapi:"primary,registry-module-versions"`
+	Deprecation *registryModuleVersionDeprecationAttr `jsonapi:"attr,deprecation"`
+}
+
+type registryModuleVersionDeprecationAttr struct {
+	Reason *string `json:"reason,omitempty"`
+	Link   *string `json:"link,omitempty"`
+}
+
+// RegistryModuleReleaseRunsOptions represents the options for queuing runs in
+// the workspaces consuming a registry module after a new version is published.
+type RegistryModuleReleaseRunsOptions struct {
+	// Required: The newly published version of the module.
+	Version string
+
+	// Optional: The workspaces to queue runs in. When empty, the consuming
+	// workspaces are discovered using the organization's explorer data.
+	Workspaces []*Workspace
+
+	// Optional: The message of the queued runs. Defaults to a message
+	// referencing the module and the published version.
+	Message *string
+
+	// Optional: When true, the consuming workspaces are resolved but no runs
+	// are queued.
+	DryRun bool
+
+	// Optional: The maximum number of runs to queue concurrently. Defaults to 1.
+	Concurrency int
+}
+
+// RegistryModuleReleaseRun represents the outcome of queuing a run in a single
+// workspace consuming a registry module.
+type RegistryModuleReleaseRun struct {
+	Workspace *Workspace
+
+	// Run is the queued run. It is nil for dry runs or when Err is set.
+	Run *Run
+
+	// Err is the error returned while reading the workspace or queuing the
+	// run, if any. Workspaces discovered using the explorer data only have
+	// their name set when they could not be read.
+	Err error
+}
+
+// explorerModuleView represents a single row of the organization explorer
+// modules view, listing the workspaces pinned to a module source and version.
+type explorerModuleView struct {
+	ID             string `jsonapi:"primary,visibility-module"`
+	Name           string `jsonapi:"attr,name"`
+	Source         string `jsonapi:"attr,source"`
+	Version        string `jsonapi:"attr,version"`
+	WorkspaceCount int    `jsonapi:"attr,workspace-count"`
+	Workspaces     string `jsonapi:"attr,workspaces"`
+}
+
+type explorerModuleViewList struct {
+	*Pagination
+	Items []*explorerModuleView
+}
+
 type RegistryModulePermissions struct {
 	CanDelete bool `jsonapi:"attr,can-delete"`
 	CanResync bool `jsonapi:"attr,can-resync"`
@@ -395,6 +501,60 @@ func (r *registryModules) UploadTarGzip(ctx context.Context, uploadURL string, a
 	return r.client.doForeignPUTRequest(ctx, uploadURL, archive)
 }
 
+// UploadTarGzipWithOptions uploads a tar gzip archive like UploadTarGzip. The
+// archive is read from its start for each attempt, so it is not held in
+// memory, which makes this method suited to large archives read from a file.
+func (r *registryModules) UploadTarGzipWithOptions(ctx context.Context, uploadURL string, archive io.ReadSeeker, options UploadOptions) error {
+	return r.client.doForeignPUTRequestWithOptions(ctx, uploadURL, archive, options)
+}
+
+// PublishLocalVersion creates a new version of a registry module, packs and
+// uploads the configuration files in sourceDir using go-slug, and polls the
+// version until it reaches a terminal status. It returns the final version, or
+// an error wrapping ErrRegistryModuleVersionFailed if ingestion failed.
+func (r *registryModules) PublishLocalVersion(ctx context.Context, moduleID RegistryModuleID, version, sourceDir string) (*RegistryModuleVersion, error) {
+	rmv, err := r.CreateVersion(ctx, moduleID, RegistryModuleCreateVersionOptions{
+		Version: String(version),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := r.Upload(ctx, *rmv, sourceDir); err != nil {
+		return nil, err
+	}
+
+	failed := map[RegistryModuleVersionStatus]bool{
+		RegistryModuleVersionStatusCloneFailed:         true,
+		RegistryModuleVersionStatusRegIngressReqFailed: true,
+		RegistryModuleVersionStatusRegIngressFailed:    true,
+	}
+	quitStatus := []string{string(RegistryModuleVersionStatusOk)}
+	for status := range failed {
+		quitStatus = append(quitStatus, string(status))
+	}
+
+	var final WaitForStatusResult
+	for result := range awaitPoll(ctx, rmv.ID, r.client.pollingBackoff(pollBackoff), func(ctx context.Context) (string, error) {
+		rmv, err = r.ReadVersion(ctx, moduleID, version)
+		if err != nil {
+			return "", err
+		}
+		return string(rmv.Status), nil
+	}, quitStatus) {
+		final = result
+	}
+
+	if final.Error != nil {
+		return nil, final.Error
+	}
+	if failed[rmv.Status] {
+		return rmv, fmt.Errorf("%w: version %s has status %q", ErrRegistryModuleVersionFailed, version, rmv.Status)
+	}
+
+	return rmv, nil
+}
+
 // Create a new registry module without a VCS repo
 func (r *registryModules) Create(ctx context.Context, organization string, options RegistryModuleCreateOptions) (*RegistryModule, error) {
 	if !validStringID(&organization) {
@@ -706,6 +866,201 @@ func (r *registryModules) DeleteVersion(ctx context.Context, moduleID RegistryMo
 	return req.Do(ctx, nil)
 }
 
+// DeprecateVersion marks a version of a registry module as deprecated. The
+// version stays available, but consumers are warned about the deprecation and
+// shown its reason and link.
+func (r *registryModules) DeprecateVersion(ctx context.Context, moduleID RegistryModuleID, version string, options RegistryModuleVersionDeprecateOptions) (*RegistryModuleVersion, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	return r.updateVersionDeprecation(ctx, moduleID, version, &registryModuleVersionDeprecationAttr{
+		Reason: options.Reason,
+		Link:   options.Link,
+	})
+}
+
+// RevertDeprecation removes the deprecation of a version of a registry module.
+func (r *registryModules) RevertDeprecation(ctx context.Context, moduleID RegistryModuleID, version string) (*RegistryModuleVersion, error) {
+	return r.updateVersionDeprecation(ctx, moduleID, version, nil)
+}
+
+func (r *registryModules) updateVersionDeprecation(ctx context.Context, moduleID RegistryModuleID, version string, deprecation *registryModuleVersionDeprecationAttr) (*RegistryModuleVersion, error) {
+	if err := moduleID.valid(); err != nil {
+		return nil, err
+	}
+	if !validString(&version) {
+		return nil, ErrRequiredVersion
+	}
+	if !validVersion(version) {
+		return nil, ErrInvalidVersion
+	}
+
+	if moduleID.RegistryName == "" {
+		moduleID.RegistryName = PrivateRegistry
+	}
+	if moduleID.RegistryName == PrivateRegistry && strings.TrimSpace(moduleID.Namespace) == "" {
+		moduleID.Namespace = moduleID.Organization
+	}
+
+	u := fmt.Sprintf(
+		"organizations/%s/registry-modules/%s/%s/%s/%s/%s",
+		url.PathEscape(moduleID.Organization),
+		url.PathEscape(string(moduleID.RegistryName)),
+		url.PathEscape(moduleID.Namespace),
+		url.PathEscape(moduleID.Name),
+		url.PathEscape(moduleID.Provider),
+		url.PathEscape(version),
+	)
+	req, err := r.client.NewRequest(http.MethodPatch, u, &registryModuleVersionDeprecationOptions{
+		Deprecation: deprecation,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	rmv := &RegistryModuleVersion{}
+	if err := req.Do(ctx, rmv); err != nil {
+		return nil, err
+	}
+
+	return rmv, nil
+}
+
+// QueueReleaseRuns queues a run in every workspace consuming the given registry
+// module. Runs are queued concurrently up to options.Concurrency and the
+// outcome for each workspace is returned in the same order the workspaces were
+// resolved; a failure to queue a single run does not stop the others.
+func (r *registryModules) QueueReleaseRuns(ctx context.Context, moduleID RegistryModuleID, options RegistryModuleReleaseRunsOptions) ([]*RegistryModuleReleaseRun, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	rm, err := r.Read(ctx, moduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []*RegistryModuleReleaseRun
+	if len(options.Workspaces) > 0 {
+		results = make([]*RegistryModuleReleaseRun, len(options.Workspaces))
+		for i, ws := range options.Workspaces {
+			results[i] = &RegistryModuleReleaseRun{Workspace: ws}
+		}
+	} else {
+		results, err = r.consumingWorkspaces(ctx, rm, options.Concurrency)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	message := fmt.Sprintf("Triggered by release %s of module %s/%s/%s", options.Version, rm.Namespace, rm.Name, rm.Provider)
+	if options.Message != nil {
+		message = *options.Message
+	}
+
+	if options.DryRun {
+		return results, nil
+	}
+
+	forEachConcurrently(len(results), options.Concurrency, func(i int) {
+		if results[i].Err != nil {
+			return
+		}
+		results[i].Run, results[i].Err = r.client.Runs.Create(ctx, RunCreateOptions{
+			Workspace: results[i].Workspace,
+			Message:   String(message),
+		})
+	})
+
+	return results, nil
+}
+
+// consumingWorkspaces uses the organization explorer modules view to find the
+// workspaces consuming any version of the given registry module. The
+// workspaces are read concurrently up to concurrency; a workspace that cannot
+// be read is returned with its error instead of stopping the others.
+func (r *registryModules) consumingWorkspaces(ctx context.Context, rm *RegistryModule, concurrency int) ([]*RegistryModuleReleaseRun, error) {
+	if rm.Organization == nil {
+		return nil, ErrInvalidOrg
+	}
+	org := rm.Organization.Name
+
+	// Sources are of the form <hostname>/<namespace>/<name>/<provider>.
+	sourceSuffix := fmt.Sprintf("/%s/%s/%s", rm.Namespace, rm.Name, rm.Provider)
+
+	u := fmt.Sprintf("organizations/%s/explorer", url.PathEscape(org))
+	options := &explorerQueryOptions{
+		ListOptions: ListOptions{PageNumber: 1, PageSize: 100},
+		Type:        "modules",
+		Name:        rm.Name,
+	}
+
+	names := []string{}
+	seen := map[string]bool{}
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		req, err := r.client.NewRequest("GET", u, options)
+		if err != nil {
+			return nil, err
+		}
+
+		list := &explorerModuleViewList{}
+		if err := req.Do(ctx, list); err != nil {
+			return nil, err
+		}
+
+		for _, row := range list.Items {
+			if !strings.HasSuffix(row.Source, sourceSuffix) {
+				continue
+			}
+			for _, name := range strings.Split(row.Workspaces, ",") {
+				name = strings.TrimSpace(name)
+				if name == "" || seen[name] {
+					continue
+				}
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+		return list.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*RegistryModuleReleaseRun, len(names))
+	forEachConcurrently(len(names), concurrency, func(i int) {
+		ws, err := r.client.Workspaces.Read(ctx, org, names[i])
+		if err != nil {
+			ws = &Workspace{Name: names[i]}
+		}
+		results[i] = &RegistryModuleReleaseRun{Workspace: ws, Err: err}
+	})
+
+	return results, nil
+}
+
+func (o RegistryModuleReleaseRunsOptions) valid() error {
+	if !validString(&o.Version) {
+		return ErrRequiredVersion
+	}
+	if !validVersion(o.Version) {
+		return ErrInvalidVersion
+	}
+
+	return nil
+}
+
+func (o RegistryModuleVersionDeprecateOptions) valid() error {
+	if validString(o.Link) {
+		u, err := url.Parse(*o.Link)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return ErrInvalidDeprecationLink
+		}
+	}
+	return nil
+}
+
 func (o RegistryModuleID) valid() error {
 	if validString(&o.ID) && validStringID(&o.ID) {
 		return nil
@@ -8,6 +8,9 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"regexp"
 	"strings"
@@ -1735,6 +1738,45 @@ func TestRegistryModulesUpload(t *testing.T) {
 	})
 }
 
+func TestRegistryModulesPublishLocalVersion(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	rm, rmCleanup := createRegistryModule(t, client, orgTest, PrivateRegistry)
+	t.Cleanup(rmCleanup)
+
+	moduleID := RegistryModuleID{
+		Organization: orgTest.Name,
+		Name:         rm.Name,
+		Provider:     rm.Provider,
+		Namespace:    rm.Namespace,
+		RegistryName: rm.RegistryName,
+	}
+
+	t.Run("with a valid configuration directory", func(t *testing.T) {
+		rmv, err := client.RegistryModules.PublishLocalVersion(ctx, moduleID, "1.0.0", "test-fixtures/config-version")
+		require.NoError(t, err)
+
+		assert.Equal(t, "1.0.0", rmv.Version)
+		assert.Equal(t, RegistryModuleVersionStatusOk, rmv.Status)
+	})
+
+	t.Run("with a missing configuration directory", func(t *testing.T) {
+		rmv, err := client.RegistryModules.PublishLocalVersion(ctx, moduleID, "1.0.1", "test-fixtures/nonexisting")
+		assert.Nil(t, rmv)
+		assert.ErrorIs(t, err, os.ErrNotExist)
+	})
+
+	t.Run("with an invalid version", func(t *testing.T) {
+		rmv, err := client.RegistryModules.PublishLocalVersion(ctx, moduleID, "foo", "test-fixtures/config-version")
+		assert.Nil(t, rmv)
+		assert.Equal(t, ErrInvalidVersion, err)
+	})
+}
+
 func TestRegistryModulesUploadTarGzip(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -1787,6 +1829,141 @@ func TestRegistryModulesUploadTarGzip(t *testing.T) {
 	})
 }
 
+func TestRegistryModulesQueueReleaseRuns(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	rm, rmCleanup := createRegistryModule(t, client, orgTest, PrivateRegistry)
+	t.Cleanup(rmCleanup)
+
+	wTest1, wTest1Cleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTest1Cleanup)
+	wTest2, wTest2Cleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTest2Cleanup)
+
+	moduleID := RegistryModuleID{
+		Organization: orgTest.Name,
+		Name:         rm.Name,
+		Provider:     rm.Provider,
+		Namespace:    rm.Namespace,
+		RegistryName: rm.RegistryName,
+	}
+
+	t.Run("with dry run", func(t *testing.T) {
+		results, err := client.RegistryModules.QueueReleaseRuns(ctx, moduleID, RegistryModuleReleaseRunsOptions{
+			Version:    "1.0.1",
+			Workspaces: []*Workspace{wTest1, wTest2},
+			DryRun:     true,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		for _, result := range results {
+			assert.Nil(t, result.Run)
+			assert.NoError(t, result.Err)
+		}
+		assert.Equal(t, wTest1.ID, results[0].Workspace.ID)
+		assert.Equal(t, wTest2.ID, results[1].Workspace.ID)
+	})
+
+	t.Run("without a version", func(t *testing.T) {
+		results, err := client.RegistryModules.QueueReleaseRuns(ctx, moduleID, RegistryModuleReleaseRunsOptions{})
+		assert.Nil(t, results)
+		assert.Equal(t, ErrRequiredVersion, err)
+	})
+
+	t.Run("with an invalid version", func(t *testing.T) {
+		results, err := client.RegistryModules.QueueReleaseRuns(ctx, moduleID, RegistryModuleReleaseRunsOptions{
+			Version: "not-a-version",
+		})
+		assert.Nil(t, results)
+		assert.Equal(t, ErrInvalidVersion, err)
+	})
+}
+
+func TestRegistryModulesQueueReleaseRunsFromExplorer(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/registry-modules/mod-1":
+			_, _ = io.WriteString(w, `{"data":{"id":"mod-1","type":"registry-modules","attributes":{"name":"vpc","namespace":"acme","provider":"aws"},"relationships":{"organization":{"data":{"id":"acme","type":"organizations"}}}}}`)
+		case "GET /api/v2/organizations/acme/explorer":
+			assert.Equal(t, "modules", r.URL.Query().Get("type"))
+			assert.Equal(t, "vpc", r.URL.Query().Get("filter[0][name][is][0]"))
+			_, _ = io.WriteString(w, `{"data":[
+				{"id":"1","type":"visibility-module","attributes":{"name":"vpc","source":"app.terraform.io/acme/vpc/aws","version":"1.0.0","workspaces":"one,missing"}},
+				{"id":"2","type":"visibility-module","attributes":{"name":"vpc","source":"app.terraform.io/acme/vpc/google","version":"1.0.0","workspaces":"other"}}
+			],"meta":{"pagination":{"current-page":1,"total-pages":1,"total-count":2}}}`)
+		case "GET /api/v2/organizations/acme/workspaces/one":
+			_, _ = io.WriteString(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"one"}}}`)
+		case "POST /api/v2/runs":
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"id":"run-1","type":"runs","attributes":{"message":"release"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	results, err := client.RegistryModules.QueueReleaseRuns(context.Background(), RegistryModuleID{ID: "mod-1"}, RegistryModuleReleaseRunsOptions{
+		Version:     "1.0.1",
+		Concurrency: 2,
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, "ws-1", results[0].Workspace.ID)
+	assert.NoError(t, results[0].Err)
+	require.NotNil(t, results[0].Run)
+	assert.Equal(t, "run-1", results[0].Run.ID)
+
+	assert.Equal(t, "missing", results[1].Workspace.Name)
+	assert.ErrorIs(t, results[1].Err, ErrResourceNotFound)
+	assert.Nil(t, results[1].Run)
+}
+
+func TestExplorerModuleView_Unmarshal(t *testing.T) {
+	data := map[string]interface{}{
+		"data": []interface{}{
+			map[string]interface{}{
+				"type": "visibility-module",
+				"attributes": map[string]interface{}{
+					"name":            "vpc",
+					"source":          "app.terraform.io/org-abc/vpc/aws",
+					"version":         "1.0.0",
+					"workspace-count": 2,
+					"workspaces":      "ws-one,ws-two",
+				},
+			},
+		},
+	}
+
+	byteData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	list := &explorerModuleViewList{}
+	err = unmarshalResponse(bytes.NewReader(byteData), list)
+	require.NoError(t, err)
+
+	require.Len(t, list.Items, 1)
+	row := list.Items[0]
+	assert.Equal(t, "vpc", row.Name)
+	assert.Equal(t, "app.terraform.io/org-abc/vpc/aws", row.Source)
+	assert.Equal(t, "1.0.0", row.Version)
+	assert.Equal(t, 2, row.WorkspaceCount)
+	assert.Equal(t, "ws-one,ws-two", row.Workspaces)
+}
+
 func TestRegistryModule_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -1875,3 +2052,78 @@ func TestRegistryCreateWithVCSOptions_Marshal(t *testing.T) {
 `
 	assert.Equal(t, expectedBody, string(bodyBytes))
 }
+
+func TestRegistryModulesDeprecateVersion(t *testing.T) {
+	t.Parallel()
+
+	var deprecation interface{}
+	var sent bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "PATCH /api/v2/organizations/acme/registry-modules/private/acme/vpc/aws/1.2.0":
+			var body struct {
+				Data struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			deprecation, sent = body.Data.Attributes["deprecation"]
+
+			attributes := map[string]interface{}{"version": "1.2.0", "deprecation": deprecation}
+			_ = json.NewEncoder(w).Encode(map[string]interface{}{
+				"data": map[string]interface{}{"id": "modver-1", "type": "registry-module-versions", "attributes": attributes},
+			})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	moduleID := RegistryModuleID{Organization: "acme", Name: "vpc", Provider: "aws"}
+
+	t.Run("deprecate", func(t *testing.T) {
+		rmv, err := client.RegistryModules.DeprecateVersion(ctx, moduleID, "1.2.0", RegistryModuleVersionDeprecateOptions{
+			Reason: String("Uses a vulnerable provider version"),
+			Link:   String("https://example.com/vpc/2.0.0"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{
+			"reason": "Uses a vulnerable provider version",
+			"link":   "https://example.com/vpc/2.0.0",
+		}, deprecation)
+
+		require.NotNil(t, rmv.Deprecation)
+		assert.Equal(t, "Uses a vulnerable provider version", rmv.Deprecation.Reason)
+		assert.Equal(t, "https://example.com/vpc/2.0.0", rmv.Deprecation.Link)
+	})
+
+	t.Run("revert", func(t *testing.T) {
+		rmv, err := client.RegistryModules.RevertDeprecation(ctx, moduleID, "1.2.0")
+		require.NoError(t, err)
+		assert.True(t, sent)
+		assert.Nil(t, deprecation)
+		assert.Nil(t, rmv.Deprecation)
+	})
+
+	t.Run("with an invalid link", func(t *testing.T) {
+		_, err := client.RegistryModules.DeprecateVersion(ctx, moduleID, "1.2.0", RegistryModuleVersionDeprecateOptions{
+			Link: String("example.com/vpc"),
+		})
+		assert.Equal(t, ErrInvalidDeprecationLink, err)
+	})
+
+	t.Run("with an invalid version", func(t *testing.T) {
+		_, err := client.RegistryModules.RevertDeprecation(ctx, moduleID, "latest")
+		assert.Equal(t, ErrInvalidVersion, err)
+	})
+}
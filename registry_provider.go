@@ -88,6 +88,9 @@ type RegistryProviderListOptions struct {
 
 	// Optional: Include related jsonapi relationships
 	Include *[]RegistryProviderIncludeOps `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 type RegistryProviderList struct {
@@ -124,6 +127,9 @@ type RegistryProviderCreateOptions struct {
 type RegistryProviderReadOptions struct {
 	// Optional: Include related jsonapi relationships
 	Include []RegistryProviderIncludeOps `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 func (r *registryProviders) List(ctx context.Context, organization string, options *RegistryProviderListOptions) (*RegistryProviderList, error) {
@@ -4,13 +4,18 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
+	"golang.org/x/sync/singleflight"
 	"golang.org/x/time/rate"
 )
 
@@ -20,16 +25,153 @@ type ClientRequest struct {
 	http             *retryablehttp.Client
 	limiter          *rate.Limiter
 
+	// reads coalesces identical GET requests, or is nil when they are not
+	// coalesced.
+	reads *singleflight.Group
+
+	// tokens provides the API token of the request when it is set, replacing
+	// the token the request was created with.
+	tokens TokenSource
+
 	// Header are the headers that will be sent in this request
 	Header http.Header
 }
 
+// Do sends the request and decodes its response into the model, which is
+// either:
+//
+//   - nil, to discard the response;
+//   - an io.Writer, such as a *bytes.Buffer, to receive the raw response body;
+//   - a pointer to a struct tagged with `jsonapi:"..."`, like the models of the
+//     services, to decode a single resource and its included relations;
+//   - a pointer to a list struct with an embedded *Pagination and an Items
+//     field holding a slice of such pointers, like WorkspaceList, to decode a
+//     page of resources and its pagination.
+//
+// Error responses are returned as the sentinel error matching their status
+// code, such as ErrResourceNotFound, or as an *APIError otherwise.
 func (r ClientRequest) Do(ctx context.Context, model interface{}) error {
+	// Responses written to an io.Writer, such as downloads, are streamed
+	// rather than buffered, so they are never coalesced.
+	if _, ok := model.(io.Writer); !ok && r.reads != nil {
+		return r.doCoalesced(ctx, model)
+	}
+
+	resp, err := r.send(ctx)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	return decodeResponse(resp.Body, model)
+}
+
+// decodeResponse decodes the body of a response into the model.
+func decodeResponse(body io.Reader, model interface{}) error {
+	// Return here if decoding the response isn't needed.
+	if model == nil {
+		return nil
+	}
+
+	// If v implements io.Writer, write the raw response body.
+	if w, ok := model.(io.Writer); ok {
+		_, err := io.Copy(w, body)
+		return err
+	}
+
+	return unmarshalResponse(body, model)
+}
+
+// coalescedResponse is the response of a coalesced request, shared by all the
+// callers that made the same request while it was in flight.
+type coalescedResponse struct {
+	status int
+	header http.Header
+	body   []byte
+}
+
+// withoutResponseHeaderHookContext is a context that does not carry the
+// response header hook of its parent, so the hook of the caller that sends a
+// coalesced request is not called for the other callers.
+type withoutResponseHeaderHookContext struct {
+	context.Context
+}
+
+func (c withoutResponseHeaderHookContext) Value(key any) any {
+	if key == contextResponseHeaderHookKey {
+		return nil
+	}
+	return c.Context.Value(key)
+}
+
+// doCoalesced sends the request unless an identical request is already in
+// flight, in which case it waits for the response of that request instead.
+// Every caller decodes its own copy of the response and has its response
+// header hook called.
+func (r ClientRequest) doCoalesced(ctx context.Context, model interface{}) error {
+	ch := r.reads.DoChan(r.coalescingKey(ctx), func() (interface{}, error) {
+		resp := &coalescedResponse{}
+		sendCtx := ContextWithResponseHeaderHook(withoutResponseHeaderHookContext{ctx}, func(status int, header http.Header) {
+			resp.status = status
+			resp.header = header
+		})
+
+		httpResp, err := r.send(sendCtx)
+		if err != nil {
+			return resp, err
+		}
+		defer httpResp.Body.Close()
+
+		resp.body, err = io.ReadAll(httpResp.Body)
+		return resp, err
+	})
+
+	var res singleflight.Result
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case res = <-ch:
+	}
+
+	// If the caller that sent the request gave up on it, send it again
+	// rather than failing a caller that is still waiting for it.
+	if (errors.Is(res.Err, context.Canceled) || errors.Is(res.Err, context.DeadlineExceeded)) && ctx.Err() == nil {
+		r.reads = nil
+		return r.Do(ctx, model)
+	}
+
+	resp := res.Val.(*coalescedResponse)
+	if resp.status != 0 {
+		contextResponseHeaderHook(ctx)(resp.status, resp.header)
+	}
+	if res.Err != nil {
+		return res.Err
+	}
+
+	return decodeResponse(bytes.NewReader(resp.body), model)
+}
+
+// coalescingKey identifies the requests that can share a response: requests
+// for the same URL with the same headers, which bypass the read cache or not.
+func (r ClientRequest) coalescingKey(ctx context.Context) string {
+	var key strings.Builder
+	if bypass, _ := ctx.Value(contextWithoutCacheKey).(bool); bypass {
+		key.WriteString("nocache\n")
+	}
+	key.WriteString(r.retryableRequest.URL.String())
+	key.WriteString("\n")
+	_ = r.retryableRequest.Header.Write(&key)
+	return key.String()
+}
+
+// send executes the request and returns the response once its status code
+// has been checked. The caller is responsible for closing the response body.
+func (r ClientRequest) send(ctx context.Context) (*http.Response, error) {
 	// Wait will block until the limiter can obtain a new token
 	// or returns an error if the given context is canceled.
 	if r.limiter != nil {
 		if err := r.limiter.Wait(ctx); err != nil {
-			return err
+			return nil, err
 		}
 	}
 
@@ -37,11 +179,8 @@ func (r ClientRequest) Do(ctx context.Context, model interface{}) error {
 	// once we have a response.
 	respHeaderHook := contextResponseHeaderHook(ctx)
 
-	// Add the context to the request.
-	reqWithCxt := r.retryableRequest.WithContext(ctx)
-
 	// Execute the request and check the response.
-	resp, err := r.http.Do(reqWithCxt)
+	resp, err := r.do(ctx)
 	if resp != nil {
 		// We call the callback whenever there's any sort of response,
 		// even if it's returned in conjunction with an error.
@@ -52,30 +191,56 @@ func (r ClientRequest) Do(ctx context.Context, model interface{}) error {
 		// the context's error is probably more useful.
 		select {
 		case <-ctx.Done():
-			return ctx.Err()
+			return nil, ctx.Err()
 		default:
-			return err
+			return nil, err
 		}
 	}
-	defer resp.Body.Close()
 
 	// Basic response checking.
 	if err := checkResponseCode(resp); err != nil {
-		return err
+		resp.Body.Close()
+		return nil, err
 	}
 
-	// Return here if decoding the response isn't needed.
-	if model == nil {
-		return nil
+	return resp, nil
+}
+
+// do executes the request with the context. When the request has a token
+// source, it is authenticated with the token of the source, and it is sent
+// once more with a refreshed token when the API rejects it with a 401.
+// Requests sent without an Authorization header, such as uploads to upload
+// URLs, are sent unchanged.
+func (r ClientRequest) do(ctx context.Context) (*http.Response, error) {
+	req := r.retryableRequest.WithContext(ctx)
+	if r.tokens == nil || req.Header.Get("Authorization") == "" {
+		return r.http.Do(req)
 	}
 
-	// If v implements io.Writer, write the raw response body.
-	if w, ok := model.(io.Writer); ok {
-		_, err := io.Copy(w, resp.Body)
-		return err
+	token, err := r.tokens.Token(ctx, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get API token: %w", err)
 	}
+	req.Header = req.Header.Clone()
+	req.Header.Set("Authorization", "Bearer "+token)
 
-	return unmarshalResponse(resp.Body, model)
+	resp, err := r.http.Do(req)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	refreshed, err := r.tokens.Token(ctx, true)
+	if errors.Is(err, ErrNoToken) || (err == nil && refreshed == token) {
+		// There is no other token to try.
+		return resp, nil
+	}
+	resp.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to refresh API token: %w", err)
+	}
+
+	req.Header.Set("Authorization", "Bearer "+refreshed)
+	return r.http.Do(req)
 }
 
 // DoJSON is similar to Do except that it should be used when a plain JSON response is expected
@@ -89,15 +254,12 @@ func (r *ClientRequest) DoJSON(ctx context.Context, model any) error {
 		}
 	}
 
-	// Add the context to the request.
-	contextReq := r.retryableRequest.WithContext(ctx)
-
 	// If the caller provided a response header hook then we'll call it
 	// once we have a response.
 	respHeaderHook := contextResponseHeaderHook(ctx)
 
 	// Execute the request and check the response.
-	resp, err := r.http.Do(contextReq)
+	resp, err := r.do(ctx)
 	if resp != nil {
 		// We call the callback whenever there's any sort of response,
 		// even if it's returned in conjunction with an error.
@@ -116,7 +278,15 @@ func (r *ClientRequest) DoJSON(ctx context.Context, model any) error {
 	defer resp.Body.Close()
 
 	if resp.StatusCode < 200 || resp.StatusCode >= 400 {
-		return fmt.Errorf("error HTTP response: %d", resp.StatusCode)
+		// The response of a plain JSON endpoint has no error document, but
+		// its status is reported the same way as for JSON:API endpoints.
+		return &APIError{
+			StatusCode: resp.StatusCode,
+			RequestID:  resp.Header.Get(_headerRequestID),
+			RateLimit:  parseRateLimit(resp.Header),
+			RetryAfter: parseRetryAfter(resp.Header, time.Now()),
+			err:        fmt.Errorf("error HTTP response: %d", resp.StatusCode),
+		}
 	} else if resp.StatusCode == 304 {
 		// Got a "Not Modified" response, but we can't return a model because there is no response body.
 		// This is necessary to support the IPRanges endpoint, which has the peculiar behavior
@@ -4,14 +4,19 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/stretchr/testify/assert"
@@ -124,3 +129,131 @@ func TestClientRequest_DoJSON(t *testing.T) {
 		assert.EqualError(t, err, "error HTTP response: 400")
 	})
 }
+
+func TestClientRequest_CoalesceReads(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	release := make(chan struct{})
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		atomic.AddInt32(&requests, 1)
+		<-release
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/workspaces/ws-1":
+			_, _ = io.WriteString(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"prod"}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(testServer.Close)
+
+	client, err := NewClient(&Config{
+		Address:       testServer.URL,
+		Token:         "insert-your-token-here",
+		HTTPClient:    testServer.Client(),
+		CoalesceReads: true,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	var wg sync.WaitGroup
+	var statuses int32
+	workspaces := make([]*Workspace, 10)
+	errs := make([]error, 10)
+	for i := range workspaces {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			hookCtx := ContextWithResponseHeaderHook(ctx, func(status int, header http.Header) {
+				atomic.AddInt32(&statuses, 1)
+			})
+			workspaces[i], errs[i] = client.Workspaces.ReadByID(hookCtx, "ws-1")
+		}(i)
+	}
+
+	// Give the reads time to reach the in-flight request before answering it.
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	assert.Equal(t, int32(1), atomic.LoadInt32(&requests))
+	assert.Equal(t, int32(10), atomic.LoadInt32(&statuses))
+	for i, ws := range workspaces {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "prod", ws.Name)
+	}
+
+	// Each caller decodes its own copy of the response.
+	assert.NotSame(t, workspaces[0], workspaces[1])
+
+	t.Run("with an error response", func(t *testing.T) {
+		_, err := client.Workspaces.ReadByID(ctx, "ws-2")
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+}
+
+func TestClientRequest_CoalesceReadsWriter(t *testing.T) {
+	t.Parallel()
+
+	var requests int32
+	release := make(chan struct{})
+	testServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		atomic.AddInt32(&requests, 1)
+		<-release
+		_, _ = io.WriteString(w, "archive")
+	}))
+	t.Cleanup(testServer.Close)
+
+	client, err := NewClient(&Config{
+		Address:       testServer.URL,
+		Token:         "insert-your-token-here",
+		HTTPClient:    testServer.Client(),
+		CoalesceReads: true,
+	})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// Downloads to an io.Writer are streamed, so each one sends its own
+	// request even while an identical one is in flight.
+	var wg sync.WaitGroup
+	bufs := make([]*bytes.Buffer, 2)
+	errs := make([]error, 2)
+	for i := range bufs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			req, err := client.NewRequest("GET", "configuration-versions/cv-1/download", nil)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+			bufs[i] = &bytes.Buffer{}
+			errs[i] = req.Do(ctx, bufs[i])
+		}(i)
+	}
+
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt32(&requests) == 2
+	}, time.Second, 10*time.Millisecond)
+	close(release)
+	wg.Wait()
+
+	for i, buf := range bufs {
+		require.NoError(t, errs[i])
+		assert.Equal(t, "archive", buf.String())
+	}
+}
@@ -4,9 +4,13 @@
 package tfe
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/url"
+	"sort"
 	"time"
 )
 
@@ -44,6 +48,23 @@ type Runs interface {
 
 	// Discard a run by its ID.
 	Discard(ctx context.Context, runID string, options RunDiscardOptions) error
+
+	// CancelOrDiscardAndWait stops a run by discarding or canceling it,
+	// escalating to a force-cancel when allowed, and waits until the run
+	// reaches a final status.
+	CancelOrDiscardAndWait(ctx context.Context, runID string, options RunCancelOrDiscardOptions) (*Run, error)
+
+	// ListOlderThan lists all the runs of the given workspace created before
+	// the given time, reading every page of runs.
+	ListOlderThan(ctx context.Context, workspaceID string, before time.Time, options *RunListOptions) ([]*Run, error)
+
+	// DiscardOlderThan discards the runs of the given workspace created
+	// before the given time that still wait for a decision.
+	DiscardOlderThan(ctx context.Context, workspaceID string, before time.Time, options RunDiscardOptions) ([]*Run, error)
+
+	// ReadDiagnostics reads the diagnostics reported by Terraform in the
+	// structured logs of the plan and apply of a run.
+	ReadDiagnostics(ctx context.Context, runID string) ([]*RunDiagnostic, error)
 }
 
 // runs implements Runs.
@@ -88,6 +109,95 @@ const (
 	RunQueuingApply             RunStatus = "queuing_apply"
 )
 
+// runStatusPhase represents the phase of a run a run status belongs to.
+type runStatusPhase int
+
+const (
+	// The run is queued or running, and can be canceled.
+	runInProgress runStatusPhase = iota + 1
+	// The run waits for a decision, such as a confirmation or a policy
+	// override, and can be discarded.
+	runAwaitingDecision
+	// The run has stopped, and its status does not change anymore.
+	runStopped
+)
+
+// runStatusPhases classifies each run status. It is the source of truth of
+// the predicates of RunStatus: a new run status must be added to it.
+var runStatusPhases = map[RunStatus]runStatusPhase{
+	RunPending:                  runInProgress,
+	RunFetching:                 runInProgress,
+	RunFetchingCompleted:        runInProgress,
+	RunQueuing:                  runInProgress,
+	RunPlanQueued:               runInProgress,
+	RunPrePlanRunning:           runInProgress,
+	RunPrePlanCompleted:         runInProgress,
+	RunPlanning:                 runInProgress,
+	RunCostEstimating:           runInProgress,
+	RunPolicyChecking:           runInProgress,
+	RunPostPlanRunning:          runInProgress,
+	RunConfirmed:                runInProgress,
+	RunQueuingApply:             runInProgress,
+	RunApplyQueued:              runInProgress,
+	RunPreApplyRunning:          runInProgress,
+	RunPreApplyCompleted:        runInProgress,
+	RunApplying:                 runInProgress,
+	RunPlanned:                  runAwaitingDecision,
+	RunCostEstimated:            runAwaitingDecision,
+	RunPolicyChecked:            runAwaitingDecision,
+	RunPolicyOverride:           runAwaitingDecision,
+	RunPolicySoftFailed:         runAwaitingDecision,
+	RunPostPlanCompleted:        runAwaitingDecision,
+	RunPostPlanAwaitingDecision: runAwaitingDecision,
+	RunApplied:                  runStopped,
+	RunCanceled:                 runStopped,
+	RunDiscarded:                runStopped,
+	RunErrored:                  runStopped,
+	RunPlannedAndFinished:       runStopped,
+	RunPlannedAndSaved:          runStopped,
+}
+
+// RunStatuses returns all the run statuses known to this library, sorted.
+func RunStatuses() []RunStatus {
+	statuses := make([]RunStatus, 0, len(runStatusPhases))
+	for status := range runStatusPhases {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i] < statuses[j]
+	})
+	return statuses
+}
+
+// IsKnown reports whether the status is one of the run statuses known to
+// this library. The predicates of an unknown status all return false, so
+// polling code waiting for a terminal status keeps waiting on a status added
+// to the API since.
+func (s RunStatus) IsKnown() bool {
+	_, ok := runStatusPhases[s]
+	return ok
+}
+
+// IsTerminal reports whether a run with the status has stopped, so its status
+// does not change anymore. A saved plan is terminal, as it is only applied by
+// a new run.
+func (s RunStatus) IsTerminal() bool {
+	return runStatusPhases[s] == runStopped
+}
+
+// IsCancelable reports whether a run with the status is queued or running,
+// so it can be canceled.
+func (s RunStatus) IsCancelable() bool {
+	return runStatusPhases[s] == runInProgress
+}
+
+// IsAwaitingDecision reports whether a run with the status waits for a
+// decision, such as a confirmation or a policy override, so it can be
+// discarded. The actions of the run tell which decisions are available.
+func (s RunStatus) IsAwaitingDecision() bool {
+	return runStatusPhases[s] == runAwaitingDecision
+}
+
 // RunSource represents a source type of a run.
 type RunSource string
 
@@ -111,6 +221,22 @@ const (
 	RunOperationSavePlan    RunOperation = "save_plan"
 )
 
+// RunDiagnostic represents a diagnostic reported by Terraform in the
+// structured logs of a plan or apply, which is how Terraform reports errors
+// and warnings.
+type RunDiagnostic struct {
+	// The stage of the run that reported the diagnostic, "plan" or "apply",
+	// or "test" for the diagnostics of a test run.
+	Stage string `json:"-"`
+
+	// The severity of the diagnostic, "error" or "warning".
+	Severity string           `json:"severity"`
+	Summary  string           `json:"summary"`
+	Detail   string           `json:"detail"`
+	Address  string           `json:"address,omitempty"`
+	Range    *DiagnosticRange `json:"range,omitempty"`
+}
+
 // RunList represents a list of runs.
 type RunList struct {
 	*Pagination
@@ -230,24 +356,39 @@ type RunListOptions struct {
 	// The presence of search[commit] or search[user] takes priority over this parameter and will be omitted.
 	Search string `url:"search[basic],omitempty"`
 
+	// Optional: A list of acceptable run statuses.
+	// Options are listed at https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run#run-states.
+	Statuses []RunStatus `url:"filter[status],omitempty"`
+
+	// Optional: A list of acceptable run sources.
+	// Options are listed at https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run#run-sources.
+	Sources []RunSource `url:"filter[source],omitempty"`
+
+	// Optional: A list of acceptable run operation types.
+	// Options are listed at https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run#run-operations.
+	Operations []RunOperation `url:"filter[operation],omitempty"`
+
 	// Optional: Comma-separated list of acceptable run statuses.
-	// Options are listed at https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run#run-states,
-	// or as constants with the RunStatus string type.
+	//
+	// Deprecated: Use Statuses instead.
 	Status string `url:"filter[status],omitempty"`
 
 	// Optional: Comma-separated list of acceptable run sources.
-	// Options are listed at https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run#run-sources,
-	// or as constants with the RunSource string type.
+	//
+	// Deprecated: Use Sources instead.
 	Source string `url:"filter[source],omitempty"`
 
 	// Optional: Comma-separated list of acceptable run operation types.
-	// Options are listed at https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run#run-operations,
-	// or as constants with the RunOperation string type.
+	//
+	// Deprecated: Use Operations instead.
 	Operation string `url:"filter[operation],omitempty"`
 
 	// Optional: A list of relations to include. See available resources:
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run#available-related-resources
 	Include []RunIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // RunReadOptions represents the options for reading a run.
@@ -255,6 +396,9 @@ type RunReadOptions struct {
 	// Optional: A list of relations to include. See available resources:
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run#available-related-resources
 	Include []RunIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // RunCreateOptions represents the options for creating a new run.
@@ -349,12 +493,14 @@ type RunCancelOptions struct {
 	Comment *string `json:"comment,omitempty"`
 }
 
+// RunVariableAttr represents a run-specific variable set when the run was
+// created, as read back on the run. Its value is the HCL literal it was set to.
 type RunVariableAttr struct {
 	Key   string `jsonapi:"attr,key"`
 	Value string `jsonapi:"attr,value"`
 }
 
-// RunVariableAttr represents a variable that can be applied to a run. All values must be expressed as an HCL literal
+// RunVariable represents a variable that can be applied to a run. All values must be expressed as an HCL literal
 // in the same syntax you would use when writing terraform code. See https://developer.hashicorp.com/terraform/language/expressions/types#types
 // for more details.
 type RunVariable struct {
@@ -374,6 +520,18 @@ type RunDiscardOptions struct {
 	Comment *string `json:"comment,omitempty"`
 }
 
+// RunCancelOrDiscardOptions represents the options for stopping a run with
+// CancelOrDiscardAndWait.
+type RunCancelOrDiscardOptions struct {
+	// An optional explanation for why the run was stopped, sent along with
+	// each action taken on the run.
+	Comment *string
+
+	// How often the run is read while waiting for it to stop. Defaults to an
+	// interval growing from 3 to 5 seconds, or to Config.PollingBackoff.
+	PollInterval time.Duration
+}
+
 // List all the runs of the given workspace.
 func (s *runs) List(ctx context.Context, workspaceID string, options *RunListOptions) (*RunList, error) {
 	if !validStringID(&workspaceID) {
@@ -527,6 +685,199 @@ func (s *runs) Discard(ctx context.Context, runID string, options RunDiscardOpti
 	return req.Do(ctx, nil)
 }
 
+// CancelOrDiscardAndWait stops a run and waits until it reaches a final
+// status, which it returns along with the run. A run waiting for
+// confirmation is discarded, and an active run is canceled. If a canceled run
+// does not stop, it is force-canceled once the API allows it, that is once
+// its force-cancel-available-at time has passed. Actions that conflict with
+// a change of the status of the run are retried with its new status.
+func (s *runs) CancelOrDiscardAndWait(ctx context.Context, runID string, options RunCancelOrDiscardOptions) (*Run, error) {
+	if !validStringID(&runID) {
+		return nil, ErrInvalidRunID
+	}
+
+	var discarded, canceled, forceCanceled bool
+	for reads := 0; ; reads++ {
+		r, err := s.Read(ContextWithoutCache(ctx), runID)
+		if err != nil {
+			return nil, err
+		}
+		if r.Status.IsTerminal() {
+			return r, nil
+		}
+
+		actions := r.Actions
+		if actions == nil {
+			actions = &RunActions{}
+		}
+
+		switch {
+		case actions.IsDiscardable && !discarded:
+			err = s.Discard(ctx, runID, RunDiscardOptions{Comment: options.Comment})
+			discarded = err == nil
+		case actions.IsCancelable && !canceled:
+			err = s.Cancel(ctx, runID, RunCancelOptions{Comment: options.Comment})
+			canceled = err == nil
+		case canceled && actions.IsForceCancelable && !forceCanceled &&
+			!r.ForceCancelAvailableAt.IsZero() && !time.Now().Before(r.ForceCancelAvailableAt):
+			err = s.ForceCancel(ctx, runID, RunForceCancelOptions{Comment: options.Comment})
+			forceCanceled = err == nil
+		}
+		if err != nil && !IsConflict(err) {
+			return nil, err
+		}
+
+		b := s.client.pollingBackoff(pollBackoff)
+		if options.PollInterval > 0 {
+			b = Backoff{Min: options.PollInterval}
+		}
+		if err := b.Wait(ctx, reads, err); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// ListOlderThan lists all the runs of the given workspace created before the
+// given time, newest first, filtered by the options. All the pages of runs
+// are read, starting from the page of the options, so the pagination of the
+// options only sets the page size.
+//
+// Runs cannot be deleted through the API: to purge old runs and their data,
+// set a data retention policy on the workspace with
+// Workspaces.SetDataRetentionPolicyDeleteOlder.
+func (s *runs) ListOlderThan(ctx context.Context, workspaceID string, before time.Time, options *RunListOptions) ([]*Run, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	opts := RunListOptions{}
+	if options != nil {
+		opts = *options
+	}
+
+	var older []*Run
+	err := forEachPage(&opts.ListOptions, func() (*Pagination, error) {
+		rl, err := s.List(ctx, workspaceID, &opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range rl.Items {
+			if r.CreatedAt.Before(before) {
+				older = append(older, r)
+			}
+		}
+		return rl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return older, nil
+}
+
+// DiscardOlderThan discards the runs of the given workspace created before
+// the given time that still wait for a decision, such as a confirmation or a
+// policy override, so they stop holding up the history of the workspace. It
+// returns the runs it discarded. A run whose status changed since it was
+// listed, so it cannot be discarded anymore, is skipped.
+func (s *runs) DiscardOlderThan(ctx context.Context, workspaceID string, before time.Time, options RunDiscardOptions) ([]*Run, error) {
+	var statuses []RunStatus
+	for _, status := range RunStatuses() {
+		if status.IsAwaitingDecision() {
+			statuses = append(statuses, status)
+		}
+	}
+
+	older, err := s.ListOlderThan(ctx, workspaceID, before, &RunListOptions{Statuses: statuses})
+	if err != nil {
+		return nil, err
+	}
+
+	var discarded []*Run
+	for _, r := range older {
+		if r.Actions == nil || !r.Actions.IsDiscardable {
+			continue
+		}
+
+		err := s.Discard(ctx, r.ID, options)
+		if IsConflict(err) {
+			continue
+		}
+		if err != nil {
+			return discarded, err
+		}
+		discarded = append(discarded, r)
+	}
+
+	return discarded, nil
+}
+
+// ReadDiagnostics reads the structured logs of the plan and apply of a run
+// and returns the diagnostics they report, plan diagnostics first. A plan or
+// apply that has not started is skipped, and the logs of one in progress are
+// read until it has stopped, so ReadDiagnostics blocks until then. Log lines
+// that are not structured are ignored.
+func (s *runs) ReadDiagnostics(ctx context.Context, runID string) ([]*RunDiagnostic, error) {
+	r, err := s.ReadWithOptions(ctx, runID, &RunReadOptions{
+		Include: []RunIncludeOpt{RunPlan, RunApply},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var diags []*RunDiagnostic
+
+	if r.Plan != nil && r.Plan.Status != PlanPending && r.Plan.Status != PlanUnreachable {
+		logs, err := s.client.Plans.Logs(ctx, r.Plan.ID)
+		if err != nil {
+			return nil, err
+		}
+		if diags, err = readDiagnostics(logs, "plan", diags); err != nil {
+			return nil, err
+		}
+	}
+
+	if r.Apply != nil && r.Apply.Status != ApplyPending && r.Apply.Status != ApplyUnreachable {
+		logs, err := s.client.Applies.Logs(ctx, r.Apply.ID)
+		if err != nil {
+			return nil, err
+		}
+		if diags, err = readDiagnostics(logs, "apply", diags); err != nil {
+			return nil, err
+		}
+	}
+
+	return diags, nil
+}
+
+// readDiagnostics appends the diagnostics of the structured log lines of
+// logs to diags. See the JSON output format of Terraform for the messages:
+// https://developer.hashicorp.com/terraform/internals/machine-readable-ui
+func readDiagnostics(logs io.Reader, stage string, diags []*RunDiagnostic) ([]*RunDiagnostic, error) {
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		var line struct {
+			Type       string         `json:"type"`
+			Diagnostic *RunDiagnostic `json:"diagnostic"`
+		}
+		// Lines that are not JSON are not part of the structured logs.
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			continue
+		}
+		if line.Type != "diagnostic" || line.Diagnostic == nil {
+			continue
+		}
+
+		line.Diagnostic.Stage = stage
+		diags = append(diags, line.Diagnostic)
+	}
+
+	return diags, scanner.Err()
+}
+
 func (o RunCreateOptions) valid() error {
 	if o.Workspace == nil {
 		return ErrRequiredWorkspace
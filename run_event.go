@@ -47,9 +47,18 @@ type RunEvent struct {
 	CreatedAt   time.Time `jsonapi:"attr,created-at,iso8601"`
 	Description string    `jsonapi:"attr,description"`
 
-	// Relations - Note that `target` is not supported yet
-	Actor   *User    `jsonapi:"relation,actor"`
-	Comment *Comment `jsonapi:"relation,comment"`
+	// Relations
+	Actor   *User                 `jsonapi:"relation,actor"`
+	Comment *Comment              `jsonapi:"relation,comment"`
+	Target  *RunEventTargetChoice `jsonapi:"polyrelation,target"`
+}
+
+// RunEventTargetChoice is a choice type struct that represents the possible
+// values within the polymorphic target relation of a run event. If a value is
+// available, exactly one field will be non-nil.
+type RunEventTargetChoice struct {
+	Run       *Run
+	TaskStage *TaskStage
 }
 
 // RunEventIncludeOpt represents the available options for include query params.
@@ -64,12 +73,18 @@ const (
 type RunEventListOptions struct {
 	// Optional: A list of relations to include. See available resources:
 	Include []RunEventIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // RunEventReadOptions represents the options for reading a run event.
 type RunEventReadOptions struct {
 	// Optional: A list of relations to include. See available resources:
 	Include []RunEventIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // List all the run events of the given run.
@@ -66,6 +66,19 @@ func TestRunEventsList(t *testing.T) {
 		assert.Equal(t, commentEvent.Comment.Body, commentText)
 	})
 
+	t.Run("with target relation", func(t *testing.T) {
+		rl, err := client.RunEvents.List(ctx, rTest.ID, nil)
+		require.NoError(t, err)
+		require.NotEmpty(t, rl.Items)
+
+		for _, event := range rl.Items {
+			if event.Target == nil || event.Target.Run == nil {
+				continue
+			}
+			assert.Equal(t, rTest.ID, event.Target.Run.ID)
+		}
+	})
+
 	t.Run("without a valid run ID", func(t *testing.T) {
 		rl, err := client.RunEvents.List(ctx, badIdentifier, nil)
 		assert.Nil(t, rl)
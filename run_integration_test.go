@@ -8,6 +8,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -720,3 +728,330 @@ func TestRunCreateOptions_Marshal(t *testing.T) {
 
 	assert.Equal(t, string(bodyBytes), expectedBody)
 }
+
+func TestRunsCancelOrDiscardAndWait(t *testing.T) {
+	t.Parallel()
+
+	// fakeRun serves a run whose status and actions change with the actions
+	// taken on it, and records those actions.
+	type fakeRun struct {
+		mu      sync.Mutex
+		status  RunStatus
+		actions RunActions
+		forceAt time.Time
+		calls   []string
+		next    map[string]RunStatus
+	}
+
+	newServer := func(t *testing.T, run *fakeRun) *Client {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			run.mu.Lock()
+			defer run.mu.Unlock()
+
+			switch {
+			case r.URL.Path == "/api/v2/ping":
+				w.WriteHeader(http.StatusNoContent)
+			case r.Method == "GET" && r.URL.Path == "/api/v2/runs/run-1":
+				w.Header().Set("Content-Type", ContentTypeJSONAPI)
+				fmt.Fprintf(w, `{"data":{"id":"run-1","type":"runs","attributes":{"status":%q,"force-cancel-available-at":%q,
+					"actions":{"is-cancelable":%t,"is-discardable":%t,"is-force-cancelable":%t}}}}`,
+					run.status, run.forceAt.Format(time.RFC3339Nano),
+					run.actions.IsCancelable, run.actions.IsDiscardable, run.actions.IsForceCancelable)
+			case r.Method == "POST" && strings.HasPrefix(r.URL.Path, "/api/v2/runs/run-1/actions/"):
+				action := strings.TrimPrefix(r.URL.Path, "/api/v2/runs/run-1/actions/")
+				run.calls = append(run.calls, action)
+				if status, ok := run.next[action]; ok {
+					run.status = status
+				}
+				// After a cancel, the run can only be force-canceled.
+				run.actions = RunActions{IsForceCancelable: action == "cancel"}
+				w.WriteHeader(http.StatusAccepted)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		t.Cleanup(srv.Close)
+
+		client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+		require.NoError(t, err)
+		return client
+	}
+
+	ctx := context.Background()
+	options := RunCancelOrDiscardOptions{Comment: String("superseded"), PollInterval: time.Millisecond}
+
+	t.Run("with a run awaiting confirmation", func(t *testing.T) {
+		run := &fakeRun{
+			status:  RunPlanned,
+			actions: RunActions{IsDiscardable: true, IsCancelable: true},
+			next:    map[string]RunStatus{"discard": RunDiscarded},
+		}
+		client := newServer(t, run)
+
+		r, err := client.Runs.CancelOrDiscardAndWait(ctx, "run-1", options)
+		require.NoError(t, err)
+		assert.Equal(t, RunDiscarded, r.Status)
+		assert.Equal(t, []string{"discard"}, run.calls)
+	})
+
+	t.Run("with a run that stops when canceled", func(t *testing.T) {
+		run := &fakeRun{
+			status:  RunPlanning,
+			actions: RunActions{IsCancelable: true},
+			next:    map[string]RunStatus{"cancel": RunCanceled},
+		}
+		client := newServer(t, run)
+
+		r, err := client.Runs.CancelOrDiscardAndWait(ctx, "run-1", options)
+		require.NoError(t, err)
+		assert.Equal(t, RunCanceled, r.Status)
+		assert.Equal(t, []string{"cancel"}, run.calls)
+	})
+
+	t.Run("with a run that must be force-canceled", func(t *testing.T) {
+		run := &fakeRun{
+			status:  RunApplying,
+			actions: RunActions{IsCancelable: true},
+			forceAt: time.Now().Add(50 * time.Millisecond),
+			next:    map[string]RunStatus{"force-cancel": RunCanceled},
+		}
+		client := newServer(t, run)
+
+		start := time.Now()
+		r, err := client.Runs.CancelOrDiscardAndWait(ctx, "run-1", options)
+		require.NoError(t, err)
+		assert.Equal(t, RunCanceled, r.Status)
+		assert.Equal(t, []string{"cancel", "force-cancel"}, run.calls)
+		assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+	})
+
+	t.Run("with an invalid run ID", func(t *testing.T) {
+		r, err := newServer(t, &fakeRun{}).Runs.CancelOrDiscardAndWait(ctx, badIdentifier, options)
+		assert.Nil(t, r)
+		assert.Equal(t, ErrInvalidRunID, err)
+	})
+}
+
+func TestRunsDiscardOlderThan(t *testing.T) {
+	t.Parallel()
+
+	// The fake API serves two pages of runs, newest first. run-3 changes
+	// status before it is discarded.
+	var mu sync.Mutex
+	var pages []string
+	var discarded []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch {
+		case r.URL.Path == "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == "GET" && r.URL.Path == "/api/v2/workspaces/ws-1/runs":
+			pages = append(pages, r.URL.Query().Get("page[number]"))
+			if r.URL.Query().Get("page[number]") != "2" {
+				_, _ = w.Write([]byte(`{"data":[
+					{"id":"run-1","type":"runs","attributes":{"status":"planned","created-at":"2024-06-01T00:00:00Z","actions":{"is-discardable":true}}},
+					{"id":"run-2","type":"runs","attributes":{"status":"planned","created-at":"2024-02-01T00:00:00Z","actions":{"is-discardable":true}}}
+				],"meta":{"pagination":{"current-page":1,"next-page":2,"total-pages":2,"total-count":4}}}`))
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":[
+				{"id":"run-3","type":"runs","attributes":{"status":"policy_override","created-at":"2024-01-15T00:00:00Z","actions":{"is-discardable":true}}},
+				{"id":"run-4","type":"runs","attributes":{"status":"planned","created-at":"2024-01-01T00:00:00Z","actions":{"is-discardable":false}}}
+			],"meta":{"pagination":{"current-page":2,"prev-page":1,"total-pages":2,"total-count":4}}}`))
+		case r.Method == "POST" && r.URL.Path == "/api/v2/runs/run-3/actions/discard":
+			w.WriteHeader(http.StatusConflict)
+		case r.Method == "POST" && strings.HasSuffix(r.URL.Path, "/actions/discard"):
+			discarded = append(discarded, strings.Split(r.URL.Path, "/")[4])
+			w.WriteHeader(http.StatusAccepted)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	before := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("listing old runs", func(t *testing.T) {
+		runs, err := client.Runs.ListOlderThan(ctx, "ws-1", before, nil)
+		require.NoError(t, err)
+
+		var ids []string
+		for _, r := range runs {
+			ids = append(ids, r.ID)
+		}
+		assert.Equal(t, []string{"run-2", "run-3", "run-4"}, ids)
+		assert.Equal(t, []string{"", "2"}, pages)
+	})
+
+	t.Run("discarding old runs", func(t *testing.T) {
+		runs, err := client.Runs.DiscardOlderThan(ctx, "ws-1", before, RunDiscardOptions{Comment: String("stale")})
+		require.NoError(t, err)
+		require.Len(t, runs, 1)
+		assert.Equal(t, "run-2", runs[0].ID)
+		assert.Equal(t, []string{"run-2"}, discarded)
+	})
+
+	t.Run("with an invalid workspace ID", func(t *testing.T) {
+		runs, err := client.Runs.ListOlderThan(ctx, badIdentifier, before, nil)
+		assert.Nil(t, runs)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
+func TestRunsReadDiagnostics(t *testing.T) {
+	t.Parallel()
+
+	logs := map[string]string{
+		"plan": `{"@level":"info","@message":"Terraform 1.9.0","type":"version"}
+Plain text is ignored
+{"@level":"warn","@message":"Warning: Deprecated attribute","type":"diagnostic","diagnostic":{"severity":"warning","summary":"Deprecated attribute","detail":"Use name instead.","address":"aws_instance.web","range":{"filename":"main.tf","start":{"line":3,"column":3,"byte":40},"end":{"line":3,"column":12,"byte":49}}}}
+`,
+		"apply": `{"@level":"error","@message":"Error: creating instance","type":"diagnostic","diagnostic":{"severity":"error","summary":"creating instance","detail":"quota exceeded"}}
+`,
+	}
+
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/runs/run-1":
+			assert.Equal(t, "plan,apply", r.URL.Query().Get("include"))
+			fmt.Fprint(w, `{"data":{"id":"run-1","type":"runs","attributes":{"status":"errored"},"relationships":{
+				"plan":{"data":{"id":"plan-1","type":"plans"}},
+				"apply":{"data":{"id":"apply-1","type":"applies"}}}},
+				"included":[
+					{"id":"plan-1","type":"plans","attributes":{"status":"finished"}},
+					{"id":"apply-1","type":"applies","attributes":{"status":"errored"}}]}`)
+		case "/api/v2/runs/run-2":
+			fmt.Fprint(w, `{"data":{"id":"run-2","type":"runs","attributes":{"status":"pending"},"relationships":{
+				"plan":{"data":{"id":"plan-2","type":"plans"}},
+				"apply":{"data":{"id":"apply-2","type":"applies"}}}},
+				"included":[
+					{"id":"plan-2","type":"plans","attributes":{"status":"pending"}},
+					{"id":"apply-2","type":"applies","attributes":{"status":"pending"}}]}`)
+		case "/api/v2/plans/plan-1":
+			fmt.Fprintf(w, `{"data":{"id":"plan-1","type":"plans","attributes":{"status":"finished","log-read-url":"%s/logs/plan"}}}`, srvURL)
+		case "/api/v2/applies/apply-1":
+			fmt.Fprintf(w, `{"data":{"id":"apply-1","type":"applies","attributes":{"status":"errored","log-read-url":"%s/logs/apply"}}}`, srvURL)
+		case "/logs/plan", "/logs/apply":
+			content := "\x02" + logs[strings.TrimPrefix(r.URL.Path, "/logs/")] + "\x03"
+			var offset int
+			_, _ = fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+			if offset < len(content) {
+				fmt.Fprint(w, content[offset:])
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	srvURL = srv.URL
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("of a run that errored while applying", func(t *testing.T) {
+		diags, err := client.Runs.ReadDiagnostics(ctx, "run-1")
+		require.NoError(t, err)
+		require.Len(t, diags, 2)
+
+		assert.Equal(t, "plan", diags[0].Stage)
+		assert.Equal(t, "warning", diags[0].Severity)
+		assert.Equal(t, "Deprecated attribute", diags[0].Summary)
+		assert.Equal(t, "aws_instance.web", diags[0].Address)
+		require.NotNil(t, diags[0].Range)
+		assert.Equal(t, "main.tf", diags[0].Range.Filename)
+		assert.Equal(t, 3, diags[0].Range.Start.Line)
+		assert.Equal(t, 12, diags[0].Range.End.Column)
+
+		assert.Equal(t, "apply", diags[1].Stage)
+		assert.Equal(t, "error", diags[1].Severity)
+		assert.Equal(t, "quota exceeded", diags[1].Detail)
+		assert.Nil(t, diags[1].Range)
+	})
+
+	t.Run("of a run that has not started", func(t *testing.T) {
+		diags, err := client.Runs.ReadDiagnostics(ctx, "run-2")
+		require.NoError(t, err)
+		assert.Empty(t, diags)
+	})
+
+	t.Run("without a valid run ID", func(t *testing.T) {
+		_, err := client.Runs.ReadDiagnostics(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidRunID, err)
+	})
+}
+
+func TestRunStatus_Predicates(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, RunApplied.IsTerminal())
+	assert.True(t, RunPlannedAndSaved.IsTerminal())
+	assert.False(t, RunApplied.IsCancelable())
+
+	assert.True(t, RunPostPlanRunning.IsCancelable())
+	assert.False(t, RunPostPlanRunning.IsTerminal())
+
+	assert.True(t, RunPostPlanAwaitingDecision.IsAwaitingDecision())
+	assert.False(t, RunPostPlanAwaitingDecision.IsCancelable())
+
+	t.Run("with an unknown status", func(t *testing.T) {
+		status := RunStatus("new_status")
+		assert.False(t, status.IsKnown())
+		assert.False(t, status.IsTerminal())
+		assert.False(t, status.IsCancelable())
+		assert.False(t, status.IsAwaitingDecision())
+	})
+
+	t.Run("every run status is classified", func(t *testing.T) {
+		// Parse the RunStatus constants, so a status added without being
+		// classified fails this test.
+		f, err := parser.ParseFile(token.NewFileSet(), "run.go", nil, 0)
+		require.NoError(t, err)
+
+		var declared []RunStatus
+		for _, decl := range f.Decls {
+			gen, ok := decl.(*ast.GenDecl)
+			if !ok || gen.Tok != token.CONST {
+				continue
+			}
+			for _, spec := range gen.Specs {
+				vs := spec.(*ast.ValueSpec)
+				if ident, ok := vs.Type.(*ast.Ident); !ok || ident.Name != "RunStatus" {
+					continue
+				}
+				for _, value := range vs.Values {
+					lit := value.(*ast.BasicLit)
+					status, err := strconv.Unquote(lit.Value)
+					require.NoError(t, err)
+					declared = append(declared, RunStatus(status))
+				}
+			}
+		}
+
+		require.NotEmpty(t, declared)
+		assert.ElementsMatch(t, declared, RunStatuses())
+	})
+}
+
+func TestPlanAndApplyStatus_IsTerminal(t *testing.T) {
+	t.Parallel()
+
+	assert.True(t, PlanFinished.IsTerminal())
+	assert.True(t, PlanUnreachable.IsTerminal())
+	assert.False(t, PlanMFAWaiting.IsTerminal())
+
+	assert.True(t, ApplyErrored.IsTerminal())
+	assert.False(t, ApplyRunning.IsTerminal())
+}
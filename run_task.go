@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
+	"time"
 )
 
 // Compile-time proof of interface implementation
@@ -34,8 +36,20 @@ type RunTasks interface {
 	// Delete an organization's run task
 	Delete(ctx context.Context, runTaskID string) error
 
+	// ReadGlobalConfiguration reads the global configuration of a run task,
+	// which attaches it to all the workspaces of its organization.
+	ReadGlobalConfiguration(ctx context.Context, runTaskID string) (*GlobalRunTask, error)
+
+	// UpdateGlobalConfiguration updates the global configuration of a run
+	// task.
+	UpdateGlobalConfiguration(ctx context.Context, runTaskID string, options GlobalRunTaskOptions) (*GlobalRunTask, error)
+
 	// Attach a run task to an organization's workspace
 	AttachToWorkspace(ctx context.Context, workspaceID string, runTaskID string, enforcementLevel TaskEnforcementLevel) (*WorkspaceRunTask, error)
+
+	// RecentExecutions lists the executions of a run task, across the
+	// workspaces it is attached to, for the runs created within the window.
+	RecentExecutions(ctx context.Context, runTaskID string, window time.Duration) ([]*RunTaskExecution, error)
 }
 
 // runTasks implements RunTasks
@@ -65,6 +79,26 @@ type GlobalRunTask struct {
 	EnforcementLevel TaskEnforcementLevel `jsonapi:"attr,enforcement-level"`
 }
 
+// RunTaskExecution represents a single execution of a run task, as reported
+// by its task result.
+type RunTaskExecution struct {
+	TaskResult *TaskResult
+	Run        *Run
+	Workspace  *Workspace
+	Stage      Stage
+	Status     TaskResultStatus
+
+	// StartedAt is when the task started running, or when the result was
+	// created if it never ran.
+	StartedAt time.Time
+	// FinishedAt is when the task reached a final status. It is zero while
+	// the task is pending or running.
+	FinishedAt time.Time
+	// Duration is the time between StartedAt and FinishedAt. It is zero
+	// while the task is pending or running.
+	Duration time.Duration
+}
+
 // RunTaskList represents a list of run tasks
 type RunTaskList struct {
 	*Pagination
@@ -78,6 +112,10 @@ type RunTaskIncludeOpt string
 const (
 	RunTaskWorkspaceTasks RunTaskIncludeOpt = "workspace_tasks"
 	RunTaskWorkspace      RunTaskIncludeOpt = "workspace_tasks.workspace"
+
+	// RunTaskGlobalConfiguration includes the global configuration of the
+	// run task, when the API reports it as a relationship.
+	RunTaskGlobalConfiguration RunTaskIncludeOpt = "global_configuration"
 )
 
 // RunTaskListOptions represents the set of options for listing run tasks
@@ -86,6 +124,9 @@ type RunTaskListOptions struct {
 	// Optional: A list of relations to include with a run task. See available resources:
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run-tasks/run-tasks#list-run-tasks
 	Include []RunTaskIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // RunTaskReadOptions represents the set of options for reading a run task
@@ -93,6 +134,9 @@ type RunTaskReadOptions struct {
 	// Optional: A list of relations to include with a run task. See available resources:
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/run-tasks/run-tasks#list-run-tasks
 	Include []RunTaskIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // GlobalRunTask represents the optional global configuration of a HCP Terraform or Terraform Enterprise run task
@@ -265,6 +309,37 @@ func (s *runTasks) Update(ctx context.Context, runTaskID string, options RunTask
 	return r.ToRunTask(), nil
 }
 
+// ReadGlobalConfiguration reads the global configuration of a run task. A run
+// task without a global configuration is reported as disabled.
+func (s *runTasks) ReadGlobalConfiguration(ctx context.Context, runTaskID string) (*GlobalRunTask, error) {
+	rt, err := s.ReadWithOptions(ctx, runTaskID, &RunTaskReadOptions{
+		Include: []RunTaskIncludeOpt{RunTaskGlobalConfiguration},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if rt.Global == nil {
+		return &GlobalRunTask{}, nil
+	}
+	return rt.Global, nil
+}
+
+// UpdateGlobalConfiguration updates the global configuration of a run task,
+// leaving its other settings unchanged. Global configuration requires the
+// global run tasks entitlement.
+func (s *runTasks) UpdateGlobalConfiguration(ctx context.Context, runTaskID string, options GlobalRunTaskOptions) (*GlobalRunTask, error) {
+	rt, err := s.Update(ctx, runTaskID, RunTaskUpdateOptions{Global: &options})
+	if err != nil {
+		return nil, err
+	}
+
+	if rt.Global == nil {
+		return &GlobalRunTask{}, nil
+	}
+	return rt.Global, nil
+}
+
 // Delete an existing run task for an organization by ID
 func (s *runTasks) Delete(ctx context.Context, runTaskID string) error {
 	if !validStringID(&runTaskID) {
@@ -288,6 +363,148 @@ func (s *runTasks) AttachToWorkspace(ctx context.Context, workspaceID, runTaskID
 	})
 }
 
+// RecentExecutions lists the executions of a run task for the runs created
+// within the window, newest first. It reads the runs of every workspace the
+// task is attached to and their task stages, so it makes at least one request
+// per workspace and per recent run; workspaces using the task only through its
+// global configuration are not included.
+func (s *runTasks) RecentExecutions(ctx context.Context, runTaskID string, window time.Duration) ([]*RunTaskExecution, error) {
+	if !validStringID(&runTaskID) {
+		return nil, ErrInvalidRunTaskID
+	}
+	if window <= 0 {
+		return nil, ErrInvalidRunTaskWindow
+	}
+
+	rt, err := s.ReadWithOptions(ctx, runTaskID, &RunTaskReadOptions{
+		Include: []RunTaskIncludeOpt{RunTaskWorkspaceTasks},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	since := time.Now().Add(-window)
+
+	var executions []*RunTaskExecution
+	for _, wrt := range rt.WorkspaceRunTasks {
+		if wrt == nil || wrt.Workspace == nil {
+			continue
+		}
+
+		runs, err := s.recentRuns(ctx, wrt.Workspace.ID, since)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, r := range runs {
+			stages, err := s.taskStages(ctx, r.ID)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, ts := range stages {
+				for _, tr := range ts.TaskResults {
+					// Task stages only reference their results, so read
+					// the ones that were not included in full.
+					if tr.TaskID == "" {
+						tr, err = s.client.TaskResults.Read(ctx, tr.ID)
+						if err != nil {
+							return nil, err
+						}
+					}
+					if tr.TaskID != runTaskID {
+						continue
+					}
+					executions = append(executions, newRunTaskExecution(tr, r, wrt.Workspace, ts.Stage))
+				}
+			}
+		}
+	}
+
+	sort.SliceStable(executions, func(i, j int) bool {
+		return executions[i].StartedAt.After(executions[j].StartedAt)
+	})
+
+	return executions, nil
+}
+
+// recentRuns returns the runs of the workspace created after since. Runs are
+// listed newest first, so listing stops at the first older run.
+func (s *runTasks) recentRuns(ctx context.Context, workspaceID string, since time.Time) ([]*Run, error) {
+	options := &RunListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+	}
+
+	var runs []*Run
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		rl, err := s.client.Runs.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range rl.Items {
+			if r.CreatedAt.Before(since) {
+				return nil, nil
+			}
+			runs = append(runs, r)
+		}
+		return rl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return runs, nil
+}
+
+// taskStages returns all the task stages of the run.
+func (s *runTasks) taskStages(ctx context.Context, runID string) ([]*TaskStage, error) {
+	options := &TaskStageListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+	}
+
+	var stages []*TaskStage
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		tsl, err := s.client.TaskStages.List(ctx, runID, options)
+		if err != nil {
+			return nil, err
+		}
+		stages = append(stages, tsl.Items...)
+		return tsl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return stages, nil
+}
+
+func newRunTaskExecution(tr *TaskResult, r *Run, w *Workspace, stage Stage) *RunTaskExecution {
+	e := &RunTaskExecution{
+		TaskResult: tr,
+		Run:        r,
+		Workspace:  w,
+		Stage:      stage,
+		Status:     tr.Status,
+		StartedAt:  tr.StatusTimestamps.RunningAt,
+	}
+	if e.StartedAt.IsZero() {
+		e.StartedAt = tr.CreatedAt
+	}
+
+	ts := tr.StatusTimestamps
+	for _, t := range []time.Time{ts.PassedAt, ts.FailedAt, ts.ErroredAt, ts.CanceledAt} {
+		if !t.IsZero() {
+			e.FinishedAt = t
+			break
+		}
+	}
+	if !e.FinishedAt.IsZero() && e.FinishedAt.After(e.StartedAt) {
+		e.Duration = e.FinishedAt.Sub(e.StartedAt)
+	}
+
+	return e
+}
+
 func (o *RunTaskCreateOptions) valid() error {
 	if !validString(&o.Name) {
 		return ErrRequiredName
@@ -301,6 +518,10 @@ func (o *RunTaskCreateOptions) valid() error {
 		return ErrInvalidRunTaskCategory
 	}
 
+	if o.Global != nil {
+		return o.Global.valid()
+	}
+
 	return nil
 }
 
@@ -317,6 +538,32 @@ func (o *RunTaskUpdateOptions) valid() error {
 		return ErrInvalidRunTaskCategory
 	}
 
+	if o.Global != nil {
+		return o.Global.valid()
+	}
+
+	return nil
+}
+
+func (o *GlobalRunTaskOptions) valid() error {
+	if o.Stages != nil {
+		for _, stage := range *o.Stages {
+			switch stage {
+			case PrePlan, PostPlan, PreApply, PostApply:
+			default:
+				return ErrInvalidRunTaskStage
+			}
+		}
+	}
+
+	if o.EnforcementLevel != nil {
+		switch *o.EnforcementLevel {
+		case Advisory, Mandatory:
+		default:
+			return ErrInvalidRunTaskEnforcementLevel
+		}
+	}
+
 	return nil
 }
 
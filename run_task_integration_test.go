@@ -8,6 +8,7 @@ import (
 	"errors"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -321,3 +322,127 @@ func TestRunTasksAttachToWorkspace(t *testing.T) {
 		require.NotNil(t, wr.ID)
 	})
 }
+
+func TestRunTasksRecentExecutions(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	ts := func(d time.Duration) string { return now.Add(-d).Format(time.RFC3339) }
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/tasks/task-1": `{"data":{"id":"task-1","type":"tasks","relationships":{"workspace-tasks":{"data":[{"id":"wstask-1","type":"workspace-tasks"}]}}},
+			"included":[{"id":"wstask-1","type":"workspace-tasks","relationships":{"workspace":{"data":{"id":"ws-1","type":"workspaces"}}}}]}`,
+		"GET /api/v2/workspaces/ws-1/runs": `{"data":[
+			{"id":"run-new","type":"runs","attributes":{"created-at":"` + ts(time.Hour) + `"}},
+			{"id":"run-old","type":"runs","attributes":{"created-at":"` + ts(48*time.Hour) + `"}}
+		]}`,
+		"GET /api/v2/runs/run-new/task-stages": `{"data":[{"id":"ts-1","type":"task-stages","attributes":{"stage":"post_plan"},
+			"relationships":{"task-results":{"data":[{"id":"taskrs-1","type":"task-results"},{"id":"taskrs-2","type":"task-results"}]}}}]}`,
+		"GET /api/v2/task-results/taskrs-1": `{"data":{"id":"taskrs-1","type":"task-results","attributes":{"status":"passed","task-id":"task-1",
+			"status-timestamps":{"running-at":"` + ts(time.Hour) + `","passed-at":"` + ts(time.Hour-30*time.Second) + `"}}}}`,
+		"GET /api/v2/task-results/taskrs-2": `{"data":{"id":"taskrs-2","type":"task-results","attributes":{"status":"running","task-id":"task-2"}}}`,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("within the window", func(t *testing.T) {
+		executions, err := client.RunTasks.RecentExecutions(ctx, "task-1", 24*time.Hour)
+		require.NoError(t, err)
+		require.Len(t, executions, 1)
+
+		e := executions[0]
+		assert.Equal(t, "taskrs-1", e.TaskResult.ID)
+		assert.Equal(t, "run-new", e.Run.ID)
+		assert.Equal(t, "ws-1", e.Workspace.ID)
+		assert.Equal(t, PostPlan, e.Stage)
+		assert.Equal(t, TaskPassed, e.Status)
+		assert.Equal(t, 30*time.Second, e.Duration)
+	})
+
+	t.Run("when no run is within the window", func(t *testing.T) {
+		executions, err := client.RunTasks.RecentExecutions(ctx, "task-1", time.Minute)
+		require.NoError(t, err)
+		assert.Empty(t, executions)
+	})
+
+	t.Run("with invalid window", func(t *testing.T) {
+		executions, err := client.RunTasks.RecentExecutions(ctx, "task-1", 0)
+		assert.Nil(t, executions)
+		assert.Equal(t, ErrInvalidRunTaskWindow, err)
+	})
+
+	t.Run("with invalid run task ID", func(t *testing.T) {
+		executions, err := client.RunTasks.RecentExecutions(ctx, badIdentifier, time.Hour)
+		assert.Nil(t, executions)
+		assert.Equal(t, ErrInvalidRunTaskID, err)
+	})
+}
+
+func TestRunTasksGlobalConfiguration(t *testing.T) {
+	t.Parallel()
+
+	attribute := `{"data":{"id":"task-1","type":"tasks","attributes":{"name":"scan",
+		"global-configuration":{"enabled":true,"stages":["pre_plan","post_plan"],"enforcement-level":"mandatory"}}}}`
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/tasks/task-1":   attribute,
+		"PATCH /api/v2/tasks/task-1": attribute,
+		"GET /api/v2/tasks/task-2": `{"data":{"id":"task-2","type":"tasks","attributes":{"name":"cost"},
+			"relationships":{"global-configuration":{"data":{"id":"grt-1","type":"global-run-tasks"}}}},
+			"included":[{"id":"grt-1","type":"global-run-tasks","attributes":{"enabled":true,"stages":["post_apply"],"enforcement-level":"advisory"}}]}`,
+		"GET /api/v2/tasks/task-3": `{"data":{"id":"task-3","type":"tasks","attributes":{"name":"lint"}}}`,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("as an attribute", func(t *testing.T) {
+		g, err := client.RunTasks.ReadGlobalConfiguration(ctx, "task-1")
+		require.NoError(t, err)
+		assert.True(t, g.Enabled)
+		assert.Equal(t, []Stage{PrePlan, PostPlan}, g.Stages)
+		assert.Equal(t, Mandatory, g.EnforcementLevel)
+	})
+
+	t.Run("as an included relationship", func(t *testing.T) {
+		g, err := client.RunTasks.ReadGlobalConfiguration(ctx, "task-2")
+		require.NoError(t, err)
+		assert.True(t, g.Enabled)
+		assert.Equal(t, []Stage{PostApply}, g.Stages)
+		assert.Equal(t, Advisory, g.EnforcementLevel)
+	})
+
+	t.Run("without a global configuration", func(t *testing.T) {
+		g, err := client.RunTasks.ReadGlobalConfiguration(ctx, "task-3")
+		require.NoError(t, err)
+		assert.False(t, g.Enabled)
+		assert.Empty(t, g.Stages)
+	})
+
+	t.Run("when updating", func(t *testing.T) {
+		enforcement := Mandatory
+		g, err := client.RunTasks.UpdateGlobalConfiguration(ctx, "task-1", GlobalRunTaskOptions{
+			Enabled:          Bool(true),
+			Stages:           &[]Stage{PrePlan, PostPlan},
+			EnforcementLevel: &enforcement,
+		})
+		require.NoError(t, err)
+		assert.True(t, g.Enabled)
+		assert.Equal(t, Mandatory, g.EnforcementLevel)
+	})
+
+	t.Run("with invalid options", func(t *testing.T) {
+		_, err := client.RunTasks.UpdateGlobalConfiguration(ctx, "task-1", GlobalRunTaskOptions{
+			Stages: &[]Stage{"pre_destroy"},
+		})
+		assert.Equal(t, ErrInvalidRunTaskStage, err)
+
+		blocking := TaskEnforcementLevel("blocking")
+		_, err = client.RunTasks.UpdateGlobalConfiguration(ctx, "task-1", GlobalRunTaskOptions{
+			EnforcementLevel: &blocking,
+		})
+		assert.Equal(t, ErrInvalidRunTaskEnforcementLevel, err)
+
+		_, err = client.RunTasks.ReadGlobalConfiguration(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidRunTaskID, err)
+	})
+}
@@ -22,9 +22,21 @@ type RunTriggers interface {
 	// List all the run triggers within a workspace.
 	List(ctx context.Context, workspaceID string, options *RunTriggerListOptions) (*RunTriggerList, error)
 
+	// ListInbound lists all the run triggers that create runs in the
+	// workspace, following pagination.
+	ListInbound(ctx context.Context, workspaceID string, options *RunTriggerListInboundOptions) ([]*RunTrigger, error)
+
+	// ListOutbound lists all the run triggers that create runs in other
+	// workspaces when the workspace is applied, following pagination.
+	ListOutbound(ctx context.Context, workspaceID string) ([]*RunTrigger, error)
+
 	// Create a new run trigger with the given options.
 	Create(ctx context.Context, workspaceID string, options RunTriggerCreateOptions) (*RunTrigger, error)
 
+	// BulkCreate concurrently creates a run trigger in the workspace for each
+	// of the given sources and returns the outcome for every source.
+	BulkCreate(ctx context.Context, workspaceID string, options RunTriggerBulkCreateOptions) ([]*RunTriggerCreateResult, error)
+
 	// Read a run trigger by its ID.
 	Read(ctx context.Context, RunTriggerID string) (*RunTrigger, error)
 
@@ -87,6 +99,14 @@ type RunTriggerListOptions struct {
 	Include        []RunTriggerIncludeOpt `url:"include,omitempty"`         // optional
 }
 
+// RunTriggerListInboundOptions represents the options for listing the inbound
+// run triggers of a workspace.
+type RunTriggerListInboundOptions struct {
+	// Optional: A list of relations to include, e.g. RunTriggerSourceable to
+	// expand the source workspaces.
+	Include []RunTriggerIncludeOpt
+}
+
 // RunTriggerCreateOptions represents the options for
 // creating a new run trigger.
 type RunTriggerCreateOptions struct {
@@ -100,6 +120,29 @@ type RunTriggerCreateOptions struct {
 	Sourceable *Workspace `jsonapi:"relation,sourceable"`
 }
 
+// RunTriggerBulkCreateOptions represents the options for creating run
+// triggers from many source workspaces at once.
+type RunTriggerBulkCreateOptions struct {
+	// Required: The source workspaces.
+	Sourceables []*Workspace
+
+	// Optional: The maximum number of run triggers to create concurrently.
+	// Defaults to 1.
+	Concurrency int
+}
+
+// RunTriggerCreateResult represents the outcome of creating the run trigger
+// of a single source workspace with BulkCreate.
+type RunTriggerCreateResult struct {
+	Sourceable *Workspace
+
+	// RunTrigger is the created run trigger. It is nil when Err is set.
+	RunTrigger *RunTrigger
+
+	// Err is the error returned while creating the run trigger, if any.
+	Err error
+}
+
 // List all the run triggers associated with a workspace.
 func (s *runTriggers) List(ctx context.Context, workspaceID string, options *RunTriggerListOptions) (*RunTriggerList, error) {
 	if !validStringID(&workspaceID) {
@@ -128,6 +171,45 @@ func (s *runTriggers) List(ctx context.Context, workspaceID string, options *Run
 	return rtl, nil
 }
 
+// ListInbound lists all the inbound run triggers of a workspace.
+func (s *runTriggers) ListInbound(ctx context.Context, workspaceID string, options *RunTriggerListInboundOptions) ([]*RunTrigger, error) {
+	listOpts := &RunTriggerListOptions{
+		ListOptions:    ListOptions{PageSize: 100},
+		RunTriggerType: RunTriggerInbound,
+	}
+	if options != nil {
+		listOpts.Include = options.Include
+	}
+
+	return s.listAll(ctx, workspaceID, listOpts)
+}
+
+// ListOutbound lists all the outbound run triggers of a workspace. The API
+// does not support including related resources for outbound run triggers.
+func (s *runTriggers) ListOutbound(ctx context.Context, workspaceID string) ([]*RunTrigger, error) {
+	return s.listAll(ctx, workspaceID, &RunTriggerListOptions{
+		ListOptions:    ListOptions{PageSize: 100},
+		RunTriggerType: RunTriggerOutbound,
+	})
+}
+
+func (s *runTriggers) listAll(ctx context.Context, workspaceID string, options *RunTriggerListOptions) ([]*RunTrigger, error) {
+	var runTriggers []*RunTrigger
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		rtl, err := s.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+		runTriggers = append(runTriggers, rtl.Items...)
+		return rtl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return runTriggers, nil
+}
+
 // Create a run trigger with the given options.
 func (s *runTriggers) Create(ctx context.Context, workspaceID string, options RunTriggerCreateOptions) (*RunTrigger, error) {
 	if !validStringID(&workspaceID) {
@@ -154,6 +236,32 @@ func (s *runTriggers) Create(ctx context.Context, workspaceID string, options Ru
 	return rt, nil
 }
 
+// BulkCreate creates a run trigger in the workspace for each source workspace.
+// The run triggers are created concurrently up to options.Concurrency and a
+// failed source does not stop the others. Results are returned in the same
+// order as options.Sourceables.
+func (s *runTriggers) BulkCreate(ctx context.Context, workspaceID string, options RunTriggerBulkCreateOptions) ([]*RunTriggerCreateResult, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	results := make([]*RunTriggerCreateResult, len(options.Sourceables))
+	for i, sourceable := range options.Sourceables {
+		results[i] = &RunTriggerCreateResult{Sourceable: sourceable}
+	}
+
+	forEachConcurrently(len(results), options.Concurrency, func(i int) {
+		results[i].RunTrigger, results[i].Err = s.Create(ctx, workspaceID, RunTriggerCreateOptions{
+			Sourceable: results[i].Sourceable,
+		})
+	})
+
+	return results, nil
+}
+
 // Read a run trigger by its ID.
 func (s *runTriggers) Read(ctx context.Context, runTriggerID string) (*RunTrigger, error) {
 	if !validStringID(&runTriggerID) {
@@ -199,6 +307,18 @@ func (o RunTriggerCreateOptions) valid() error {
 	return nil
 }
 
+func (o RunTriggerBulkCreateOptions) valid() error {
+	if len(o.Sourceables) == 0 {
+		return ErrRequiredSourceable
+	}
+	for _, sourceable := range o.Sourceables {
+		if sourceable == nil {
+			return ErrRequiredSourceable
+		}
+	}
+	return nil
+}
+
 func (o *RunTriggerListOptions) valid() error {
 	if o == nil {
 		return ErrRequiredRunTriggerListOps
@@ -145,6 +145,31 @@ func TestRunTriggerList(t *testing.T) {
 		)
 		assert.Equal(t, err, ErrUnsupportedRunTriggerType)
 	})
+
+	t.Run("with ListInbound", func(t *testing.T) {
+		rts, err := client.RunTriggers.ListInbound(ctx, wTest.ID, &RunTriggerListInboundOptions{
+			Include: []RunTriggerIncludeOpt{RunTriggerSourceable},
+		})
+		require.NoError(t, err)
+		require.Len(t, rts, 2)
+
+		names := []string{rts[0].Sourceable.Name, rts[1].Sourceable.Name}
+		assert.Contains(t, names, sourceable1Test.Name)
+		assert.Contains(t, names, sourceable2Test.Name)
+	})
+
+	t.Run("with ListOutbound", func(t *testing.T) {
+		rts, err := client.RunTriggers.ListOutbound(ctx, sourceable1Test.ID)
+		require.NoError(t, err)
+		require.Len(t, rts, 1)
+		assert.Equal(t, rtTest1.ID, rts[0].ID)
+	})
+
+	t.Run("with ListInbound and an invalid workspace", func(t *testing.T) {
+		rts, err := client.RunTriggers.ListInbound(ctx, badIdentifier, nil)
+		assert.Nil(t, rts)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
 }
 
 func TestRunTriggerCreate(t *testing.T) {
@@ -197,6 +222,62 @@ func TestRunTriggerCreate(t *testing.T) {
 	})
 }
 
+func TestRunTriggerBulkCreate(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	defer wTestCleanup()
+
+	sourceable1Test, sourceable1TestCleanup := createWorkspace(t, client, orgTest)
+	defer sourceable1TestCleanup()
+
+	sourceable2Test, sourceable2TestCleanup := createWorkspace(t, client, orgTest)
+	defer sourceable2TestCleanup()
+
+	t.Run("with a failing source", func(t *testing.T) {
+		// Setting the workspace as its own source is rejected by the API and
+		// must not prevent the other run triggers from being created.
+		results, err := client.RunTriggers.BulkCreate(ctx, wTest.ID, RunTriggerBulkCreateOptions{
+			Sourceables: []*Workspace{sourceable1Test, wTest, sourceable2Test},
+			Concurrency: 2,
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		assert.NoError(t, results[0].Err)
+		assert.Equal(t, sourceable1Test.ID, results[0].Sourceable.ID)
+		require.NotNil(t, results[0].RunTrigger)
+
+		assert.Error(t, results[1].Err)
+		assert.Nil(t, results[1].RunTrigger)
+
+		assert.NoError(t, results[2].Err)
+		require.NotNil(t, results[2].RunTrigger)
+
+		rts, err := client.RunTriggers.ListInbound(ctx, wTest.ID, nil)
+		require.NoError(t, err)
+		assert.Len(t, rts, 2)
+	})
+
+	t.Run("without sources", func(t *testing.T) {
+		results, err := client.RunTriggers.BulkCreate(ctx, wTest.ID, RunTriggerBulkCreateOptions{})
+		assert.Nil(t, results)
+		assert.Equal(t, err, ErrRequiredSourceable)
+	})
+
+	t.Run("without a valid workspace", func(t *testing.T) {
+		results, err := client.RunTriggers.BulkCreate(ctx, badIdentifier, RunTriggerBulkCreateOptions{
+			Sourceables: []*Workspace{sourceable1Test},
+		})
+		assert.Nil(t, results)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
 func TestRunTriggerRead(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
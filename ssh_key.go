@@ -27,7 +27,7 @@ type SSHKeys interface {
 	// Read an SSH key by its ID.
 	Read(ctx context.Context, sshKeyID string) (*SSHKey, error)
 
-	// Update an SSH key by its ID.
+	// Update an SSH key by its ID, renaming it or replacing its value.
 	Update(ctx context.Context, sshKeyID string, options SSHKeyUpdateOptions) (*SSHKey, error)
 
 	// Delete an SSH key by its ID.
@@ -49,6 +49,10 @@ type SSHKeyList struct {
 type SSHKey struct {
 	ID   string `jsonapi:"primary,ssh-keys"`
 	Name string `jsonapi:"attr,name"`
+
+	// The fingerprint of the key, which identifies the installed key without
+	// exposing its value.
+	Fingerprint string `jsonapi:"attr,fingerprint"`
 }
 
 // SSHKeyListOptions represents the options for listing SSH keys.
@@ -78,6 +82,10 @@ type SSHKeyUpdateOptions struct {
 
 	// Optional: A new name to identify the SSH key.
 	Name *string `jsonapi:"attr,name,omitempty"`
+
+	// Optional: The content of a new SSH private key, replacing the current
+	// one. The fingerprint of the returned SSH key reflects the new key.
+	Value *string `jsonapi:"attr,value,omitempty"`
 }
 
 // List all the SSH keys for a given organization
@@ -147,12 +155,17 @@ func (s *sshKeys) Read(ctx context.Context, sshKeyID string) (*SSHKey, error) {
 	return k, nil
 }
 
-// Update an SSH key by its ID.
+// Update an SSH key by its ID. Setting options.Value rotates the key in
+// place, so the workspaces using it pick up the new key on their next run.
 func (s *sshKeys) Update(ctx context.Context, sshKeyID string, options SSHKeyUpdateOptions) (*SSHKey, error) {
 	if !validStringID(&sshKeyID) {
 		return nil, ErrInvalidSHHKeyID
 	}
 
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("ssh-keys/%s", url.PathEscape(sshKeyID))
 	req, err := s.client.NewRequest("PATCH", u, &options)
 	if err != nil {
@@ -192,3 +205,10 @@ func (o SSHKeyCreateOptions) valid() error {
 	}
 	return nil
 }
+
+func (o SSHKeyUpdateOptions) valid() error {
+	if o.Value != nil && !validString(o.Value) {
+		return ErrRequiredValue
+	}
+	return nil
+}
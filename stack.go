@@ -171,10 +171,16 @@ type StackListOptions struct {
 	Sort         StackSortColumn   `url:"sort,omitempty"`
 	SearchByName string            `url:"search[name],omitempty"`
 	Include      []StackIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 type StackReadOptions struct {
 	Include []StackIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // StackCreateOptions represents the options for creating a stack. The project
@@ -207,9 +213,6 @@ type WaitForStatusResult struct {
 	Quit         bool
 }
 
-const minimumPollingIntervalMs = 3000
-const maximumPollingIntervalMs = 5000
-
 // UpdateConfiguration updates the configuration of a stack, triggering stack operations
 func (s *stacks) UpdateConfiguration(ctx context.Context, stackID string) (*Stack, error) {
 	req, err := s.client.NewRequest("POST", fmt.Sprintf("stacks/%s/actions/update-configuration", url.PathEscape(stackID)), nil)
@@ -347,7 +350,9 @@ func (s StackVCSRepoOptions) valid() error {
 // current status, or an error. For each time the status changes, the channel
 // emits a new result. The id parameter should be the ID of the resource being
 // polled, which is used in the result to help identify the resource being polled.
-func awaitPoll(ctx context.Context, id string, reader func(ctx context.Context) (string, error), quitStatus []string) <-chan WaitForStatusResult {
+// The reads are spaced by the waits of b and bypass the read cache, so every
+// read returns the current status.
+func awaitPoll(ctx context.Context, id string, b Backoff, reader func(ctx context.Context) (string, error), quitStatus []string) <-chan WaitForStatusResult {
 	resultCh := make(chan WaitForStatusResult)
 
 	mapStatus := make(map[string]struct{}, len(quitStatus))
@@ -361,36 +366,35 @@ func awaitPoll(ctx context.Context, id string, reader func(ctx context.Context)
 		reads := 0
 		lastStatus := ""
 		for {
-			select {
-			case <-ctx.Done():
-				resultCh <- WaitForStatusResult{ID: id, Error: fmt.Errorf("context canceled: %w", ctx.Err())}
+			if err := b.Wait(ctx, reads, nil); err != nil {
+				resultCh <- WaitForStatusResult{ID: id, Error: fmt.Errorf("context canceled: %w", err)}
 				return
-			case <-time.After(backoff(minimumPollingIntervalMs, maximumPollingIntervalMs, reads)):
-				status, err := reader(ctx)
-				if err != nil {
-					resultCh <- WaitForStatusResult{ID: id, Error: err, Quit: true}
-					return
-				}
-
-				_, terminal := mapStatus[status]
+			}
 
-				if status != lastStatus {
-					resultCh <- WaitForStatusResult{
-						ID:           id,
-						Status:       status,
-						ReadAttempts: reads + 1,
-						Quit:         terminal,
-					}
-				}
+			status, err := reader(ContextWithoutCache(ctx))
+			if err != nil {
+				resultCh <- WaitForStatusResult{ID: id, Error: err, Quit: true}
+				return
+			}
 
-				lastStatus = status
+			_, terminal := mapStatus[status]
 
-				if terminal {
-					return
+			if status != lastStatus {
+				resultCh <- WaitForStatusResult{
+					ID:           id,
+					Status:       status,
+					ReadAttempts: reads + 1,
+					Quit:         terminal,
 				}
+			}
 
-				reads += 1
+			lastStatus = status
+
+			if terminal {
+				return
 			}
+
+			reads += 1
 		}
 	}()
 
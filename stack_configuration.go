@@ -97,7 +97,7 @@ func (s stackConfigurations) JSONSchemas(ctx context.Context, stackConfiguration
 // read will be retried dependending on the configuration of the client. When the channel is closed,
 // the last value will either be a completed status or an error.
 func (s stackConfigurations) AwaitCompleted(ctx context.Context, stackConfigurationID string) <-chan WaitForStatusResult {
-	return awaitPoll(ctx, stackConfigurationID, func(ctx context.Context) (string, error) {
+	return awaitPoll(ctx, stackConfigurationID, s.client.pollingBackoff(pollBackoff), func(ctx context.Context) (string, error) {
 		stackConfiguration, err := s.Read(ctx, stackConfigurationID)
 		if err != nil {
 			return "", err
@@ -112,7 +112,7 @@ func (s stackConfigurations) AwaitCompleted(ctx context.Context, stackConfigurat
 // read will be retried dependending on the configuration of the client. When the channel is closed,
 // the last value will either be the specified status, "errored" status, or "canceled" status, or an error.
 func (s stackConfigurations) AwaitStatus(ctx context.Context, stackConfigurationID string, status StackConfigurationStatus) <-chan WaitForStatusResult {
-	return awaitPoll(ctx, stackConfigurationID, func(ctx context.Context) (string, error) {
+	return awaitPoll(ctx, stackConfigurationID, s.client.pollingBackoff(pollBackoff), func(ctx context.Context) (string, error) {
 		stackConfiguration, err := s.Read(ctx, stackConfigurationID)
 		if err != nil {
 			return "", err
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"time"
+)
+
+// StackDeploymentGroups describes all the stack deployment group related
+// methods that the HCP Terraform API supports. A deployment group is the set
+// of deployments of a stack configuration that are planned and applied
+// together.
+//
+// **Note: These methods are still in BETA and subject to change.**
+type StackDeploymentGroups interface {
+	// List returns the deployment groups of a stack configuration.
+	List(ctx context.Context, stackConfigurationID string, options *StackDeploymentGroupListOptions) (*StackDeploymentGroupList, error)
+
+	// Read returns a stack deployment group by its ID.
+	Read(ctx context.Context, stackDeploymentGroupID string) (*StackDeploymentGroup, error)
+
+	// ApproveAllPlans approves all the pending plans of a stack deployment
+	// group.
+	ApproveAllPlans(ctx context.Context, stackDeploymentGroupID string) error
+
+	// Rerun plans the given deployments of a stack deployment group again,
+	// to converge them with their stack configuration.
+	Rerun(ctx context.Context, stackDeploymentGroupID string, options StackDeploymentGroupRerunOptions) error
+}
+
+type stackDeploymentGroups struct {
+	client *Client
+}
+
+var _ StackDeploymentGroups = &stackDeploymentGroups{}
+
+// StackDeploymentGroupStatus represents the status of a stack deployment
+// group.
+type StackDeploymentGroupStatus string
+
+const (
+	StackDeploymentGroupStatusPending   StackDeploymentGroupStatus = "pending"
+	StackDeploymentGroupStatusDeploying StackDeploymentGroupStatus = "deploying"
+	StackDeploymentGroupStatusSucceeded StackDeploymentGroupStatus = "succeeded"
+	StackDeploymentGroupStatusFailed    StackDeploymentGroupStatus = "failed"
+	StackDeploymentGroupStatusAbandoned StackDeploymentGroupStatus = "abandoned"
+)
+
+func (s StackDeploymentGroupStatus) String() string {
+	return string(s)
+}
+
+// StackDeploymentGroup represents a group of deployments of a stack
+// configuration.
+type StackDeploymentGroup struct {
+	ID        string                     `jsonapi:"primary,stack-deployment-groups"`
+	Name      string                     `jsonapi:"attr,name"`
+	Status    StackDeploymentGroupStatus `jsonapi:"attr,status"`
+	CreatedAt time.Time                  `jsonapi:"attr,created-at,iso8601"`
+	UpdatedAt time.Time                  `jsonapi:"attr,updated-at,iso8601"`
+
+	// Relationships
+	StackConfiguration *StackConfiguration `jsonapi:"relation,stack-configuration"`
+}
+
+// StackDeploymentGroupList represents a list of stack deployment groups.
+type StackDeploymentGroupList struct {
+	*Pagination
+	Items []*StackDeploymentGroup
+}
+
+// StackDeploymentGroupListOptions represents the options for listing stack
+// deployment groups.
+type StackDeploymentGroupListOptions struct {
+	ListOptions
+}
+
+// StackDeploymentGroupRerunOptions represents the options for rerunning the
+// deployments of a stack deployment group.
+type StackDeploymentGroupRerunOptions struct {
+	// Type is a public field utilized by JSON:API to
+	// set the resource type via the field tag.
+	// It is not a user-defined value and does not need to be set.
+	// https://jsonapi.org/format/#crud-creating
+	Type string `jsonapi:"primary,stack-deployment-groups"`
+
+	// The names of the deployments to plan again.
+	Deployments []string `jsonapi:"attr,deployments"`
+}
+
+func (s stackDeploymentGroups) List(ctx context.Context, stackConfigurationID string, options *StackDeploymentGroupListOptions) (*StackDeploymentGroupList, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("stack-configurations/%s/stack-deployment-groups", url.PathEscape(stackConfigurationID)), options)
+	if err != nil {
+		return nil, err
+	}
+
+	dgl := &StackDeploymentGroupList{}
+	err = req.Do(ctx, dgl)
+	if err != nil {
+		return nil, err
+	}
+
+	return dgl, nil
+}
+
+func (s stackDeploymentGroups) Read(ctx context.Context, stackDeploymentGroupID string) (*StackDeploymentGroup, error) {
+	req, err := s.client.NewRequest("GET", fmt.Sprintf("stack-deployment-groups/%s", url.PathEscape(stackDeploymentGroupID)), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	dg := &StackDeploymentGroup{}
+	err = req.Do(ctx, dg)
+	if err != nil {
+		return nil, err
+	}
+
+	return dg, nil
+}
+
+func (s stackDeploymentGroups) ApproveAllPlans(ctx context.Context, stackDeploymentGroupID string) error {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("stack-deployment-groups/%s/approve-all-plans", url.PathEscape(stackDeploymentGroupID)), nil)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
+
+func (s stackDeploymentGroups) Rerun(ctx context.Context, stackDeploymentGroupID string, options StackDeploymentGroupRerunOptions) error {
+	req, err := s.client.NewRequest("POST", fmt.Sprintf("stack-deployment-groups/%s/rerun", url.PathEscape(stackDeploymentGroupID)), &options)
+	if err != nil {
+		return err
+	}
+
+	return req.Do(ctx, nil)
+}
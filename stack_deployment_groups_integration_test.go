@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStackDeploymentGroups(t *testing.T) {
+	t.Parallel()
+
+	var approved bool
+	var rerun []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/stack-configurations/stc-1/stack-deployment-groups":
+			_, _ = io.WriteString(w, `{"data":[
+				{"id":"sdg-1","type":"stack-deployment-groups","attributes":{"name":"production_default","status":"pending"},
+				 "relationships":{"stack-configuration":{"data":{"id":"stc-1","type":"stack-configurations"}}}}],
+				"meta":{"pagination":{"current-page":1,"next-page":null,"total-pages":1,"total-count":1}}}`)
+		case "GET /api/v2/stack-deployment-groups/sdg-1":
+			_, _ = io.WriteString(w, `{"data":{"id":"sdg-1","type":"stack-deployment-groups","attributes":{"name":"production_default","status":"succeeded"}}}`)
+		case "POST /api/v2/stack-deployment-groups/sdg-1/approve-all-plans":
+			approved = true
+			w.WriteHeader(http.StatusNoContent)
+		case "POST /api/v2/stack-deployment-groups/sdg-1/rerun":
+			rerun, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("list the deployment groups of a configuration", func(t *testing.T) {
+		dgl, err := client.StackDeploymentGroups.List(ctx, "stc-1", nil)
+		require.NoError(t, err)
+		require.Len(t, dgl.Items, 1)
+		assert.Equal(t, "production_default", dgl.Items[0].Name)
+		assert.Equal(t, StackDeploymentGroupStatusPending, dgl.Items[0].Status)
+		require.NotNil(t, dgl.Items[0].StackConfiguration)
+		assert.Equal(t, "stc-1", dgl.Items[0].StackConfiguration.ID)
+	})
+
+	t.Run("read a deployment group", func(t *testing.T) {
+		dg, err := client.StackDeploymentGroups.Read(ctx, "sdg-1")
+		require.NoError(t, err)
+		assert.Equal(t, StackDeploymentGroupStatusSucceeded, dg.Status)
+	})
+
+	t.Run("approve all the plans of a deployment group", func(t *testing.T) {
+		require.NoError(t, client.StackDeploymentGroups.ApproveAllPlans(ctx, "sdg-1"))
+		assert.True(t, approved)
+	})
+
+	t.Run("rerun deployments of a deployment group", func(t *testing.T) {
+		err := client.StackDeploymentGroups.Rerun(ctx, "sdg-1", StackDeploymentGroupRerunOptions{
+			Deployments: []string{"production"},
+		})
+		require.NoError(t, err)
+		assert.Contains(t, string(rerun), `"deployments":["production"]`)
+	})
+}
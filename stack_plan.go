@@ -299,7 +299,7 @@ func (s stackPlans) PlanDescription(ctx context.Context, stackPlanID string) (*J
 // if the stack plan is waiting for approval. Check the status within the the channel to determine
 // if the stack plan needs approval.
 func (s stackPlans) AwaitTerminal(ctx context.Context, stackPlanID string) <-chan WaitForStatusResult {
-	return awaitPoll(ctx, stackPlanID, func(ctx context.Context) (string, error) {
+	return awaitPoll(ctx, stackPlanID, s.client.pollingBackoff(pollBackoff), func(ctx context.Context) (string, error) {
 		stackPlan, err := s.Read(ctx, stackPlanID)
 		if err != nil {
 			return "", err
@@ -323,7 +323,7 @@ func (s stackPlans) AwaitTerminal(ctx context.Context, stackPlanID string) <-cha
 // finished_planned, discarded, canceled, errorer), or an error occurs. The read will be retried
 // dependending on the configuration of the client.
 func (s stackPlans) AwaitRunning(ctx context.Context, stackPlanID string) <-chan WaitForStatusResult {
-	return awaitPoll(ctx, stackPlanID, func(ctx context.Context) (string, error) {
+	return awaitPoll(ctx, stackPlanID, s.client.pollingBackoff(pollBackoff), func(ctx context.Context) (string, error) {
 		stackPlan, err := s.Read(ctx, stackPlanID)
 		if err != nil {
 			return "", err
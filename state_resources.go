@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+)
+
+// StateResource represents a resource of a Terraform state, as stored in the
+// "resources" array of the state file.
+type StateResource struct {
+	Module    string                  `json:"module,omitempty"`
+	Mode      string                  `json:"mode"`
+	Type      string                  `json:"type"`
+	Name      string                  `json:"name"`
+	Provider  string                  `json:"provider"`
+	Instances []StateResourceInstance `json:"instances"`
+}
+
+// StateResourceInstance represents an instance of a resource of a Terraform
+// state. Attributes are left undecoded, as their schema depends on the
+// provider.
+type StateResourceInstance struct {
+	IndexKey       any             `json:"index_key,omitempty"`
+	SchemaVersion  int             `json:"schema_version"`
+	Attributes     json.RawMessage `json:"attributes,omitempty"`
+	SensitiveAttrs json.RawMessage `json:"sensitive_attributes,omitempty"`
+	Dependencies   []string        `json:"dependencies,omitempty"`
+	Status         string          `json:"status,omitempty"`
+}
+
+// IterateResources decodes the resources of the Terraform state read from r
+// one at a time and calls fn for each of them, so only one resource is held in
+// memory at once. It stops at the first error returned by fn, which is
+// returned unless it is ErrStopIteration.
+func IterateResources(r io.Reader, fn func(StateResource) error) error {
+	err := walkState(r, func(dec *json.Decoder, key string) error {
+		if key != "resources" {
+			return skipValue(dec)
+		}
+		return iterateResourcesArray(dec, fn)
+	})
+	if errors.Is(err, ErrStopIteration) {
+		return nil
+	}
+	return err
+}
+
+// walkState decodes the top-level object of the Terraform state read from r
+// and calls fn with the key of each of its members. fn must decode the value
+// of the member from dec, e.g. with skipValue.
+func walkState(r io.Reader, fn func(dec *json.Decoder, key string) error) error {
+	dec := json.NewDecoder(r)
+
+	if err := expectDelim(dec, '{'); err != nil {
+		return err
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("invalid state: unexpected token %v", tok)
+		}
+
+		if err := fn(dec, key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// skipValue decodes the next value of dec without keeping it.
+func skipValue(dec *json.Decoder) error {
+	var skip json.RawMessage
+	return dec.Decode(&skip)
+}
+
+func iterateResourcesArray(dec *json.Decoder, fn func(StateResource) error) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		// "resources": null
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("invalid state: expected resources to be an array, got %v", tok)
+	}
+
+	for dec.More() {
+		var resource StateResource
+		if err := dec.Decode(&resource); err != nil {
+			return err
+		}
+		if err := fn(resource); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, ']')
+}
+
+func expectDelim(dec *json.Decoder, want json.Delim) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != want {
+		return fmt.Errorf("invalid state: expected %v, got %v", want, tok)
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"errors"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIterateResources(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with a state file", func(t *testing.T) {
+		f, err := os.Open("test-fixtures/state-version/terraform.tfstate")
+		require.NoError(t, err)
+		defer f.Close()
+
+		var resources []StateResource
+		err = IterateResources(f, func(r StateResource) error {
+			resources = append(resources, r)
+			return nil
+		})
+		require.NoError(t, err)
+		require.Len(t, resources, 1)
+
+		r := resources[0]
+		assert.Equal(t, "module.media_bucket", r.Module)
+		assert.Equal(t, "managed", r.Mode)
+		assert.Equal(t, "aws_s3_bucket_public_access_block", r.Type)
+		assert.Equal(t, "this", r.Name)
+		require.Len(t, r.Instances, 1)
+		assert.NotEmpty(t, r.Instances[0].Attributes)
+	})
+
+	state := `{"version":4,"resources":[{"mode":"managed","type":"null_resource","name":"a"},{"mode":"managed","type":"null_resource","name":"b"}],"outputs":{}}`
+
+	t.Run("when stopping the iteration", func(t *testing.T) {
+		var names []string
+		err := IterateResources(strings.NewReader(state), func(r StateResource) error {
+			names = append(names, r.Name)
+			return ErrStopIteration
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a"}, names)
+	})
+
+	t.Run("when the callback fails", func(t *testing.T) {
+		failure := errors.New("failure")
+		err := IterateResources(strings.NewReader(state), func(r StateResource) error {
+			return failure
+		})
+		assert.Equal(t, failure, err)
+	})
+
+	t.Run("without resources", func(t *testing.T) {
+		err := IterateResources(strings.NewReader(`{"version":4,"resources":null}`), func(r StateResource) error {
+			t.Fatal("unexpected resource")
+			return nil
+		})
+		require.NoError(t, err)
+	})
+
+	t.Run("with an invalid state", func(t *testing.T) {
+		err := IterateResources(strings.NewReader(`{"resources":{}}`), func(r StateResource) error {
+			return nil
+		})
+		assert.Error(t, err)
+
+		err = IterateResources(strings.NewReader(`[]`), func(r StateResource) error {
+			return nil
+		})
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,174 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+)
+
+// StateSummary represents an inventory of a Terraform state: the version of
+// Terraform that wrote it, the names of its outputs and the number of its
+// resources, grouped by provider, type and module.
+type StateSummary struct {
+	TerraformVersion string
+	Serial           int64
+	Lineage          string
+
+	// The names of the outputs, sorted.
+	Outputs []string
+
+	// The resources, grouped by provider, mode, type and module, sorted in
+	// that order.
+	Resources []*StateResourceGroup
+}
+
+// StateResourceGroup represents the resources of a Terraform state of the
+// same provider, mode, type and module.
+type StateResourceGroup struct {
+	// The source address of the provider, e.g.
+	// "registry.terraform.io/hashicorp/aws".
+	Provider string
+
+	// The mode of the resources, "managed" or "data".
+	Mode string
+	Type string
+
+	// The address of the module, or an empty string for the root module.
+	Module string
+
+	// The number of resources, and the number of their instances.
+	Resources int
+	Instances int
+}
+
+// InstancesByProvider returns the number of instances of managed resources
+// of each provider of the state, keyed by provider source address.
+func (s *StateSummary) InstancesByProvider() map[string]int {
+	counts := make(map[string]int)
+	for _, g := range s.Resources {
+		if g.Mode == "managed" {
+			counts[g.Provider] += g.Instances
+		}
+	}
+	return counts
+}
+
+// SummarizeState decodes the Terraform state read from r into a summary. Like
+// IterateResources, it decodes the resources one at a time, and decodes the
+// outputs one at a time without keeping their values, so large states are
+// summarized with bounded memory.
+func SummarizeState(r io.Reader) (*StateSummary, error) {
+	summary := &StateSummary{}
+	groups := make(map[StateResourceGroup]*StateResourceGroup)
+
+	err := walkState(r, func(dec *json.Decoder, key string) error {
+		switch key {
+		case "terraform_version":
+			return dec.Decode(&summary.TerraformVersion)
+		case "serial":
+			return dec.Decode(&summary.Serial)
+		case "lineage":
+			return dec.Decode(&summary.Lineage)
+		case "outputs":
+			return iterateObjectKeys(dec, func(name string) {
+				summary.Outputs = append(summary.Outputs, name)
+			})
+		case "resources":
+			return iterateResourcesArray(dec, func(resource StateResource) error {
+				key := StateResourceGroup{
+					Provider: stateProviderSource(resource.Provider),
+					Mode:     resource.Mode,
+					Type:     resource.Type,
+					Module:   resource.Module,
+				}
+				g, ok := groups[key]
+				if !ok {
+					g = &key
+					groups[key] = g
+					summary.Resources = append(summary.Resources, g)
+				}
+				g.Resources++
+				g.Instances += len(resource.Instances)
+				return nil
+			})
+		default:
+			return skipValue(dec)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(summary.Outputs)
+	sort.Slice(summary.Resources, func(i, j int) bool {
+		a, b := summary.Resources[i], summary.Resources[j]
+		if a.Provider != b.Provider {
+			return a.Provider < b.Provider
+		}
+		if a.Mode != b.Mode {
+			return a.Mode < b.Mode
+		}
+		if a.Type != b.Type {
+			return a.Type < b.Type
+		}
+		return a.Module < b.Module
+	})
+
+	return summary, nil
+}
+
+// iterateObjectKeys decodes the next value of dec, which must be an object or
+// null, calling fn with each of its keys and skipping their values.
+func iterateObjectKeys(dec *json.Decoder, fn func(key string)) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+		return fmt.Errorf("invalid state: expected an object, got %v", tok)
+	}
+
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return err
+		}
+		key, ok := tok.(string)
+		if !ok {
+			return fmt.Errorf("invalid state: unexpected token %v", tok)
+		}
+		fn(key)
+
+		if err := skipValue(dec); err != nil {
+			return err
+		}
+	}
+
+	return expectDelim(dec, '}')
+}
+
+// stateProviderSource returns the source address of the provider
+// configuration address of a resource, e.g. "registry.terraform.io/hashicorp/aws"
+// for `module.foo.provider["registry.terraform.io/hashicorp/aws"].east`. The
+// address is returned unchanged if it is not in that form.
+func stateProviderSource(provider string) string {
+	const prefix = `provider["`
+	i := strings.Index(provider, prefix)
+	if i < 0 {
+		return provider
+	}
+	source := provider[i+len(prefix):]
+	j := strings.Index(source, `"]`)
+	if j < 0 {
+		return provider
+	}
+	return source[:j]
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummarizeState(t *testing.T) {
+	t.Parallel()
+
+	t.Run("with a state file", func(t *testing.T) {
+		f, err := os.Open("test-fixtures/state-version/terraform.tfstate")
+		require.NoError(t, err)
+		defer f.Close()
+
+		summary, err := SummarizeState(f)
+		require.NoError(t, err)
+
+		assert.Equal(t, "1.3.6", summary.TerraformVersion)
+		assert.Equal(t, int64(5), summary.Serial)
+		assert.Equal(t, "8094ef40-1dbd-95cd-1f60-bb25d84d883b", summary.Lineage)
+		assert.Equal(t, []string{
+			"test_output_bool",
+			"test_output_list_string",
+			"test_output_number",
+			"test_output_object",
+			"test_output_string",
+			"test_output_tuple_number",
+			"test_output_tuple_string",
+		}, summary.Outputs)
+		assert.Equal(t, []*StateResourceGroup{{
+			Provider:  "registry.terraform.io/hashicorp/aws",
+			Mode:      "managed",
+			Type:      "aws_s3_bucket_public_access_block",
+			Module:    "module.media_bucket",
+			Resources: 1,
+			Instances: 1,
+		}}, summary.Resources)
+	})
+
+	t.Run("grouping resources", func(t *testing.T) {
+		state := `{"version":4,"terraform_version":"1.9.0","outputs":null,"resources":[
+			{"mode":"managed","type":"null_resource","name":"a","provider":"provider[\"registry.terraform.io/hashicorp/null\"]","instances":[{},{}]},
+			{"mode":"managed","type":"null_resource","name":"b","provider":"provider[\"registry.terraform.io/hashicorp/null\"]","instances":[{}]},
+			{"module":"module.net","mode":"managed","type":"aws_vpc","name":"main","provider":"module.net.provider[\"registry.terraform.io/hashicorp/aws\"].east","instances":[{}]},
+			{"mode":"data","type":"aws_region","name":"current","provider":"provider[\"registry.terraform.io/hashicorp/aws\"]","instances":[{}]}
+		]}`
+
+		summary, err := SummarizeState(strings.NewReader(state))
+		require.NoError(t, err)
+
+		assert.Empty(t, summary.Outputs)
+		require.Len(t, summary.Resources, 3)
+		assert.Equal(t, "data", summary.Resources[0].Mode)
+		assert.Equal(t, "aws_vpc", summary.Resources[1].Type)
+		assert.Equal(t, "module.net", summary.Resources[1].Module)
+		assert.Equal(t, 2, summary.Resources[2].Resources)
+		assert.Equal(t, 3, summary.Resources[2].Instances)
+		assert.Equal(t, map[string]int{
+			"registry.terraform.io/hashicorp/aws":  1,
+			"registry.terraform.io/hashicorp/null": 3,
+		}, summary.InstancesByProvider())
+	})
+
+	t.Run("with an invalid state", func(t *testing.T) {
+		_, err := SummarizeState(strings.NewReader(`{"outputs":[]}`))
+		assert.Error(t, err)
+
+		_, err = SummarizeState(strings.NewReader(`{"terraform_version":1}`))
+		assert.Error(t, err)
+	})
+}
@@ -4,9 +4,12 @@
 package tfe
 
 import (
+	"bufio"
 	"bytes"
+	"compress/gzip"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
 	"strings"
 	"time"
@@ -58,6 +61,14 @@ type StateVersions interface {
 	// Download retrieves the actual stored state of a state version
 	Download(ctx context.Context, url string) ([]byte, error)
 
+	// DownloadStream retrieves the actual stored state of a state version
+	// as a stream, decompressing it if needed. The caller must close it.
+	DownloadStream(ctx context.Context, url string) (io.ReadCloser, error)
+
+	// ReadCurrentSummary downloads the current state of a workspace and
+	// summarizes its resources and outputs.
+	ReadCurrentSummary(ctx context.Context, workspaceID string) (*StateSummary, error)
+
 	// ListOutputs retrieves all the outputs of a state version by its ID. IMPORTANT: HCP Terraform might
 	// process outputs asynchronously. When consuming outputs or other async StateVersion fields, be sure to
 	// wait for ResourcesProcessed to become `true` before assuming they are empty.
@@ -130,6 +141,9 @@ type StateVersionListOptions struct {
 	ListOptions
 	Organization string `url:"filter[organization][name]"`
 	Workspace    string `url:"filter[workspace][name]"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // StateVersionIncludeOpt represents the available options for include query params.
@@ -149,6 +163,9 @@ type StateVersionReadOptions struct {
 	// Optional: A list of relations to include. See available resources:
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/state-versions#available-related-resources
 	Include []StateVersionIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // StateVersionOutputsListOptions represents the options for listing state
@@ -388,6 +405,87 @@ func (s *stateVersions) Download(ctx context.Context, u string) ([]byte, error)
 	return buf.Bytes(), nil
 }
 
+// DownloadStream retrieves the actual stored state of a state version without
+// buffering it in memory, so that large states can be processed with bounded
+// memory, e.g. with IterateResources. States stored gzip-compressed are
+// decompressed transparently. The caller must close the returned reader.
+func (s *stateVersions) DownloadStream(ctx context.Context, u string) (io.ReadCloser, error) {
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := req.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := decompressState(resp.Body)
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	return body, nil
+}
+
+// ReadCurrentSummary downloads the current state of a workspace as a stream
+// and summarizes it with SummarizeState, so the state is never held in
+// memory as a whole.
+func (s *stateVersions) ReadCurrentSummary(ctx context.Context, workspaceID string) (*StateSummary, error) {
+	sv, err := s.ReadCurrent(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := s.DownloadStream(ctx, sv.DownloadURL)
+	if err != nil {
+		return nil, err
+	}
+	defer body.Close()
+
+	return SummarizeState(body)
+}
+
+// gzipReadCloser closes both the gzip reader and the underlying body.
+type gzipReadCloser struct {
+	*gzip.Reader
+	body io.Closer
+}
+
+func (r *gzipReadCloser) Close() error {
+	err := r.Reader.Close()
+	if cerr := r.body.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// decompressState returns a reader decompressing the body if it starts with
+// the gzip magic number, as states stored compressed by the object store are
+// not always served with a Content-Encoding the HTTP client would handle.
+func decompressState(body io.ReadCloser) (io.ReadCloser, error) {
+	br := bufio.NewReader(body)
+	magic, err := br.Peek(2)
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+
+	if len(magic) == 2 && magic[0] == 0x1f && magic[1] == 0x8b {
+		zr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, err
+		}
+		return &gzipReadCloser{Reader: zr, body: body}, nil
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{br, body}, nil
+}
+
 // ListOutputs retrieves all the outputs of a state version by its ID. IMPORTANT: HCP Terraform might
 // process outputs asynchronously. When consuming outputs or other async StateVersion fields, be sure to
 // wait for ResourcesProcessed to become `true` before assuming they are empty.
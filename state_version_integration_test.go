@@ -4,10 +4,13 @@
 package tfe
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto/md5"
 	"encoding/base64"
 	"fmt"
+	"io"
 	"os"
 	"testing"
 	"time"
@@ -580,6 +583,90 @@ func TestStateVersionsDownload(t *testing.T) {
 	})
 }
 
+func TestStateVersionsDownloadStream(t *testing.T) {
+	t.Parallel()
+
+	stateTest, err := os.ReadFile("test-fixtures/state-version/terraform.tfstate")
+	require.NoError(t, err)
+
+	var compressed bytes.Buffer
+	zw := gzip.NewWriter(&compressed)
+	_, err = zw.Write(stateTest)
+	require.NoError(t, err)
+	require.NoError(t, zw.Close())
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/state-versions/sv-1/download":    string(stateTest),
+		"GET /api/v2/state-versions/sv-gzip/download": compressed.String(),
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("with an uncompressed state", func(t *testing.T) {
+		r, err := client.StateVersions.DownloadStream(ctx, "state-versions/sv-1/download")
+		require.NoError(t, err)
+		defer r.Close()
+
+		state, err := io.ReadAll(r)
+		require.NoError(t, err)
+		assert.Equal(t, stateTest, state)
+	})
+
+	t.Run("with a gzip-compressed state", func(t *testing.T) {
+		r, err := client.StateVersions.DownloadStream(ctx, "state-versions/sv-gzip/download")
+		require.NoError(t, err)
+		defer r.Close()
+
+		count := 0
+		err = IterateResources(r, func(StateResource) error {
+			count++
+			return nil
+		})
+		require.NoError(t, err)
+		assert.Equal(t, 1, count)
+	})
+
+	t.Run("with an invalid url", func(t *testing.T) {
+		r, err := client.StateVersions.DownloadStream(ctx, "state-versions/nonexisting/download")
+		assert.Nil(t, r)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+}
+
+func TestStateVersionsReadCurrentSummary(t *testing.T) {
+	t.Parallel()
+
+	stateTest, err := os.ReadFile("test-fixtures/state-version/terraform.tfstate")
+	require.NoError(t, err)
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-1/current-state-version": `{"data":{"id":"sv-1","type":"state-versions","attributes":{
+			"hosted-state-download-url":"state-versions/sv-1/download"}}}`,
+		"GET /api/v2/state-versions/sv-1/download": string(stateTest),
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("with a current state", func(t *testing.T) {
+		summary, err := client.StateVersions.ReadCurrentSummary(ctx, "ws-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, "1.3.6", summary.TerraformVersion)
+		assert.Len(t, summary.Outputs, 7)
+		assert.Equal(t, map[string]int{"registry.terraform.io/hashicorp/aws": 1}, summary.InstancesByProvider())
+	})
+
+	t.Run("without a current state", func(t *testing.T) {
+		_, err := client.StateVersions.ReadCurrentSummary(ctx, "ws-2")
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		_, err := client.StateVersions.ReadCurrentSummary(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
 func TestStateVersionOutputs(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
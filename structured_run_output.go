@@ -0,0 +1,385 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+)
+
+// Compile-time proof of interface implementation.
+var _ StructuredRunOutputs = (*structuredRunOutputs)(nil)
+
+// StructuredRunOutputs describes the methods to read the structured output of
+// a run, the redacted JSON execution plan the HCP Terraform UI renders its
+// plan summaries from.
+//
+// TFE API docs: https://developer.hashicorp.com/terraform/cloud-docs/api-docs/plans#retrieve-the-json-execution-plan
+type StructuredRunOutputs interface {
+	// ReadPlan reads the redacted JSON execution plan of a plan.
+	ReadPlan(ctx context.Context, planID string) (*RedactedPlan, error)
+
+	// ListResourceChanges lists the resource changes of the redacted JSON
+	// execution plan of a plan, one page at a time.
+	ListResourceChanges(ctx context.Context, planID string, options *PlanResourceChangeListOptions) (*PlanResourceChangeList, error)
+}
+
+// structuredRunOutputs implements StructuredRunOutputs.
+type structuredRunOutputs struct {
+	client *Client
+}
+
+// PlanChangeAction represents an action of a planned change.
+type PlanChangeAction string
+
+// List of available planned change actions. A replacement is planned as a
+// delete and a create, in either order.
+const (
+	PlanChangeNoOp   PlanChangeAction = "no-op"
+	PlanChangeCreate PlanChangeAction = "create"
+	PlanChangeRead   PlanChangeAction = "read"
+	PlanChangeUpdate PlanChangeAction = "update"
+	PlanChangeDelete PlanChangeAction = "delete"
+)
+
+// RedactedPlan represents a JSON execution plan with its sensitive values
+// redacted.
+type RedactedPlan struct {
+	FormatVersion    string                 `json:"format_version"`
+	TerraformVersion string                 `json:"terraform_version"`
+	ResourceChanges  []*PlanResourceChange  `json:"resource_changes"`
+	ResourceDrift    []*PlanResourceChange  `json:"resource_drift"`
+	OutputChanges    map[string]*PlanChange `json:"output_changes"`
+	Errored          bool                   `json:"errored"`
+}
+
+// PlanResourceChange represents the planned change of a resource instance.
+type PlanResourceChange struct {
+	Address         string     `json:"address"`
+	PreviousAddress string     `json:"previous_address,omitempty"`
+	ModuleAddress   string     `json:"module_address,omitempty"`
+	Mode            string     `json:"mode"`
+	Type            string     `json:"type"`
+	Name            string     `json:"name"`
+	Index           any        `json:"index,omitempty"`
+	ProviderName    string     `json:"provider_name"`
+	Deposed         string     `json:"deposed,omitempty"`
+	ActionReason    string     `json:"action_reason,omitempty"`
+	Change          PlanChange `json:"change"`
+}
+
+// PlanChange represents a planned change of a resource instance or an
+// output. The values are left undecoded, as their schema depends on the
+// provider.
+type PlanChange struct {
+	Actions         []PlanChangeAction `json:"actions"`
+	Before          json.RawMessage    `json:"before"`
+	After           json.RawMessage    `json:"after"`
+	AfterUnknown    json.RawMessage    `json:"after_unknown,omitempty"`
+	BeforeSensitive json.RawMessage    `json:"before_sensitive,omitempty"`
+	AfterSensitive  json.RawMessage    `json:"after_sensitive,omitempty"`
+	ReplacePaths    json.RawMessage    `json:"replace_paths,omitempty"`
+	Importing       *PlanImporting     `json:"importing,omitempty"`
+}
+
+// PlanImporting represents the import of a resource instance planned with
+// its change.
+type PlanImporting struct {
+	ID string `json:"id"`
+}
+
+// PlanAttributeDiff represents the planned change of a top-level attribute of
+// a resource instance.
+type PlanAttributeDiff struct {
+	Name string
+
+	// The values of the attribute before and after the change, or nil when
+	// the attribute is unset.
+	Before json.RawMessage
+	After  json.RawMessage
+
+	// Whether the value after the change is only known after apply.
+	Unknown bool
+
+	// Whether the value before or after the change is sensitive, in which
+	// case it is redacted.
+	Sensitive bool
+}
+
+// PlanResourceChangeList represents a page of the resource changes of a plan.
+type PlanResourceChangeList struct {
+	*Pagination
+	Items []*PlanResourceChange
+}
+
+// PlanResourceChangeListOptions represents the options for listing the
+// resource changes of a plan.
+type PlanResourceChangeListOptions struct {
+	ListOptions
+
+	// Optional: Only list the resource changes with one of these actions,
+	// e.g. to leave out the resource instances that do not change.
+	Actions []PlanChangeAction
+}
+
+// Has reports whether the change includes the action.
+func (c *PlanChange) Has(action PlanChangeAction) bool {
+	for _, a := range c.Actions {
+		if a == action {
+			return true
+		}
+	}
+	return false
+}
+
+// IsReplace reports whether the change replaces the resource instance.
+func (c *PlanChange) IsReplace() bool {
+	return c.Has(PlanChangeDelete) && c.Has(PlanChangeCreate)
+}
+
+// Diff returns the top-level attributes of the resource instance whose
+// values differ before and after the change, sorted by name. Attributes only
+// known after apply are always included.
+func (c *PlanChange) Diff() ([]*PlanAttributeDiff, error) {
+	before, err := decodeAttributes(c.Before)
+	if err != nil {
+		return nil, err
+	}
+	after, err := decodeAttributes(c.After)
+	if err != nil {
+		return nil, err
+	}
+	unknown, err := decodeAttributes(c.AfterUnknown)
+	if err != nil {
+		return nil, err
+	}
+	beforeSensitive, err := decodeAttributes(c.BeforeSensitive)
+	if err != nil {
+		return nil, err
+	}
+	afterSensitive, err := decodeAttributes(c.AfterSensitive)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]bool)
+	for _, attrs := range []map[string]json.RawMessage{before, after, unknown} {
+		for name := range attrs {
+			names[name] = true
+		}
+	}
+
+	var diffs []*PlanAttributeDiff
+	for name := range names {
+		diff := &PlanAttributeDiff{
+			Name:      name,
+			Before:    before[name],
+			After:     after[name],
+			Unknown:   isJSONTrue(unknown[name]),
+			Sensitive: isJSONTrue(beforeSensitive[name]) || isJSONTrue(afterSensitive[name]),
+		}
+		if !diff.Unknown && !diff.Sensitive && jsonEqual(diff.Before, diff.After) {
+			continue
+		}
+		diffs = append(diffs, diff)
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Name < diffs[j].Name
+	})
+
+	return diffs, nil
+}
+
+// ReadPlan reads the redacted JSON execution plan of a plan.
+func (s *structuredRunOutputs) ReadPlan(ctx context.Context, planID string) (*RedactedPlan, error) {
+	if !validStringID(&planID) {
+		return nil, ErrInvalidPlanID
+	}
+
+	req, err := s.client.NewRequest("GET", redactedPlanURL(planID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	var buf bytes.Buffer
+	err = req.Do(ctx, &buf)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &RedactedPlan{}
+	if err := json.Unmarshal(buf.Bytes(), p); err != nil {
+		return nil, fmt.Errorf("invalid JSON execution plan: %w", err)
+	}
+
+	return p, nil
+}
+
+// ListResourceChanges lists the resource changes of the redacted JSON
+// execution plan of a plan, one page at a time. The API returns the plan as
+// a whole, so it is downloaded as a stream and its resource changes are
+// decoded one at a time, keeping only the ones of the requested page: large
+// plans are paged through with bounded memory, at the cost of downloading
+// the plan for each page.
+func (s *structuredRunOutputs) ListResourceChanges(ctx context.Context, planID string, options *PlanResourceChangeListOptions) (*PlanResourceChangeList, error) {
+	if !validStringID(&planID) {
+		return nil, ErrInvalidPlanID
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+	if options == nil {
+		options = &PlanResourceChangeListOptions{}
+	}
+
+	pageNumber, pageSize := options.PageNumber, options.PageSize
+	if pageNumber == 0 {
+		pageNumber = 1
+	}
+	if pageSize == 0 {
+		pageSize = 20
+	}
+	first := (pageNumber - 1) * pageSize
+
+	req, err := s.client.NewRequest("GET", redactedPlanURL(planID), nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := req.send(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	rcl := &PlanResourceChangeList{}
+	var count int
+	err = walkState(resp.Body, func(dec *json.Decoder, key string) error {
+		if key != "resource_changes" {
+			return skipValue(dec)
+		}
+		return iterateResourceChanges(dec, func(rc *PlanResourceChange) {
+			if !options.matches(rc) {
+				return
+			}
+			if count >= first && count < first+pageSize {
+				rcl.Items = append(rcl.Items, rc)
+			}
+			count++
+		})
+	})
+	if err != nil {
+		return nil, fmt.Errorf("invalid JSON execution plan: %w", err)
+	}
+
+	rcl.Pagination = &Pagination{
+		CurrentPage: pageNumber,
+		TotalCount:  count,
+		TotalPages:  (count + pageSize - 1) / pageSize,
+	}
+	if pageNumber > 1 {
+		rcl.PreviousPage = pageNumber - 1
+	}
+	if pageNumber < rcl.TotalPages {
+		rcl.NextPage = pageNumber + 1
+	}
+
+	return rcl, nil
+}
+
+func redactedPlanURL(planID string) string {
+	return fmt.Sprintf("plans/%s/json-output-redacted", url.PathEscape(planID))
+}
+
+// iterateResourceChanges decodes the next value of dec, which must be an
+// array of resource changes or null, calling fn with each resource change.
+func iterateResourceChanges(dec *json.Decoder, fn func(*PlanResourceChange)) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	if tok == nil {
+		return nil
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return fmt.Errorf("expected resource changes to be an array, got %v", tok)
+	}
+
+	for dec.More() {
+		rc := &PlanResourceChange{}
+		if err := dec.Decode(rc); err != nil {
+			return err
+		}
+		fn(rc)
+	}
+
+	return expectDelim(dec, ']')
+}
+
+// decodeAttributes decodes a JSON object of attribute values, or null, into
+// a map keyed by attribute name. Values that are not objects, such as the
+// booleans Terraform uses when a whole value is sensitive or unknown, decode
+// to no attributes.
+func decodeAttributes(data json.RawMessage) (map[string]json.RawMessage, error) {
+	if len(data) == 0 || data[0] != '{' {
+		return nil, nil
+	}
+
+	var attrs map[string]json.RawMessage
+	if err := json.Unmarshal(data, &attrs); err != nil {
+		return nil, err
+	}
+	return attrs, nil
+}
+
+func isJSONTrue(data json.RawMessage) bool {
+	return string(data) == "true"
+}
+
+// jsonEqual reports whether two JSON values are equal, regardless of their
+// formatting. Unset values equal null.
+func jsonEqual(a, b json.RawMessage) bool {
+	var av, bv any
+	if len(a) > 0 {
+		if err := json.Unmarshal(a, &av); err != nil {
+			return false
+		}
+	}
+	if len(b) > 0 {
+		if err := json.Unmarshal(b, &bv); err != nil {
+			return false
+		}
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+func (o *PlanResourceChangeListOptions) matches(rc *PlanResourceChange) bool {
+	if o == nil || len(o.Actions) == 0 {
+		return true
+	}
+	for _, action := range o.Actions {
+		if rc.Change.Has(action) {
+			return true
+		}
+	}
+	return false
+}
+
+func (o *PlanResourceChangeListOptions) valid() error {
+	if o == nil {
+		return nil
+	}
+	if o.PageNumber < 0 || o.PageSize < 0 || o.PageSize > 100 {
+		return ErrInvalidPagination
+	}
+	return nil
+}
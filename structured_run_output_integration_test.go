@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStructuredRunOutputs(t *testing.T) {
+	t.Parallel()
+
+	change := func(address string, actions string) string {
+		return fmt.Sprintf(`{"address":%q,"mode":"managed","type":"null_resource","name":%q,
+			"provider_name":"registry.terraform.io/hashicorp/null","change":{"actions":%s,"before":null,"after":{}}}`,
+			address, address, actions)
+	}
+	plan := fmt.Sprintf(`{
+		"format_version": "1.2",
+		"terraform_version": "1.9.0",
+		"planned_values": {"root_module": {}},
+		"resource_changes": [%s, %s, %s, %s, {
+			"address": "aws_instance.web",
+			"mode": "managed",
+			"type": "aws_instance",
+			"name": "web",
+			"provider_name": "registry.terraform.io/hashicorp/aws",
+			"change": {
+				"actions": ["delete", "create"],
+				"before": {"ami": "ami-1", "id": "i-1", "tags": {"env": "dev"}, "password": null},
+				"after": {"ami": "ami-2", "tags": {"env": "dev"}, "password": null},
+				"after_unknown": {"id": true},
+				"before_sensitive": {},
+				"after_sensitive": {"password": true},
+				"replace_paths": [["ami"]]
+			}
+		}],
+		"output_changes": {"ip": {"actions": ["update"], "before": "10.0.0.1", "after": null}}
+	}`,
+		change("null_resource.a", `["no-op"]`),
+		change("null_resource.b", `["create"]`),
+		change("null_resource.c", `["no-op"]`),
+		change("null_resource.d", `["create"]`))
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/plans/plan-1/json-output-redacted": plan,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("read the plan", func(t *testing.T) {
+		p, err := client.StructuredRunOutputs.ReadPlan(ctx, "plan-1")
+		require.NoError(t, err)
+		assert.Equal(t, "1.9.0", p.TerraformVersion)
+		require.Len(t, p.ResourceChanges, 5)
+		assert.Equal(t, "null_resource.b", p.ResourceChanges[1].Address)
+		assert.Equal(t, []PlanChangeAction{PlanChangeUpdate}, p.OutputChanges["ip"].Actions)
+	})
+
+	t.Run("diff a replaced resource", func(t *testing.T) {
+		p, err := client.StructuredRunOutputs.ReadPlan(ctx, "plan-1")
+		require.NoError(t, err)
+
+		rc := p.ResourceChanges[4]
+		assert.True(t, rc.Change.IsReplace())
+
+		diffs, err := rc.Change.Diff()
+		require.NoError(t, err)
+		require.Len(t, diffs, 3)
+
+		assert.Equal(t, "ami", diffs[0].Name)
+		assert.Equal(t, json.RawMessage(`"ami-1"`), diffs[0].Before)
+		assert.Equal(t, json.RawMessage(`"ami-2"`), diffs[0].After)
+
+		assert.Equal(t, "id", diffs[1].Name)
+		assert.True(t, diffs[1].Unknown)
+		assert.Nil(t, diffs[1].After)
+
+		assert.Equal(t, "password", diffs[2].Name)
+		assert.True(t, diffs[2].Sensitive)
+	})
+
+	t.Run("list resource changes by page", func(t *testing.T) {
+		rcl, err := client.StructuredRunOutputs.ListResourceChanges(ctx, "plan-1", &PlanResourceChangeListOptions{
+			ListOptions: ListOptions{PageNumber: 2, PageSize: 2},
+		})
+		require.NoError(t, err)
+		require.Len(t, rcl.Items, 2)
+		assert.Equal(t, "null_resource.c", rcl.Items[0].Address)
+		assert.Equal(t, "null_resource.d", rcl.Items[1].Address)
+		assert.Equal(t, 2, rcl.CurrentPage)
+		assert.Equal(t, 1, rcl.PreviousPage)
+		assert.Equal(t, 3, rcl.NextPage)
+		assert.Equal(t, 3, rcl.TotalPages)
+		assert.Equal(t, 5, rcl.TotalCount)
+	})
+
+	t.Run("list resource changes with actions", func(t *testing.T) {
+		rcl, err := client.StructuredRunOutputs.ListResourceChanges(ctx, "plan-1", &PlanResourceChangeListOptions{
+			Actions: []PlanChangeAction{PlanChangeCreate},
+		})
+		require.NoError(t, err)
+		require.Len(t, rcl.Items, 3)
+		assert.Equal(t, "null_resource.b", rcl.Items[0].Address)
+		assert.Equal(t, "aws_instance.web", rcl.Items[2].Address)
+		assert.Equal(t, 1, rcl.TotalPages)
+		assert.Equal(t, 0, rcl.NextPage)
+	})
+
+	t.Run("with invalid pagination", func(t *testing.T) {
+		_, err := client.StructuredRunOutputs.ListResourceChanges(ctx, "plan-1", &PlanResourceChangeListOptions{
+			ListOptions: ListOptions{PageSize: 101},
+		})
+		assert.Equal(t, ErrInvalidPagination, err)
+	})
+
+	t.Run("without a valid plan ID", func(t *testing.T) {
+		_, err := client.StructuredRunOutputs.ReadPlan(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidPlanID, err)
+
+		_, err = client.StructuredRunOutputs.ListResourceChanges(ctx, badIdentifier, nil)
+		assert.Equal(t, ErrInvalidPlanID, err)
+	})
+}
@@ -3,7 +3,24 @@
 
 package tfe
 
-import "fmt"
+import (
+	"fmt"
+	"strings"
+	"unicode/utf8"
+)
+
+// The limits on the tag bindings of a workspace or project, enforced by the
+// API.
+const (
+	// The maximum number of tag bindings of a workspace or project, not
+	// counting the ones it inherits.
+	MaxTagBindings = 10
+
+	// The maximum length, in characters, of the key and the value of a tag
+	// binding.
+	MaxTagBindingKeyLength   = 128
+	MaxTagBindingValueLength = 256
+)
 
 type TagList struct {
 	*Pagination
@@ -23,9 +40,67 @@ type TagBinding struct {
 }
 
 type EffectiveTagBinding struct {
-	ID    string `jsonapi:"primary,effective-tag-bindings"`
-	Key   string `jsonapi:"attr,key"`
-	Value string `jsonapi:"attr,value,omitempty"`
+	ID    string                 `jsonapi:"primary,effective-tag-bindings"`
+	Key   string                 `jsonapi:"attr,key"`
+	Value string                 `jsonapi:"attr,value,omitempty"`
+	Links map[string]interface{} `jsonapi:"links,omitempty"`
+}
+
+// Inherited reports whether the effective tag binding was inherited from a
+// parent resource, such as the project of a workspace.
+func (t *EffectiveTagBinding) Inherited() bool {
+	from, ok := t.Links["inherited-from"]
+	return ok && from != nil
+}
+
+// MergeTagBindings merges sets of tag bindings into a single set in which
+// each key appears once. Keys are compared regardless of case and trimmed of
+// surrounding whitespace: a key keeps its casing from the first set it
+// appears in and its value from the last, so that merging the existing tag
+// bindings of a workspace with new ones updates their values without
+// renaming their keys. Bindings with an empty key are dropped.
+func MergeTagBindings(sets ...[]*TagBinding) []*TagBinding {
+	var merged []*TagBinding
+	index := make(map[string]int)
+
+	for _, set := range sets {
+		for _, tb := range set {
+			if tb == nil {
+				continue
+			}
+			key := strings.TrimSpace(tb.Key)
+			if key == "" {
+				continue
+			}
+
+			folded := strings.ToLower(key)
+			if i, ok := index[folded]; ok {
+				merged[i].Value = tb.Value
+				continue
+			}
+			index[folded] = len(merged)
+			merged = append(merged, &TagBinding{Key: key, Value: tb.Value})
+		}
+	}
+
+	return merged
+}
+
+// validTagBindings checks the tag bindings of a workspace or project against
+// the limits of the API, so that an easily detectable mistake fails without a
+// round trip.
+func validTagBindings(bindings []*TagBinding) error {
+	if len(bindings) > MaxTagBindings {
+		return ErrTooManyTagBindings
+	}
+	for _, tb := range bindings {
+		if tb == nil || strings.TrimSpace(tb.Key) == "" ||
+			utf8.RuneCountInString(tb.Key) > MaxTagBindingKeyLength ||
+			utf8.RuneCountInString(tb.Value) > MaxTagBindingValueLength {
+			return ErrInvalidTagBinding
+		}
+	}
+	return nil
 }
 
 func encodeTagFiltersAsParams(filters []*TagBinding) map[string][]string {
@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"sort"
+)
+
+// TagBindingKeyUsage represents the use of a tag binding key in an
+// organization, with the values it is bound to.
+type TagBindingKeyUsage struct {
+	Key    string
+	Values []*TagBindingValueUsage
+}
+
+// TagBindingValueUsage represents the resources a tag binding key and value
+// are bound to.
+type TagBindingValueUsage struct {
+	Value string
+
+	// Projects the tag binding is set on.
+	Projects []*Project
+	// Workspaces the tag binding is set on directly.
+	Workspaces []*Workspace
+	// InheritingWorkspaces are the workspaces the tag binding is inherited by
+	// from their project.
+	InheritingWorkspaces []*Workspace
+}
+
+// ReadTagBindingUsage reports the tag binding keys used in an organization,
+// sorted by key, with their values, sorted by value, and the projects and
+// workspaces each of them is bound to. It lists all the projects and
+// workspaces of the organization with their effective tag bindings.
+func (s *organizations) ReadTagBindingUsage(ctx context.Context, organization string) ([]*TagBindingKeyUsage, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	usage := make(map[string]map[string]*TagBindingValueUsage)
+	valueUsage := func(key, value string) *TagBindingValueUsage {
+		values, ok := usage[key]
+		if !ok {
+			values = make(map[string]*TagBindingValueUsage)
+			usage[key] = values
+		}
+		u, ok := values[value]
+		if !ok {
+			u = &TagBindingValueUsage{Value: value}
+			values[value] = u
+		}
+		return u
+	}
+
+	projectOptions := &ProjectListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+		Include:     []ProjectIncludeOpt{ProjectEffectiveTagBindings},
+	}
+	err := forEachPage(&projectOptions.ListOptions, func() (*Pagination, error) {
+		pl, err := s.client.Projects.List(ctx, organization, projectOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, p := range pl.Items {
+			for _, tb := range p.EffectiveTagBindings {
+				u := valueUsage(tb.Key, tb.Value)
+				u.Projects = append(u.Projects, p)
+			}
+		}
+		return pl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	workspaceOptions := &WorkspaceListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+		Include:     []WSIncludeOpt{WSEffectiveTagBindings},
+	}
+	err = forEachPage(&workspaceOptions.ListOptions, func() (*Pagination, error) {
+		wl, err := s.client.Workspaces.List(ctx, organization, workspaceOptions)
+		if err != nil {
+			return nil, err
+		}
+		for _, w := range wl.Items {
+			for _, tb := range w.EffectiveTagBindings {
+				u := valueUsage(tb.Key, tb.Value)
+				if tb.Inherited() {
+					u.InheritingWorkspaces = append(u.InheritingWorkspaces, w)
+				} else {
+					u.Workspaces = append(u.Workspaces, w)
+				}
+			}
+		}
+		return wl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]*TagBindingKeyUsage, 0, len(usage))
+	for key, values := range usage {
+		ku := &TagBindingKeyUsage{Key: key}
+		for _, u := range values {
+			ku.Values = append(ku.Values, u)
+		}
+		sort.Slice(ku.Values, func(i, j int) bool {
+			return ku.Values[i].Value < ku.Values[j].Value
+		})
+		keys = append(keys, ku)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Key < keys[j].Key
+	})
+
+	return keys, nil
+}
@@ -24,6 +24,10 @@ type TaskStages interface {
 	// **Note: This function is still in BETA and subject to change.**
 	// Override a task stage for a given run
 	Override(ctx context.Context, taskStageID string, options TaskStageOverrideOptions) (*TaskStage, error)
+
+	// AwaitCompletion blocks until a task stage is no longer pending or
+	// running and returns it, including its task results.
+	AwaitCompletion(ctx context.Context, taskStageID string) (*TaskStage, error)
 }
 
 // taskStages implements TaskStages
@@ -78,14 +82,28 @@ type TaskStage struct {
 	Permissions      *Permissions              `jsonapi:"attr,permissions"`
 	Actions          *Actions                  `jsonapi:"attr,actions"`
 
+	// The comment given when the stage was overridden, if any.
+	OverrideComment string `jsonapi:"attr,override-comment"`
+
 	Run               *Run                `jsonapi:"relation,run"`
 	TaskResults       []*TaskResult       `jsonapi:"relation,task-results"`
 	PolicyEvaluations []*PolicyEvaluation `jsonapi:"relation,policy-evaluations"`
+
+	// The user who overrode the stage, if any. Include TaskStageOverriddenBy
+	// to read their attributes.
+	OverriddenBy *User `jsonapi:"relation,overridden-by"`
+}
+
+// Overridden reports whether the task stage was overridden, letting its run
+// continue past failed tasks or policies.
+func (t *TaskStage) Overridden() bool {
+	return t.OverriddenBy != nil || !t.StatusTimestamps.OverriddenAt.IsZero()
 }
 
 // TaskStageOverrideOptions represents the options for overriding a TaskStage.
 type TaskStageOverrideOptions struct {
-	// An optional explanation for why the stage was overridden
+	// An optional explanation for why the stage was overridden. It is
+	// recorded with the override and returned as TaskStage.OverrideComment.
 	Comment *string `json:"comment,omitempty"`
 }
 
@@ -102,12 +120,18 @@ type TaskStageStatusTimestamps struct {
 	CanceledAt time.Time `jsonapi:"attr,canceled-at,rfc3339"`
 	FailedAt   time.Time `jsonapi:"attr,failed-at,rfc3339"`
 	PassedAt   time.Time `jsonapi:"attr,passed-at,rfc3339"`
+
+	// When the stage was overridden, if it was.
+	OverriddenAt time.Time `jsonapi:"attr,overridden-at,rfc3339"`
 }
 
 // TaskStageIncludeOpt represents the available options for include query params.
 type TaskStageIncludeOpt string
 
-const TaskStageTaskResults TaskStageIncludeOpt = "task_results"
+const (
+	TaskStageTaskResults  TaskStageIncludeOpt = "task_results"
+	TaskStageOverriddenBy TaskStageIncludeOpt = "overridden_by"
+)
 
 // **Note: This field is still in BETA and subject to change.**
 const PolicyEvaluationsTaskResults TaskStageIncludeOpt = "policy_evaluations"
@@ -116,11 +140,20 @@ const PolicyEvaluationsTaskResults TaskStageIncludeOpt = "policy_evaluations"
 type TaskStageReadOptions struct {
 	// Optional: A list of relations to include.
 	Include []TaskStageIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // TaskStageListOptions represents the options for listing task stages for a run
 type TaskStageListOptions struct {
 	ListOptions
+
+	// Optional: Only list the task stages of the given stage.
+	Stage Stage `url:"filter[stage],omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // Read a task stage by ID
@@ -166,11 +199,25 @@ func (s *taskStages) List(ctx context.Context, runID string, options *TaskStageL
 		return nil, err
 	}
 
+	// Filter the stages here as well, in case the API ignored the filter. A
+	// run has at most one task stage per stage, so they fit on a single page.
+	if options != nil && options.Stage != "" {
+		items := tlist.Items[:0]
+		for _, ts := range tlist.Items {
+			if ts.Stage == options.Stage {
+				items = append(items, ts)
+			}
+		}
+		tlist.Items = items
+	}
+
 	return tlist, nil
 }
 
 // **Note: This function is still in BETA and subject to change.**
-// Override a task stages for a run
+// Override a task stages for a run. The comment of the options is recorded
+// with the override, along with the user overriding the stage, and both are
+// returned when the stage is read.
 func (s *taskStages) Override(ctx context.Context, taskStageID string, options TaskStageOverrideOptions) (*TaskStage, error) {
 	if !validStringID(&taskStageID) {
 		return nil, ErrInvalidTaskStageID
@@ -191,6 +238,36 @@ func (s *taskStages) Override(ctx context.Context, taskStageID string, options T
 	return t, nil
 }
 
+// AwaitCompletion polls a task stage until it is no longer pending or running
+// and returns it, including its task results. A stage awaiting an override is
+// considered settled, so the caller can decide whether to call Override.
+func (s *taskStages) AwaitCompletion(ctx context.Context, taskStageID string) (*TaskStage, error) {
+	if !validStringID(&taskStageID) {
+		return nil, ErrInvalidTaskStageID
+	}
+
+	options := &TaskStageReadOptions{Include: []TaskStageIncludeOpt{TaskStageTaskResults}}
+
+	// Loop until the context is canceled or the task stage settled.
+	b := s.client.pollingBackoff(Backoff{Min: time.Second})
+	for reads := 0; ; reads++ {
+		ts, err := s.Read(ContextWithoutCache(ctx), taskStageID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		switch ts.Status {
+		case TaskStagePending, TaskStageRunning:
+			if err := b.Wait(ctx, reads, nil); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		return ts, nil
+	}
+}
+
 func (o *TaskStageReadOptions) valid() error {
 	return nil
 }
@@ -5,7 +5,11 @@ package tfe
 
 import (
 	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -113,3 +117,108 @@ func TestTaskStagesList(t *testing.T) {
 		assert.Equal(t, 2, len(taskStageList.Items[0].TaskResults))
 	})
 }
+
+func TestTaskStagesListByStage(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/runs/run-1/task-stages": `{"data":[
+			{"id":"ts-1","type":"task-stages","attributes":{"stage":"pre_plan","status":"passed"}},
+			{"id":"ts-2","type":"task-stages","attributes":{"stage":"post_plan","status":"running"}}
+		]}`,
+	})
+	defer done()
+
+	tsl, err := client.TaskStages.List(context.Background(), "run-1", &TaskStageListOptions{
+		Stage: PostPlan,
+	})
+	require.NoError(t, err)
+	require.Len(t, tsl.Items, 1)
+	assert.Equal(t, "ts-2", tsl.Items[0].ID)
+}
+
+func TestTaskStagesAwaitCompletion(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/task-stages/ts-1": `{
+			"data":{"id":"ts-1","type":"task-stages","attributes":{"stage":"post_plan","status":"awaiting_override"},
+				"relationships":{"task-results":{"data":[{"id":"taskrs-1","type":"task-results"}]}}},
+			"included":[{"id":"taskrs-1","type":"task-results","attributes":{"status":"failed","task-name":"scan"}}]
+		}`,
+	})
+	defer done()
+
+	ctx := context.Background()
+
+	t.Run("with a settled task stage", func(t *testing.T) {
+		ts, err := client.TaskStages.AwaitCompletion(ctx, "ts-1")
+		require.NoError(t, err)
+		assert.Equal(t, TaskStageAwaitingOverride, ts.Status)
+		require.Len(t, ts.TaskResults, 1)
+		assert.Equal(t, TaskFailed, ts.TaskResults[0].Status)
+	})
+
+	t.Run("with an invalid task stage ID", func(t *testing.T) {
+		ts, err := client.TaskStages.AwaitCompletion(ctx, badIdentifier)
+		assert.Nil(t, ts)
+		assert.Equal(t, ErrInvalidTaskStageID, err)
+	})
+}
+
+func TestTaskStagesOverride_audit(t *testing.T) {
+	t.Parallel()
+
+	var body []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "POST /api/v2/task-stages/ts-1/actions/override":
+			body, _ = io.ReadAll(r.Body)
+			_, _ = w.Write([]byte(`{"data":{"id":"ts-1","type":"task-stages","attributes":{"stage":"pre_apply","status":"passed"}}}`))
+		case "GET /api/v2/task-stages/ts-1":
+			assert.Equal(t, "overridden_by", r.URL.Query().Get("include"))
+			_, _ = w.Write([]byte(`{
+				"data":{"id":"ts-1","type":"task-stages",
+					"attributes":{"stage":"pre_apply","status":"passed","override-comment":"Scanner outage","status-timestamps":{"overridden-at":"2024-05-01T12:00:00Z"}},
+					"relationships":{"overridden-by":{"data":{"id":"user-1","type":"users"}}}},
+				"included":[{"id":"user-1","type":"users","attributes":{"username":"admin"}}]
+			}`))
+		case "GET /api/v2/task-stages/ts-2":
+			_, _ = w.Write([]byte(`{"data":{"id":"ts-2","type":"task-stages","attributes":{"stage":"pre_plan","status":"passed"}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{
+		Address:    srv.URL,
+		Token:      "insert-your-token-here",
+		HTTPClient: srv.Client(),
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	_, err = client.TaskStages.Override(ctx, "ts-1", TaskStageOverrideOptions{
+		Comment: String("Scanner outage"),
+	})
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"comment":"Scanner outage"}`, string(body))
+
+	ts, err := client.TaskStages.Read(ctx, "ts-1", &TaskStageReadOptions{
+		Include: []TaskStageIncludeOpt{TaskStageOverriddenBy},
+	})
+	require.NoError(t, err)
+	assert.True(t, ts.Overridden())
+	assert.Equal(t, "Scanner outage", ts.OverrideComment)
+	assert.Equal(t, time.Date(2024, 5, 1, 12, 0, 0, 0, time.UTC), ts.StatusTimestamps.OverriddenAt)
+	require.NotNil(t, ts.OverriddenBy)
+	assert.Equal(t, "admin", ts.OverriddenBy.Username)
+
+	ts, err = client.TaskStages.Read(ctx, "ts-2", nil)
+	require.NoError(t, err)
+	assert.False(t, ts.Overridden())
+}
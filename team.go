@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 )
 
 // Compile-time proof of interface implementation.
@@ -26,11 +27,22 @@ type Teams interface {
 	// Read a team by its ID.
 	Read(ctx context.Context, teamID string) (*Team, error)
 
+	// ReadWithOptions reads a team by its ID using the options supplied.
+	ReadWithOptions(ctx context.Context, teamID string, options *TeamReadOptions) (*Team, error)
+
 	// Update a team by its ID.
 	Update(ctx context.Context, teamID string, options TeamUpdateOptions) (*Team, error)
 
 	// Delete a team by its ID.
 	Delete(ctx context.Context, teamID string) error
+
+	// ReadBySSOTeamID reads the team of the given organization mapped to the
+	// given SSO team ID.
+	ReadBySSOTeamID(ctx context.Context, organization string, ssoTeamID string) (*Team, error)
+
+	// MapSSOTeamIDs sets the SSO team IDs of the teams of the given
+	// organization, keyed by team name, and returns the outcome per team.
+	MapSSOTeamIDs(ctx context.Context, organization string, mapping map[string]string) ([]*TeamSSOMappingResult, error)
 }
 
 // teams implements Teams.
@@ -66,10 +78,13 @@ type Team struct {
 type OrganizationAccess struct {
 	ManagePolicies           bool `jsonapi:"attr,manage-policies"`
 	ManagePolicyOverrides    bool `jsonapi:"attr,manage-policy-overrides"`
+	DelegatePolicyOverrides  bool `jsonapi:"attr,delegate-policy-overrides"`
 	ManageWorkspaces         bool `jsonapi:"attr,manage-workspaces"`
 	ManageVCSSettings        bool `jsonapi:"attr,manage-vcs-settings"`
 	ManageProviders          bool `jsonapi:"attr,manage-providers"`
 	ManageModules            bool `jsonapi:"attr,manage-modules"`
+	ManagePublicProviders    bool `jsonapi:"attr,manage-public-providers"`
+	ManagePublicModules      bool `jsonapi:"attr,manage-public-modules"`
 	ManageRunTasks           bool `jsonapi:"attr,manage-run-tasks"`
 	ManageProjects           bool `jsonapi:"attr,manage-projects"`
 	ReadWorkspaces           bool `jsonapi:"attr,read-workspaces"`
@@ -81,6 +96,20 @@ type OrganizationAccess struct {
 	ManageAgentPools         bool `jsonapi:"attr,manage-agent-pools"`
 }
 
+// TeamSSOMappingResult represents the outcome of mapping a single team to an
+// SSO team ID with MapSSOTeamIDs.
+type TeamSSOMappingResult struct {
+	TeamName  string
+	SSOTeamID string
+
+	// Team is the mapped team. It is nil when Err is set.
+	Team *Team
+
+	// Err is the error returned while mapping the team, if any. It is
+	// ErrResourceNotFound when the organization has no team with the name.
+	Err error
+}
+
 // TeamPermissions represents the current user's permissions on the team.
 type TeamPermissions struct {
 	CanDestroy          bool `jsonapi:"attr,can-destroy"`
@@ -108,6 +137,19 @@ type TeamListOptions struct {
 
 	// Optional: A query string to search teams by names.
 	Query string `url:"q,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
+}
+
+// TeamReadOptions represents the options for reading a team.
+type TeamReadOptions struct {
+	// Optional: A list of relations to include.
+	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/teams#available-related-resources
+	Include []TeamIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // TeamCreateOptions represents the options for creating a team.
@@ -162,10 +204,13 @@ type TeamUpdateOptions struct {
 type OrganizationAccessOptions struct {
 	ManagePolicies           *bool `json:"manage-policies,omitempty"`
 	ManagePolicyOverrides    *bool `json:"manage-policy-overrides,omitempty"`
+	DelegatePolicyOverrides  *bool `json:"delegate-policy-overrides,omitempty"`
 	ManageWorkspaces         *bool `json:"manage-workspaces,omitempty"`
 	ManageVCSSettings        *bool `json:"manage-vcs-settings,omitempty"`
 	ManageProviders          *bool `json:"manage-providers,omitempty"`
 	ManageModules            *bool `json:"manage-modules,omitempty"`
+	ManagePublicProviders    *bool `json:"manage-public-providers,omitempty"`
+	ManagePublicModules      *bool `json:"manage-public-modules,omitempty"`
 	ManageRunTasks           *bool `json:"manage-run-tasks,omitempty"`
 	ManageProjects           *bool `json:"manage-projects,omitempty"`
 	ReadWorkspaces           *bool `json:"read-workspaces,omitempty"`
@@ -226,12 +271,18 @@ func (s *teams) Create(ctx context.Context, organization string, options TeamCre
 
 // Read a single team by its ID.
 func (s *teams) Read(ctx context.Context, teamID string) (*Team, error) {
+	return s.ReadWithOptions(ctx, teamID, nil)
+}
+
+// ReadWithOptions reads a single team by its ID using the options supplied,
+// e.g. to include its users and organization memberships.
+func (s *teams) ReadWithOptions(ctx context.Context, teamID string, options *TeamReadOptions) (*Team, error) {
 	if !validStringID(&teamID) {
 		return nil, ErrInvalidTeamID
 	}
 
 	u := fmt.Sprintf("teams/%s", url.PathEscape(teamID))
-	req, err := s.client.NewRequest("GET", u, nil)
+	req, err := s.client.NewRequest("GET", u, options)
 	if err != nil {
 		return nil, err
 	}
@@ -281,6 +332,104 @@ func (s *teams) Delete(ctx context.Context, teamID string) error {
 	return req.Do(ctx, nil)
 }
 
+// ReadBySSOTeamID reads the team of the given organization mapped to the given
+// SSO team ID. As teams cannot be filtered by SSO team ID, all the teams of the
+// organization are listed. It returns ErrResourceNotFound if no team is mapped
+// to the SSO team ID.
+func (s *teams) ReadBySSOTeamID(ctx context.Context, organization, ssoTeamID string) (*Team, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if !validString(&ssoTeamID) {
+		return nil, ErrRequiredSSOTeamID
+	}
+
+	teams, err := s.listAll(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, t := range teams {
+		if t.SSOTeamID == ssoTeamID {
+			return t, nil
+		}
+	}
+
+	return nil, ErrResourceNotFound
+}
+
+// MapSSOTeamIDs sets the SSO team ID of each team of the given organization
+// named in the mapping, so the identity provider manages the membership of
+// the team. An empty SSO team ID removes the mapping of the team. Teams
+// already mapped to the given SSO team ID are not updated, and a failure to
+// map one team does not stop the others. Results are returned ordered by team
+// name.
+func (s *teams) MapSSOTeamIDs(ctx context.Context, organization string, mapping map[string]string) ([]*TeamSSOMappingResult, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+	if err := validateTeamSSOMapping(mapping); err != nil {
+		return nil, err
+	}
+
+	teams, err := s.listAll(ctx, organization)
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*Team, len(teams))
+	for _, t := range teams {
+		byName[t.Name] = t
+	}
+
+	names := make([]string, 0, len(mapping))
+	for name := range mapping {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := make([]*TeamSSOMappingResult, 0, len(names))
+	for _, name := range names {
+		result := &TeamSSOMappingResult{TeamName: name, SSOTeamID: mapping[name]}
+		results = append(results, result)
+
+		t, ok := byName[name]
+		switch {
+		case !ok:
+			result.Err = ErrResourceNotFound
+		case t.SSOTeamID == result.SSOTeamID:
+			result.Team = t
+		default:
+			result.Team, result.Err = s.Update(ctx, t.ID, TeamUpdateOptions{
+				SSOTeamID: String(result.SSOTeamID),
+			})
+		}
+	}
+
+	return results, nil
+}
+
+// listAll returns all the teams of the given organization.
+func (s *teams) listAll(ctx context.Context, organization string) ([]*Team, error) {
+	options := &TeamListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+	}
+
+	var teams []*Team
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		tl, err := s.List(ctx, organization, options)
+		if err != nil {
+			return nil, err
+		}
+		teams = append(teams, tl.Items...)
+		return tl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return teams, nil
+}
+
 func (o TeamCreateOptions) valid() error {
 	if !validString(o.Name) {
 		return ErrRequiredName
@@ -309,3 +458,16 @@ func validateTeamNames(names []string) error {
 
 	return nil
 }
+
+func validateTeamSSOMapping(mapping map[string]string) error {
+	if len(mapping) == 0 {
+		return ErrRequiredTeam
+	}
+	for name := range mapping {
+		if name == "" {
+			return ErrEmptyTeamName
+		}
+	}
+
+	return nil
+}
@@ -229,6 +229,10 @@ func (s *teamAccesses) Update(ctx context.Context, teamAccessID string, options
 		return nil, ErrInvalidAccessTeamID
 	}
 
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
 	u := fmt.Sprintf("team-workspaces/%s", url.PathEscape(teamAccessID))
 	req, err := s.client.NewRequest("PATCH", u, &options)
 	if err != nil {
@@ -283,5 +287,69 @@ func (o TeamAccessAddOptions) valid() error {
 	if o.Workspace == nil {
 		return ErrRequiredWorkspace
 	}
+	return validateTeamAccessPermissions(o.Access, o.Runs, o.Variables, o.StateVersions, o.SentinelMocks,
+		o.WorkspaceLocking != nil || o.RunTasks != nil)
+}
+
+func (o TeamAccessUpdateOptions) valid() error {
+	return validateTeamAccessPermissions(o.Access, o.Runs, o.Variables, o.StateVersions, o.SentinelMocks,
+		o.WorkspaceLocking != nil || o.RunTasks != nil)
+}
+
+// validateTeamAccessPermissions validates the access type and the custom
+// workspace permissions of a team access. The custom permissions can only be
+// set along with the custom access type, or without an access type when
+// updating a team access, as the other access types imply their permissions.
+func validateTeamAccessPermissions(
+	access *AccessType,
+	runs *RunsPermissionType,
+	variables *VariablesPermissionType,
+	stateVersions *StateVersionsPermissionType,
+	sentinelMocks *SentinelMocksPermissionType,
+	otherPermissions bool,
+) error {
+	if access != nil {
+		switch *access {
+		case AccessAdmin, AccessPlan, AccessRead, AccessWrite, AccessCustom:
+			// do nothing
+		default:
+			return ErrInvalidTeamAccessType
+		}
+
+		custom := otherPermissions || runs != nil || variables != nil || stateVersions != nil || sentinelMocks != nil
+		if custom && *access != AccessCustom {
+			return ErrTeamAccessPermissionsRequireCustom
+		}
+	}
+
+	if runs != nil {
+		switch *runs {
+		case RunsPermissionRead, RunsPermissionPlan, RunsPermissionApply:
+		default:
+			return ErrInvalidRunsPermission
+		}
+	}
+	if variables != nil {
+		switch *variables {
+		case VariablesPermissionNone, VariablesPermissionRead, VariablesPermissionWrite:
+		default:
+			return ErrInvalidVariablesPermission
+		}
+	}
+	if stateVersions != nil {
+		switch *stateVersions {
+		case StateVersionsPermissionNone, StateVersionsPermissionReadOutputs, StateVersionsPermissionRead, StateVersionsPermissionWrite:
+		default:
+			return ErrInvalidStateVersionsPermission
+		}
+	}
+	if sentinelMocks != nil {
+		switch *sentinelMocks {
+		case SentinelMocksPermissionNone, SentinelMocksPermissionRead:
+		default:
+			return ErrInvalidSentinelMocksPermission
+		}
+	}
+
 	return nil
 }
@@ -172,7 +172,7 @@ func TestTeamAccessesAdd(t *testing.T) {
 
 		_, err := client.TeamAccess.Add(ctx, options)
 
-		assert.EqualError(t, err, "invalid attribute\n\nRuns is read-only when access level is 'read'; use the 'custom' access level to set this attribute.")
+		assert.Equal(t, ErrTeamAccessPermissionsRequireCustom, err)
 	})
 
 	t.Run("when the team already has access", func(t *testing.T) {
@@ -390,3 +390,92 @@ func TestTeamAccessesUpdateRunTasks(t *testing.T) {
 		assert.Equal(t, newAccess, ta.RunTasks)
 	})
 }
+
+func TestTeamAccessesCustomPermissions(t *testing.T) {
+	t.Parallel()
+
+	customAccess := `{"data":{"id":"tws-1","type":"team-workspaces","attributes":{
+		"access":"custom",
+		"runs":"plan",
+		"variables":"write",
+		"state-versions":"read-outputs",
+		"sentinel-mocks":"read",
+		"workspace-locking":true,
+		"run-tasks":true
+	}}}`
+	client, done := newExampleClient(map[string]string{
+		"POST /api/v2/team-workspaces":        customAccess,
+		"PATCH /api/v2/team-workspaces/tws-1": customAccess,
+	})
+	defer done()
+
+	ctx := context.Background()
+
+	assertCustomAccess := func(t *testing.T, ta *TeamAccess) {
+		assert.Equal(t, AccessCustom, ta.Access)
+		assert.Equal(t, RunsPermissionPlan, ta.Runs)
+		assert.Equal(t, VariablesPermissionWrite, ta.Variables)
+		assert.Equal(t, StateVersionsPermissionReadOutputs, ta.StateVersions)
+		assert.Equal(t, SentinelMocksPermissionRead, ta.SentinelMocks)
+		assert.True(t, ta.WorkspaceLocking)
+		assert.True(t, ta.RunTasks)
+	}
+
+	t.Run("add with custom permissions", func(t *testing.T) {
+		ta, err := client.TeamAccess.Add(ctx, TeamAccessAddOptions{
+			Access:           Access(AccessCustom),
+			Runs:             RunsPermission(RunsPermissionPlan),
+			Variables:        VariablesPermission(VariablesPermissionWrite),
+			StateVersions:    StateVersionsPermission(StateVersionsPermissionReadOutputs),
+			SentinelMocks:    SentinelMocksPermission(SentinelMocksPermissionRead),
+			WorkspaceLocking: Bool(true),
+			RunTasks:         Bool(true),
+			Team:             &Team{ID: "team-1"},
+			Workspace:        &Workspace{ID: "ws-1"},
+		})
+		require.NoError(t, err)
+		assertCustomAccess(t, ta)
+	})
+
+	t.Run("update with custom permissions", func(t *testing.T) {
+		ta, err := client.TeamAccess.Update(ctx, "tws-1", TeamAccessUpdateOptions{
+			Variables: VariablesPermission(VariablesPermissionWrite),
+			RunTasks:  Bool(true),
+		})
+		require.NoError(t, err)
+		assertCustomAccess(t, ta)
+	})
+
+	t.Run("with custom permissions and another access type", func(t *testing.T) {
+		ta, err := client.TeamAccess.Update(ctx, "tws-1", TeamAccessUpdateOptions{
+			Access:           Access(AccessWrite),
+			WorkspaceLocking: Bool(true),
+		})
+		assert.Nil(t, ta)
+		assert.Equal(t, ErrTeamAccessPermissionsRequireCustom, err)
+	})
+
+	t.Run("with an invalid access type", func(t *testing.T) {
+		ta, err := client.TeamAccess.Update(ctx, "tws-1", TeamAccessUpdateOptions{
+			Access: Access("owner"),
+		})
+		assert.Nil(t, ta)
+		assert.Equal(t, ErrInvalidTeamAccessType, err)
+	})
+
+	t.Run("with invalid permission values", func(t *testing.T) {
+		for _, tc := range []struct {
+			options TeamAccessUpdateOptions
+			err     error
+		}{
+			{TeamAccessUpdateOptions{Runs: RunsPermission("destroy")}, ErrInvalidRunsPermission},
+			{TeamAccessUpdateOptions{Variables: VariablesPermission("apply")}, ErrInvalidVariablesPermission},
+			{TeamAccessUpdateOptions{StateVersions: StateVersionsPermission("admin")}, ErrInvalidStateVersionsPermission},
+			{TeamAccessUpdateOptions{SentinelMocks: SentinelMocksPermission("write")}, ErrInvalidSentinelMocksPermission},
+		} {
+			ta, err := client.TeamAccess.Update(ctx, "tws-1", tc.options)
+			assert.Nil(t, ta)
+			assert.Equal(t, tc.err, err)
+		}
+	})
+}
@@ -313,6 +313,64 @@ func TestTeamsDelete(t *testing.T) {
 	})
 }
 
+func TestTeamsMapSSOTeamIDs(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	defer orgTestCleanup()
+
+	tmTest, tmTestCleanup := createTeam(t, client, orgTest)
+	defer tmTestCleanup()
+
+	ssoTeamID := randomString(t)
+
+	t.Run("with known and unknown teams", func(t *testing.T) {
+		results, err := client.Teams.MapSSOTeamIDs(ctx, orgTest.Name, map[string]string{
+			tmTest.Name:      ssoTeamID,
+			"zz-nonexisting": randomString(t),
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		assert.Equal(t, tmTest.Name, results[0].TeamName)
+		require.NoError(t, results[0].Err)
+		assert.Equal(t, ssoTeamID, results[0].Team.SSOTeamID)
+
+		assert.Equal(t, "zz-nonexisting", results[1].TeamName)
+		assert.Nil(t, results[1].Team)
+		assert.ErrorIs(t, results[1].Err, ErrResourceNotFound)
+	})
+
+	t.Run("when reading by SSO team ID", func(t *testing.T) {
+		tm, err := client.Teams.ReadBySSOTeamID(ctx, orgTest.Name, ssoTeamID)
+		require.NoError(t, err)
+		assert.Equal(t, tmTest.ID, tm.ID)
+
+		tm, err = client.Teams.ReadBySSOTeamID(ctx, orgTest.Name, randomString(t))
+		assert.Nil(t, tm)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("without a mapping", func(t *testing.T) {
+		results, err := client.Teams.MapSSOTeamIDs(ctx, orgTest.Name, nil)
+		assert.Nil(t, results)
+		assert.Equal(t, ErrRequiredTeam, err)
+	})
+
+	t.Run("without an SSO team ID", func(t *testing.T) {
+		tm, err := client.Teams.ReadBySSOTeamID(ctx, orgTest.Name, "")
+		assert.Nil(t, tm)
+		assert.Equal(t, ErrRequiredSSOTeamID, err)
+	})
+
+	t.Run("with invalid organization", func(t *testing.T) {
+		results, err := client.Teams.MapSSOTeamIDs(ctx, badIdentifier, map[string]string{tmTest.Name: ssoTeamID})
+		assert.Nil(t, results)
+		assert.EqualError(t, err, ErrInvalidOrg.Error())
+	})
+}
+
 func TestTeam_Unmarshal(t *testing.T) {
 	data := map[string]interface{}{
 		"data": map[string]interface{}{
@@ -321,12 +379,19 @@ func TestTeam_Unmarshal(t *testing.T) {
 			"attributes": map[string]interface{}{
 				"name": "team hashi",
 				"organization-access": map[string]interface{}{
-					"manage-policies":     true,
-					"manage-workspaces":   true,
-					"manage-vcs-settings": true,
-					"manage-projects":     true,
-					"read-workspaces":     true,
-					"read-projects":       true,
+					"manage-policies":            true,
+					"manage-workspaces":          true,
+					"manage-vcs-settings":        true,
+					"manage-projects":            true,
+					"read-workspaces":            true,
+					"read-projects":              true,
+					"manage-teams":               true,
+					"manage-organization-access": true,
+					"access-secret-teams":        true,
+					"manage-agent-pools":         true,
+					"delegate-policy-overrides":  true,
+					"manage-public-providers":    true,
+					"manage-public-modules":      true,
 				},
 				"permissions": map[string]interface{}{
 					"can-destroy":           true,
@@ -352,6 +417,14 @@ func TestTeam_Unmarshal(t *testing.T) {
 	assert.Equal(t, team.OrganizationAccess.ManageProjects, true)
 	assert.Equal(t, team.OrganizationAccess.ReadWorkspaces, true)
 	assert.Equal(t, team.OrganizationAccess.ReadProjects, true)
+	assert.Equal(t, team.OrganizationAccess.ManageTeams, true)
+	assert.Equal(t, team.OrganizationAccess.ManageOrganizationAccess, true)
+	assert.Equal(t, team.OrganizationAccess.AccessSecretTeams, true)
+	assert.Equal(t, team.OrganizationAccess.ManageAgentPools, true)
+	assert.Equal(t, team.OrganizationAccess.DelegatePolicyOverrides, true)
+	assert.Equal(t, team.OrganizationAccess.ManagePublicProviders, true)
+	assert.Equal(t, team.OrganizationAccess.ManagePublicModules, true)
+	assert.Equal(t, team.OrganizationAccess.ManageModules, false)
 	assert.Equal(t, team.Permissions.CanDestroy, true)
 	assert.Equal(t, team.Permissions.CanUpdateMembership, true)
 }
@@ -362,7 +435,9 @@ func TestTeamCreateOptions_Marshal(t *testing.T) {
 		Visibility:                 String("organization"),
 		AllowMemberTokenManagement: Bool(true),
 		OrganizationAccess: &OrganizationAccessOptions{
-			ManagePolicies: Bool(true),
+			ManagePolicies:          Bool(true),
+			DelegatePolicyOverrides: Bool(true),
+			ManagePublicModules:     Bool(false),
 		},
 	}
 
@@ -373,7 +448,7 @@ func TestTeamCreateOptions_Marshal(t *testing.T) {
 	bodyBytes, err := req.BodyBytes()
 	require.NoError(t, err)
 
-	expectedBody := `{"data":{"type":"teams","attributes":{"allow-member-token-management":true,"name":"team name","organization-access":{"manage-policies":true},"visibility":"organization"}}}
+	expectedBody := `{"data":{"type":"teams","attributes":{"allow-member-token-management":true,"name":"team name","organization-access":{"manage-policies":true,"delegate-policy-overrides":true,"manage-public-modules":false},"visibility":"organization"}}}
 `
 	assert.Equal(t, expectedBody, string(bodyBytes))
 }
@@ -657,3 +732,42 @@ func TestTeamsUpdateManageAgentPools(t *testing.T) {
 	originalTeamAccess.ManageAgentPools = true
 	assert.Equal(t, originalTeamAccess, refreshed.OrganizationAccess)
 }
+
+func TestTeamsReadWithOptions(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/teams/team-1": `{
+			"data": {
+				"id": "team-1",
+				"type": "teams",
+				"attributes": {"name": "owners"},
+				"relationships": {
+					"users": {"data": [{"id": "user-1", "type": "users"}]},
+					"organization-memberships": {"data": [{"id": "ou-1", "type": "organization-memberships"}]}
+				}
+			},
+			"included": [
+				{"id": "user-1", "type": "users", "attributes": {"username": "alice"}},
+				{"id": "ou-1", "type": "organization-memberships", "attributes": {"email": "alice@example.com", "status": "active"}}
+			]
+		}`,
+	})
+	defer done()
+
+	team, err := client.Teams.ReadWithOptions(context.Background(), "team-1", &TeamReadOptions{
+		Include: []TeamIncludeOpt{TeamUsers, TeamOrganizationMemberships},
+	})
+	require.NoError(t, err)
+
+	require.Len(t, team.Users, 1)
+	assert.Equal(t, "alice", team.Users[0].Username)
+	require.Len(t, team.OrganizationMemberships, 1)
+	assert.Equal(t, "alice@example.com", team.OrganizationMemberships[0].Email)
+	assert.Equal(t, OrganizationMembershipActive, team.OrganizationMemberships[0].Status)
+
+	t.Run("with an invalid team ID", func(t *testing.T) {
+		_, err := client.Teams.ReadWithOptions(context.Background(), badIdentifier, nil)
+		assert.EqualError(t, err, ErrInvalidTeamID.Error())
+	})
+}
@@ -74,9 +74,7 @@ func (s *teamMembers) ListUsers(ctx context.Context, teamID string) ([]*User, er
 		return nil, ErrInvalidTeamID
 	}
 
-	options := struct {
-		Include []TeamIncludeOpt `url:"include,omitempty"`
-	}{
+	options := &TeamReadOptions{
 		Include: []TeamIncludeOpt{TeamUsers},
 	}
 
@@ -101,9 +99,7 @@ func (s *teamMembers) ListOrganizationMemberships(ctx context.Context, teamID st
 		return nil, ErrInvalidTeamID
 	}
 
-	options := struct {
-		Include []TeamIncludeOpt `url:"include,omitempty"`
-	}{
+	options := &TeamReadOptions{
 		Include: []TeamIncludeOpt{TeamOrganizationMemberships},
 	}
 
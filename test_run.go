@@ -4,10 +4,13 @@
 package tfe
 
 import (
+	"bufio"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/url"
+	"sort"
 	"time"
 )
 
@@ -31,6 +34,10 @@ type TestRuns interface {
 	// Logs retrieves the logs for a test run by its ID.
 	Logs(ctx context.Context, moduleID RegistryModuleID, testRunID string) (io.Reader, error)
 
+	// ReadResults reads the results of the run blocks of a test run from its
+	// structured logs.
+	ReadResults(ctx context.Context, moduleID RegistryModuleID, testRunID string) (*TestRunResults, error)
+
 	// Cancel a test run by its ID.
 	Cancel(ctx context.Context, moduleID RegistryModuleID, testRunID string) error
 
@@ -133,6 +140,43 @@ type TestRunCreateOptions struct {
 	RegistryModule *RegistryModule `jsonapi:"relation,registry-module"`
 }
 
+// TestRunResults represents the results of a test run, as reported by
+// Terraform in its structured logs.
+type TestRunResults struct {
+	// The overall status of the test run, and the number of run blocks per
+	// status, or empty when the test run stopped before reporting them.
+	Status  TestStatus
+	Passed  int
+	Failed  int
+	Errored int
+	Skipped int
+
+	// The test files of the test run, in the order Terraform runs them.
+	Files []*TestFileResult
+}
+
+// TestFileResult represents the results of a test file.
+type TestFileResult struct {
+	Path   string
+	Status TestStatus
+
+	// The run blocks of the test file, in the order they are declared.
+	Runs []*TestRunBlockResult
+
+	// The diagnostics reported for the test file outside of its run blocks.
+	Diagnostics []*RunDiagnostic
+}
+
+// TestRunBlockResult represents the result of a run block of a test file.
+type TestRunBlockResult struct {
+	Name   string
+	Status TestStatus
+
+	// The diagnostics reported for the run block, such as the failed
+	// assertions.
+	Diagnostics []*RunDiagnostic
+}
+
 // TestRunList represents a list of test runs.
 type TestRunList struct {
 	*Pagination
@@ -271,6 +315,19 @@ func (s *testRuns) Logs(ctx context.Context, moduleID RegistryModuleID, testRunI
 	}, nil
 }
 
+// ReadResults reads the structured logs of a test run and returns the
+// results of its test files and run blocks. The logs of a test run in
+// progress are read until it has stopped, so ReadResults blocks until then.
+// Log lines that are not structured are ignored.
+func (s *testRuns) ReadResults(ctx context.Context, moduleID RegistryModuleID, testRunID string) (*TestRunResults, error) {
+	logs, err := s.Logs(ctx, moduleID, testRunID)
+	if err != nil {
+		return nil, err
+	}
+
+	return readTestResults(logs)
+}
+
 // Cancel a test run by its ID.
 func (s *testRuns) Cancel(ctx context.Context, moduleID RegistryModuleID, testRunID string) error {
 	if err := moduleID.valid(); err != nil {
@@ -309,6 +366,128 @@ func (s *testRuns) ForceCancel(ctx context.Context, moduleID RegistryModuleID, t
 	return req.Do(ctx, nil)
 }
 
+// Failures returns the run blocks of all test files that failed or errored.
+func (r *TestRunResults) Failures() []*TestRunBlockResult {
+	var failures []*TestRunBlockResult
+	for _, f := range r.Files {
+		for _, run := range f.Runs {
+			if run.Status == TestFail || run.Status == TestError {
+				failures = append(failures, run)
+			}
+		}
+	}
+	return failures
+}
+
+// file returns the result of the test file with the given path, adding it
+// when it was not reported yet.
+func (r *TestRunResults) file(path string) *TestFileResult {
+	for _, f := range r.Files {
+		if f.Path == path {
+			return f
+		}
+	}
+	f := &TestFileResult{Path: path, Status: TestPending}
+	r.Files = append(r.Files, f)
+	return f
+}
+
+// run returns the result of the run block with the given name, adding it
+// when it was not reported yet.
+func (f *TestFileResult) run(name string) *TestRunBlockResult {
+	for _, run := range f.Runs {
+		if run.Name == name {
+			return run
+		}
+	}
+	run := &TestRunBlockResult{Name: name, Status: TestPending}
+	f.Runs = append(f.Runs, run)
+	return run
+}
+
+// readTestResults reads the results of the structured log lines of the logs
+// of a test run. See the JSON output format of terraform test for the
+// messages:
+// https://developer.hashicorp.com/terraform/internals/machine-readable-ui
+func readTestResults(logs io.Reader) (*TestRunResults, error) {
+	scanner := bufio.NewScanner(logs)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	results := &TestRunResults{}
+	for scanner.Scan() {
+		var line struct {
+			Type         string              `json:"type"`
+			TestFile     string              `json:"@testfile"`
+			TestRun      string              `json:"@testrun"`
+			TestAbstract map[string][]string `json:"test_abstract"`
+			Diagnostic   *RunDiagnostic      `json:"diagnostic"`
+			File         *struct {
+				Path   string     `json:"path"`
+				Status TestStatus `json:"status"`
+			} `json:"test_file"`
+			Run *struct {
+				Path   string     `json:"path"`
+				Run    string     `json:"run"`
+				Status TestStatus `json:"status"`
+			} `json:"test_run"`
+			Summary *struct {
+				Status  TestStatus `json:"status"`
+				Passed  int        `json:"passed"`
+				Failed  int        `json:"failed"`
+				Errored int        `json:"errored"`
+				Skipped int        `json:"skipped"`
+			} `json:"test_summary"`
+		}
+		// Lines that are not JSON are not part of the structured logs.
+		if err := json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			continue
+		}
+
+		switch {
+		case line.Type == "test_abstract":
+			// The abstract lists the run blocks of each test file before any
+			// of them are executed. Being a map, its files are in no
+			// particular order, so they are sorted by path.
+			paths := make([]string, 0, len(line.TestAbstract))
+			for path := range line.TestAbstract {
+				paths = append(paths, path)
+			}
+			sort.Strings(paths)
+			for _, path := range paths {
+				f := results.file(path)
+				for _, name := range line.TestAbstract[path] {
+					f.run(name)
+				}
+			}
+		case line.Type == "test_file" && line.File != nil:
+			if line.File.Status != "" {
+				results.file(line.File.Path).Status = line.File.Status
+			}
+		case line.Type == "test_run" && line.Run != nil:
+			if line.Run.Status != "" {
+				results.file(line.Run.Path).run(line.Run.Run).Status = line.Run.Status
+			}
+		case line.Type == "test_summary" && line.Summary != nil:
+			results.Status = line.Summary.Status
+			results.Passed = line.Summary.Passed
+			results.Failed = line.Summary.Failed
+			results.Errored = line.Summary.Errored
+			results.Skipped = line.Summary.Skipped
+		case line.Type == "diagnostic" && line.Diagnostic != nil && line.TestFile != "":
+			line.Diagnostic.Stage = "test"
+			f := results.file(line.TestFile)
+			if line.TestRun == "" {
+				f.Diagnostics = append(f.Diagnostics, line.Diagnostic)
+			} else {
+				run := f.run(line.TestRun)
+				run.Diagnostics = append(run.Diagnostics, line.Diagnostic)
+			}
+		}
+	}
+
+	return results, scanner.Err()
+}
+
 func (o TestRunCreateOptions) valid() error {
 	if o.ConfigurationVersion == nil {
 		return ErrInvalidConfigVersionID
@@ -5,7 +5,10 @@ package tfe
 
 import (
 	"context"
+	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -291,3 +294,90 @@ func TestTestRunsCancel(t *testing.T) {
 		assert.Equal(t, err, ErrResourceNotFound)
 	})
 }
+
+func TestTestRunsReadResults(t *testing.T) {
+	t.Parallel()
+
+	logs := `{"@level":"info","@message":"Terraform 1.9.0","type":"version"}
+{"@level":"info","@message":"Found 2 files and 3 run blocks","test_abstract":{"tests/main.tftest.hcl":["setup","check_name"],"tests/defaults.tftest.hcl":["defaults"]},"type":"test_abstract"}
+{"@level":"info","@message":"tests/defaults.tftest.hcl... in progress","@testfile":"tests/defaults.tftest.hcl","test_file":{"path":"tests/defaults.tftest.hcl","progress":"starting"},"type":"test_file"}
+{"@level":"info","@message":"  \"defaults\"... pass","@testfile":"tests/defaults.tftest.hcl","@testrun":"defaults","test_run":{"path":"tests/defaults.tftest.hcl","run":"defaults","progress":"complete","status":"pass"},"type":"test_run"}
+{"@level":"info","@message":"tests/defaults.tftest.hcl... pass","@testfile":"tests/defaults.tftest.hcl","test_file":{"path":"tests/defaults.tftest.hcl","progress":"complete","status":"pass"},"type":"test_file"}
+Plain text is ignored
+{"@level":"info","@message":"  \"setup\"... pass","@testfile":"tests/main.tftest.hcl","@testrun":"setup","test_run":{"path":"tests/main.tftest.hcl","run":"setup","progress":"complete","status":"pass"},"type":"test_run"}
+{"@level":"error","@message":"Error: Test assertion failed","@testfile":"tests/main.tftest.hcl","@testrun":"check_name","diagnostic":{"severity":"error","summary":"Test assertion failed","detail":"name did not match"},"type":"diagnostic"}
+{"@level":"info","@message":"  \"check_name\"... fail","@testfile":"tests/main.tftest.hcl","@testrun":"check_name","test_run":{"path":"tests/main.tftest.hcl","run":"check_name","progress":"complete","status":"fail"},"type":"test_run"}
+{"@level":"warn","@message":"Warning: Resource not destroyed","@testfile":"tests/main.tftest.hcl","diagnostic":{"severity":"warning","summary":"Resource not destroyed"},"type":"diagnostic"}
+{"@level":"info","@message":"tests/main.tftest.hcl... fail","@testfile":"tests/main.tftest.hcl","test_file":{"path":"tests/main.tftest.hcl","progress":"complete","status":"fail"},"type":"test_file"}
+{"@level":"info","@message":"Failure! 2 passed, 1 failed.","test_summary":{"status":"fail","passed":2,"failed":1,"errored":0,"skipped":0},"type":"test_summary"}
+`
+	trPath := "/api/v2/organizations/acme/tests/registry-modules/private/acme/vpc/aws/test-runs/tr-1"
+
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case trPath:
+			fmt.Fprintf(w, `{"data":{"id":"tr-1","type":"test-runs","attributes":{"status":"finished","log-read-url":"%s/logs"}}}`, srvURL)
+		case "/logs":
+			content := "\x02" + logs + "\x03"
+			var offset int
+			_, _ = fmt.Sscan(r.URL.Query().Get("offset"), &offset)
+			if offset < len(content) {
+				fmt.Fprint(w, content[offset:])
+			}
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	srvURL = srv.URL
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	id := RegistryModuleID{
+		Organization: "acme",
+		Name:         "vpc",
+		Provider:     "aws",
+		Namespace:    "acme",
+		RegistryName: PrivateRegistry,
+	}
+
+	t.Run("of a test run with a failure", func(t *testing.T) {
+		results, err := client.TestRuns.ReadResults(ctx, id, "tr-1")
+		require.NoError(t, err)
+
+		assert.Equal(t, TestFail, results.Status)
+		assert.Equal(t, 2, results.Passed)
+		assert.Equal(t, 1, results.Failed)
+
+		require.Len(t, results.Files, 2)
+		assert.Equal(t, "tests/defaults.tftest.hcl", results.Files[0].Path)
+		assert.Equal(t, TestPass, results.Files[0].Status)
+
+		main := results.Files[1]
+		assert.Equal(t, "tests/main.tftest.hcl", main.Path)
+		assert.Equal(t, TestFail, main.Status)
+		require.Len(t, main.Runs, 2)
+		assert.Equal(t, "setup", main.Runs[0].Name)
+		assert.Equal(t, TestPass, main.Runs[0].Status)
+		require.Len(t, main.Diagnostics, 1)
+		assert.Equal(t, "Resource not destroyed", main.Diagnostics[0].Summary)
+
+		failures := results.Failures()
+		require.Len(t, failures, 1)
+		assert.Equal(t, "check_name", failures[0].Name)
+		require.Len(t, failures[0].Diagnostics, 1)
+		assert.Equal(t, "test", failures[0].Diagnostics[0].Stage)
+		assert.Equal(t, "name did not match", failures[0].Diagnostics[0].Detail)
+	})
+
+	t.Run("without a valid test run ID", func(t *testing.T) {
+		_, err := client.TestRuns.ReadResults(ctx, id, badIdentifier)
+		assert.Equal(t, ErrInvalidTestRunID, err)
+	})
+}
@@ -6,6 +6,7 @@ package tfe
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -26,19 +27,24 @@ import (
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	retryablehttp "github.com/hashicorp/go-retryablehttp"
 	"github.com/hashicorp/jsonapi"
+	"golang.org/x/sync/errgroup"
+	"golang.org/x/sync/singleflight"
 	"golang.org/x/time/rate"
 
 	slug "github.com/hashicorp/go-slug"
 )
 
 const (
-	_userAgent         = "go-tfe"
-	_headerRateLimit   = "X-RateLimit-Limit"
-	_headerRateReset   = "X-RateLimit-Reset"
-	_headerAppName     = "TFP-AppName"
-	_headerAPIVersion  = "TFP-API-Version"
-	_headerTFEVersion  = "X-TFE-Version"
-	_includeQueryParam = "include"
+	_userAgent           = "go-tfe"
+	_headerRateLimit     = "X-RateLimit-Limit"
+	_headerRateRemaining = "X-RateLimit-Remaining"
+	_headerRateReset     = "X-RateLimit-Reset"
+	_headerRetryAfter    = "Retry-After"
+	_headerRequestID     = "X-Request-Id"
+	_headerAppName       = "TFP-AppName"
+	_headerAPIVersion    = "TFP-API-Version"
+	_headerTFEVersion    = "X-TFE-Version"
+	_includeQueryParam   = "include"
 
 	DefaultAddress      = "https://app.terraform.io"
 	DefaultBasePath     = "/api/v2/"
@@ -67,6 +73,11 @@ type Config struct {
 	// API token used to access the Terraform Enterprise API.
 	Token string
 
+	// TokenSource provides the API tokens of the client, instead of Token,
+	// when they are issued dynamically. Use DefaultTokenSource to read the
+	// token of the Terraform CLI, e.g. as written by `terraform login`.
+	TokenSource TokenSource
+
 	// Headers that will be added to every request.
 	Headers http.Header
 
@@ -78,6 +89,38 @@ type Config struct {
 
 	// RetryServerErrors enables the retry logic in the client.
 	RetryServerErrors bool
+
+	// TLSConfig is used for connections to the Terraform Enterprise API, e.g.
+	// to trust a private CA bundle or to present a client certificate when TFE
+	// sits behind mTLS.
+	TLSConfig *tls.Config
+
+	// MaxIdleConnsPerHost controls the maximum idle (keep-alive) connections
+	// kept per host. Zero leaves the transport default in place.
+	MaxIdleConnsPerHost int
+
+	// DisableHTTP2 forces the client to use HTTP/1.1.
+	DisableHTTP2 bool
+
+	// CoalesceReads makes concurrent identical GET requests share a single
+	// request to the API: while a request is in flight, identical requests
+	// wait for its response instead of being sent as well. This reduces the
+	// API usage of highly concurrent callers, such as controllers reading the
+	// same workspace from many goroutines. Downloads, whose responses are
+	// streamed to an io.Writer, are never coalesced.
+	CoalesceReads bool
+
+	// ReadCache caches the responses of GET requests, serving them without
+	// a request to the API while they are fresh and revalidating them with
+	// their ETag once stale. This reduces the API usage of callers reading
+	// the same resources over and over, such as dashboards.
+	ReadCache *ReadCacheConfig
+
+	// PollingBackoff sets how long the polling helpers of the client, such as
+	// Runs.CancelOrDiscardAndWait, wait between their reads, overriding their
+	// own defaults. A poll interval given in the options of a call takes
+	// precedence.
+	PollingBackoff *Backoff
 }
 
 // DefaultConfig returns a default config structure.
@@ -108,15 +151,54 @@ func DefaultConfig() *Config {
 	return config
 }
 
+// tunedHTTPClient returns the HTTP client to use for API requests. When any
+// transport settings are configured, they are applied to a copy of the
+// configured client's transport so the caller's client is left untouched.
+func (c *Config) tunedHTTPClient() (*http.Client, error) {
+	if c.TLSConfig == nil && c.MaxIdleConnsPerHost == 0 && !c.DisableHTTP2 {
+		return c.HTTPClient, nil
+	}
+
+	var transport *http.Transport
+	switch t := c.HTTPClient.Transport.(type) {
+	case nil:
+		transport = http.DefaultTransport.(*http.Transport).Clone()
+	case *http.Transport:
+		transport = t.Clone()
+	default:
+		return nil, ErrUnsupportedTransport
+	}
+
+	if c.TLSConfig != nil {
+		transport.TLSClientConfig = c.TLSConfig.Clone()
+	}
+	if c.MaxIdleConnsPerHost > 0 {
+		transport.MaxIdleConnsPerHost = c.MaxIdleConnsPerHost
+	}
+	if c.DisableHTTP2 {
+		// A non-nil, empty TLSNextProto map disables HTTP/2 support.
+		transport.ForceAttemptHTTP2 = false
+		transport.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+
+	httpClient := *c.HTTPClient
+	httpClient.Transport = transport
+
+	return &httpClient, nil
+}
+
 // Client is the Terraform Enterprise API client. It provides the basic
 // connectivity and configuration for accessing the TFE API
 type Client struct {
 	baseURL           *url.URL
 	registryBaseURL   *url.URL
 	token             string
+	tokenSource       TokenSource
 	headers           http.Header
 	http              *retryablehttp.Client
 	limiter           *rate.Limiter
+	reads             *singleflight.Group
+	polling           *Backoff
 	retryLogHook      RetryLogHook
 	retryServerErrors bool
 	remoteAPIVersion  string
@@ -127,11 +209,15 @@ type Client struct {
 	Agents                     Agents
 	AgentPools                 AgentPools
 	AgentTokens                AgentTokens
+	Analytics                  Analytics
 	Applies                    Applies
 	AuditTrails                AuditTrails
+	AuthenticationTokens       AuthenticationTokens
+	Campaigns                  Campaigns
 	Comments                   Comments
 	ConfigurationVersions      ConfigurationVersions
 	CostEstimates              CostEstimates
+	Diagnostics                Diagnostics
 	GHAInstallations           GHAInstallations
 	GPGKeys                    GPGKeys
 	NotificationConfigurations NotificationConfigurations
@@ -150,6 +236,7 @@ type Client struct {
 	PolicySetParameters        PolicySetParameters
 	PolicySetVersions          PolicySetVersions
 	PolicySets                 PolicySets
+	Previews                   Previews
 	RegistryModules            RegistryModules
 	RegistryNoCodeModules      RegistryNoCodeModules
 	RegistryProviders          RegistryProviders
@@ -164,11 +251,13 @@ type Client struct {
 	Stacks                     Stacks
 	StackConfigurations        StackConfigurations
 	StackDeployments           StackDeployments
+	StackDeploymentGroups      StackDeploymentGroups
 	StackPlans                 StackPlans
 	StackPlanOperations        StackPlanOperations
 	StackSources               StackSources
 	StateVersionOutputs        StateVersionOutputs
 	StateVersions              StateVersions
+	StructuredRunOutputs       StructuredRunOutputs
 	TaskResults                TaskResults
 	TaskStages                 TaskStages
 	Teams                      Teams
@@ -251,6 +340,17 @@ func (c *Client) doForeignPUTRequest(ctx context.Context, foreignURL string, dat
 // specified. For GET requests, the reqBody is encoded as query parameters.
 // For DELETE, PATCH, and POST requests, the request body is serialized as JSONAPI.
 // For PUT requests, the request body is sent as a stream of bytes.
+//
+// NewRequest is the supported way to call endpoints the client does not
+// cover yet, and is kept stable for that purpose. The path is relative to the
+// API base path, e.g. "organizations/hashicorp/workspaces". The reqBody of a
+// GET request is a struct whose fields are tagged with `url:"..."`, like the
+// list options of the services, and may embed ListOptions. The reqBody of a
+// DELETE, PATCH or POST request is a pointer to a struct whose fields are
+// tagged with `jsonapi:"..."`, like the create options of the services, a
+// slice of such pointers, or a pointer to a struct tagged with `json:"..."`
+// for endpoints taking plain JSON. The returned request is sent with
+// ClientRequest.Do, which also decodes its response.
 func (c *Client) NewRequest(method, path string, reqBody any) (*ClientRequest, error) {
 	return c.NewRequestWithAdditionalQueryParams(method, path, reqBody, nil)
 }
@@ -292,7 +392,7 @@ func (c *Client) NewRequestWithAdditionalQueryParams(method, path string, reqBod
 
 		// Encode the reqBody as query parameters
 		if reqBody != nil {
-			q, err = query.Values(reqBody)
+			q, err = decodeQueryParams(reqBody)
 			if err != nil {
 				return nil, err
 			}
@@ -336,12 +436,20 @@ func (c *Client) NewRequestWithAdditionalQueryParams(method, path string, reqBod
 		req.Header[k] = v
 	}
 
-	return &ClientRequest{
+	request := &ClientRequest{
 		retryableRequest: req,
 		http:             c.http,
 		limiter:          c.limiter,
+		tokens:           c.tokenSource,
 		Header:           req.Header,
-	}, nil
+	}
+
+	// Only GET requests are coalesced, as they do not change anything.
+	if method == "GET" {
+		request.reads = c.reads
+	}
+
+	return request, nil
 }
 
 // NewClient creates a new Terraform Enterprise API client.
@@ -362,6 +470,7 @@ func NewClient(cfg *Config) (*Client, error) {
 		if cfg.Token != "" {
 			config.Token = cfg.Token
 		}
+		config.TokenSource = cfg.TokenSource
 		for k, v := range cfg.Headers {
 			config.Headers[k] = v
 		}
@@ -372,6 +481,22 @@ func NewClient(cfg *Config) (*Client, error) {
 			config.RetryLogHook = cfg.RetryLogHook
 		}
 		config.RetryServerErrors = cfg.RetryServerErrors
+		config.TLSConfig = cfg.TLSConfig
+		config.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+		config.DisableHTTP2 = cfg.DisableHTTP2
+		config.CoalesceReads = cfg.CoalesceReads
+		config.ReadCache = cfg.ReadCache
+		config.PollingBackoff = cfg.PollingBackoff
+	}
+
+	if config.ReadCache != nil && config.ReadCache.Cache == nil {
+		return nil, ErrRequiredCache
+	}
+
+	// Apply any transport settings to the HTTP client.
+	httpClient, err := config.tunedHTTPClient()
+	if err != nil {
+		return nil, err
 	}
 
 	// Parse the address to make sure its a valid URL.
@@ -385,6 +510,23 @@ func NewClient(cfg *Config) (*Client, error) {
 		baseURL.Path += "/"
 	}
 
+	// Cache the responses of GET requests on a copy of the HTTP client.
+	if config.ReadCache != nil {
+		transport := httpClient.Transport
+		if transport == nil {
+			transport = http.DefaultTransport
+		}
+
+		cachingClient := *httpClient
+		cachingClient.Transport = &cachingTransport{
+			next:    transport,
+			config:  *config.ReadCache,
+			baseURL: baseURL,
+			now:     time.Now,
+		}
+		httpClient = &cachingClient
+	}
+
 	registryURL, err := url.Parse(config.Address)
 	if err != nil {
 		return nil, fmt.Errorf("invalid address: %w", err)
@@ -395,6 +537,17 @@ func NewClient(cfg *Config) (*Client, error) {
 		registryURL.Path += "/"
 	}
 
+	// A token source takes precedence over the token.
+	if config.TokenSource != nil {
+		config.Token, err = config.TokenSource.Token(context.Background(), false)
+		if errors.Is(err, ErrNoToken) {
+			return nil, fmt.Errorf("missing API token")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("failed to get API token: %w", err)
+		}
+	}
+
 	// This value must be provided by the user.
 	if config.Token == "" {
 		return nil, fmt.Errorf("missing API token")
@@ -405,16 +558,22 @@ func NewClient(cfg *Config) (*Client, error) {
 		baseURL:           baseURL,
 		registryBaseURL:   registryURL,
 		token:             config.Token,
+		tokenSource:       config.TokenSource,
 		headers:           config.Headers,
+		polling:           config.PollingBackoff,
 		retryLogHook:      config.RetryLogHook,
 		retryServerErrors: config.RetryServerErrors,
 	}
 
+	if config.CoalesceReads {
+		client.reads = &singleflight.Group{}
+	}
+
 	client.http = &retryablehttp.Client{
 		Backoff:      client.retryHTTPBackoff,
 		CheckRetry:   client.retryHTTPCheck,
 		ErrorHandler: retryablehttp.PassthroughErrorHandler,
-		HTTPClient:   config.HTTPClient,
+		HTTPClient:   httpClient,
 		RetryWaitMin: 100 * time.Millisecond,
 		RetryWaitMax: 400 * time.Millisecond,
 		RetryMax:     30,
@@ -454,12 +613,16 @@ func NewClient(cfg *Config) (*Client, error) {
 	client.AgentPools = &agentPools{client: client}
 	client.Agents = &agents{client: client}
 	client.AgentTokens = &agentTokens{client: client}
+	client.Analytics = &analytics{client: client}
 	client.Applies = &applies{client: client}
 	client.AuditTrails = &auditTrails{client: client}
+	client.AuthenticationTokens = &authenticationTokens{client: client}
+	client.Campaigns = &campaigns{client: client}
 	client.Comments = &comments{client: client}
 	client.ConfigurationVersions = &configurationVersions{client: client}
 	client.GHAInstallations = &gHAInstallations{client: client}
 	client.CostEstimates = &costEstimates{client: client}
+	client.Diagnostics = &diagnostics{client: client}
 	client.GPGKeys = &gpgKeys{client: client}
 	client.RegistryNoCodeModules = &registryNoCodeModules{client: client}
 	client.NotificationConfigurations = &notificationConfigurations{client: client}
@@ -478,6 +641,7 @@ func NewClient(cfg *Config) (*Client, error) {
 	client.PolicySetParameters = &policySetParameters{client: client}
 	client.PolicySets = &policySets{client: client}
 	client.PolicySetVersions = &policySetVersions{client: client}
+	client.Previews = &previews{client: client}
 	client.Projects = &projects{client: client}
 	client.RegistryModules = &registryModules{client: client}
 	client.RegistryProviderPlatforms = &registryProviderPlatforms{client: client}
@@ -492,11 +656,13 @@ func NewClient(cfg *Config) (*Client, error) {
 	client.Stacks = &stacks{client: client}
 	client.StackConfigurations = &stackConfigurations{client: client}
 	client.StackDeployments = &stackDeployments{client: client}
+	client.StackDeploymentGroups = &stackDeploymentGroups{client: client}
 	client.StackPlans = &stackPlans{client: client}
 	client.StackPlanOperations = &stackPlanOperations{client: client}
 	client.StackSources = &stackSources{client: client}
 	client.StateVersionOutputs = &stateVersionOutputs{client: client}
 	client.StateVersions = &stateVersions{client: client}
+	client.StructuredRunOutputs = &structuredRunOutputs{client: client}
 	client.TaskResults = &taskResults{client: client}
 	client.TaskStages = &taskStages{client: client}
 	client.TeamAccess = &teamAccesses{client: client}
@@ -635,8 +801,8 @@ func (c *Client) retryHTTPBackoff(min, max time.Duration, attemptNum int, resp *
 }
 
 // rateLimitBackoff provides a callback for Client.Backoff which will use the
-// X-RateLimit_Reset header to determine the time to wait. We add some jitter
-// to prevent a thundering herd.
+// X-RateLimit_Reset header, or else the Retry-After header, to determine the
+// time to wait. We add some jitter to prevent a thundering herd.
 //
 // min and max are mainly used for bounding the jitter that will be added to
 // the reset time retrieved from the headers. But if the final wait time is
@@ -658,6 +824,10 @@ func rateLimitBackoff(min, max time.Duration, resp *http.Response) time.Duration
 		if reset > 0 && time.Duration(reset*1e9) > min {
 			min = time.Duration(reset * 1e9)
 		}
+	} else if resp != nil {
+		if retryAfter := parseRetryAfter(resp.Header, time.Now()); retryAfter > min {
+			min = retryAfter
+		}
 	}
 
 	return min + jitter
@@ -781,14 +951,69 @@ func encodeQueryParams(v url.Values) string {
 // decodeQueryParams types an object and converts the struct fields into
 // Query Parameters, which can be used with NewRequestWithAdditionalQueryParams
 // Note that a field without a `url` annotation will be converted into a query
-// parameter. Use url:"-" to ignore struct fields.
+// parameter. Use url:"-" to ignore struct fields. Setting more than one field
+// tagged with the same query parameter, such as a deprecated comma-separated
+// filter and the list field replacing it, is an error.
 func decodeQueryParams(v any) (url.Values, error) {
 	if v == nil {
 		return make(url.Values, 0), nil
 	}
+	if name := duplicateQueryParam(reflect.ValueOf(v), map[string]bool{}); name != "" {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedDuplicateQueryParam, name)
+	}
 	return query.Values(v)
 }
 
+// duplicateQueryParam returns the name of the first query parameter set by
+// more than one field of the struct v points to, including the fields of its
+// embedded structs, or "" if there is none. seen holds the names of the query
+// parameters set so far.
+func duplicateQueryParam(v reflect.Value, seen map[string]bool) string {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return ""
+	}
+
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		if !sf.IsExported() && !sf.Anonymous {
+			continue
+		}
+		tag := sf.Tag.Get("url")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+
+		fv := v.Field(i)
+		if sf.Anonymous && name == "" {
+			if dup := duplicateQueryParam(fv, seen); dup != "" {
+				return dup
+			}
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		if fv.IsZero() || ((fv.Kind() == reflect.Slice || fv.Kind() == reflect.Map) && fv.Len() == 0) {
+			continue
+		}
+		if seen[name] {
+			return name
+		}
+		seen[name] = true
+	}
+
+	return ""
+}
+
 // serializeRequestBody serializes the given ptr or ptr slice into a JSON
 // request. It automatically uses jsonapi or json serialization, depending
 // on the body type's tags.
@@ -846,6 +1071,126 @@ func serializeRequestBody(v interface{}) (interface{}, error) {
 	return buf, nil
 }
 
+// attributesPatch is a JSON:API document used to send an arbitrary set of
+// attributes in a PATCH request. Only the given attributes are sent, which
+// allows partial updates of attributes that are not modeled by the typed
+// update options yet.
+type attributesPatch struct {
+	Data attributesPatchData `json:"data"`
+}
+
+type attributesPatchData struct {
+	Type       string                 `json:"type"`
+	ID         string                 `json:"id,omitempty"`
+	Attributes map[string]interface{} `json:"attributes"`
+}
+
+// newAttributesPatch builds the PATCH body for the given resource type and ID,
+// using the raw API attribute names (e.g. "auto-apply") as keys.
+func newAttributesPatch(resourceType, id string, attributes map[string]interface{}) (*attributesPatch, error) {
+	if len(attributes) == 0 {
+		return nil, ErrRequiredAttributes
+	}
+
+	return &attributesPatch{
+		Data: attributesPatchData{
+			Type:       resourceType,
+			ID:         id,
+			Attributes: attributes,
+		},
+	}, nil
+}
+
+// ResourceIdentifier identifies a resource by its JSON:API type and ID, e.g.
+// the project of a workspace as {Type: "projects", ID: "prj-123"}.
+type ResourceIdentifier struct {
+	Type string `json:"type"`
+	ID   string `json:"id"`
+}
+
+// RelationshipUpdateOptions represents the options for replacing a single
+// relationship of a resource.
+type RelationshipUpdateOptions struct {
+	// Required: The name of the relationship, as named by the API, e.g.
+	// "project" or "default-agent-pool".
+	Relationship string
+
+	// Optional: The related resource, or nil to clear the relationship.
+	Related *ResourceIdentifier
+
+	// Optional: Only update the resource if its ETag matches, sent as the
+	// If-Match header. Capture the ETag of a previous response with
+	// ContextWithResponseHeaderHook. Where the API supports it, a mismatch is
+	// rejected with a 412 Precondition Failed, which IsConflict reports.
+	IfMatch string
+}
+
+func (o RelationshipUpdateOptions) valid() error {
+	if !validString(&o.Relationship) {
+		return ErrRequiredRelationship
+	}
+	if o.Related != nil && (!validString(&o.Related.Type) || !validStringID(&o.Related.ID)) {
+		return ErrInvalidRelatedResource
+	}
+	return nil
+}
+
+// relationshipPatch is a JSON:API document used to replace a single
+// relationship in a PATCH request. No attributes are sent, so concurrent
+// changes to them are not overwritten.
+type relationshipPatch struct {
+	Data relationshipPatchData `json:"data"`
+}
+
+type relationshipPatchData struct {
+	Type          string                              `json:"type"`
+	ID            string                              `json:"id,omitempty"`
+	Relationships map[string]relationshipPatchLinkage `json:"relationships"`
+}
+
+// relationshipPatchLinkage marshals a nil Data as null, which clears the
+// relationship.
+type relationshipPatchLinkage struct {
+	Data *ResourceIdentifier `json:"data"`
+}
+
+// newRelationshipPatch builds the PATCH body for the given resource type and
+// ID, replacing the relationship of the options.
+func newRelationshipPatch(resourceType, id string, options RelationshipUpdateOptions) (*relationshipPatch, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	return &relationshipPatch{
+		Data: relationshipPatchData{
+			Type: resourceType,
+			ID:   id,
+			Relationships: map[string]relationshipPatchLinkage{
+				options.Relationship: {Data: options.Related},
+			},
+		},
+	}, nil
+}
+
+// newRelationshipRequest creates the PATCH request replacing a relationship
+// of the resource at path, with the If-Match header of the options.
+func (c *Client) newRelationshipRequest(path, resourceType, id string, options RelationshipUpdateOptions) (*ClientRequest, error) {
+	body, err := newRelationshipPatch(resourceType, id, options)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := c.NewRequest("PATCH", path, body)
+	if err != nil {
+		return nil, err
+	}
+	if options.IfMatch != "" {
+		req.Header.Set("If-Match", options.IfMatch)
+	}
+
+	return req, nil
+}
+
 func unmarshalResponse(responseBody io.Reader, model interface{}) error {
 	// Get the value of model so we can test if it's a struct.
 	dst := reflect.Indirect(reflect.ValueOf(model))
@@ -915,6 +1260,26 @@ type ListOptions struct {
 	PageSize int `url:"page[size],omitempty"`
 }
 
+// Fieldsets represents JSON:API sparse fieldsets: the attributes and
+// relationships to return for each type of resource of a response, keyed by
+// type, e.g. {"workspaces": {"name", "updated-at"}}. The attributes and
+// relationships not returned are left unset on the decoded resources.
+//
+// https://jsonapi.org/format/#fetching-sparse-fieldsets
+type Fieldsets map[string][]string
+
+// EncodeValues encodes the fieldsets as fields[type] query parameters, each
+// holding a comma-separated list of fields. It implements query.Encoder.
+func (f Fieldsets) EncodeValues(key string, v *url.Values) error {
+	for typ, fields := range f {
+		if typ == "" {
+			return ErrInvalidFieldsetType
+		}
+		v.Set(fmt.Sprintf("%s[%s]", key, typ), strings.Join(fields, ","))
+	}
+	return nil
+}
+
 // Pagination is used to return the pagination details of an API request.
 type Pagination struct {
 	CurrentPage  int `json:"current-page"`
@@ -939,27 +1304,85 @@ func parsePagination(body io.Reader) (*Pagination, error) {
 	return &raw.Meta.Pagination, nil
 }
 
+// forEachPage reads every page of a list, starting from the page set in
+// options. The list function reads the page options point at and returns its
+// pagination; forEachPage then moves options to the next page, until the last
+// page is read, list returns a nil pagination or list returns an error.
+func forEachPage(options *ListOptions, list func() (*Pagination, error)) error {
+	for {
+		p, err := list()
+		if err != nil {
+			return err
+		}
+		if p == nil || p.NextPage == 0 {
+			return nil
+		}
+		options.PageNumber = p.NextPage
+	}
+}
+
+// forEachConcurrently calls fn for each index up to n, running at most limit
+// calls at a time, and waits for all of them to return. A limit below one runs
+// the calls one at a time. fn records its own result, so a failed call does not
+// stop the others.
+func forEachConcurrently(n, limit int, fn func(i int)) {
+	if limit < 1 {
+		limit = 1
+	}
+
+	var g errgroup.Group
+	g.SetLimit(limit)
+	for i := 0; i < n; i++ {
+		i := i
+		g.Go(func() error {
+			fn(i)
+			return nil
+		})
+	}
+	_ = g.Wait()
+}
+
 // checkResponseCode refines typical API errors into more specific errors
-// if possible. It returns nil if the response code < 400
+// if possible. It returns nil if the response code < 400. Error responses
+// matching one of the sentinel errors, such as ErrResourceNotFound, return
+// that error as is, so it can be compared with ==; other error responses
+// return an *APIError.
 func checkResponseCode(r *http.Response) error {
 	if r.StatusCode >= 200 && r.StatusCode <= 399 {
 		return nil
 	}
 
-	var errs []string
-	var err error
+	objs, decodeErr := decodeErrorPayload(r)
+	errs := errorPayloadMessages(objs)
 
+	if err := sentinelResponseError(r, errs, decodeErr); err != nil {
+		return err
+	}
+
+	err := decodeErr
+	if err == nil {
+		err = errors.New(strings.Join(errs, "\n"))
+	}
+
+	return &APIError{
+		StatusCode: r.StatusCode,
+		Errors:     objs,
+		RequestID:  r.Header.Get(_headerRequestID),
+		RateLimit:  parseRateLimit(r.Header),
+		RetryAfter: parseRetryAfter(r.Header, time.Now()),
+		err:        err,
+	}
+}
+
+// sentinelResponseError returns the sentinel error matching an error
+// response, or nil if none matches. decodeErr is the error returned when the
+// error document could not be decoded.
+func sentinelResponseError(r *http.Response, errs []string, decodeErr error) error {
 	switch r.StatusCode {
 	case 400:
-		errs, err = decodeErrorPayload(r)
-		if err != nil {
-			return err
-		}
-
-		if errorPayloadContains(errs, "Invalid include parameter") {
+		if decodeErr == nil && errorPayloadContains(errs, "Invalid include parameter") {
 			return ErrInvalidIncludeValue
 		}
-		return errors.New(strings.Join(errs, "\n"))
 	case 401:
 		return ErrUnauthorized
 	case 404:
@@ -969,9 +1392,8 @@ func checkResponseCode(r *http.Response) error {
 		case strings.HasSuffix(r.Request.URL.Path, "actions/lock"):
 			return ErrWorkspaceLocked
 		case strings.HasSuffix(r.Request.URL.Path, "actions/unlock"):
-			errs, err = decodeErrorPayload(r)
-			if err != nil {
-				return err
+			if decodeErr != nil {
+				return nil
 			}
 
 			if errorPayloadContains(errs, "is locked by Run") {
@@ -990,9 +1412,8 @@ func checkResponseCode(r *http.Response) error {
 		case strings.HasSuffix(r.Request.URL.Path, "actions/force-unlock"):
 			return ErrWorkspaceNotLocked
 		case strings.HasSuffix(r.Request.URL.Path, "actions/safe-delete"):
-			errs, err = decodeErrorPayload(r)
-			if err != nil {
-				return err
+			if decodeErr != nil {
+				return nil
 			}
 			if errorPayloadContains(errs, "locked") {
 				return ErrWorkspaceLockedCannotDelete
@@ -1005,25 +1426,24 @@ func checkResponseCode(r *http.Response) error {
 		}
 	}
 
-	errs, err = decodeErrorPayload(r)
-	if err != nil {
-		return err
-	}
-
-	return errors.New(strings.Join(errs, "\n"))
+	return nil
 }
 
-func decodeErrorPayload(r *http.Response) ([]string, error) {
+func decodeErrorPayload(r *http.Response) ([]*jsonapi.ErrorObject, error) {
 	// Decode the error payload.
-	var errs []string
 	errPayload := &jsonapi.ErrorsPayload{}
 	err := json.NewDecoder(r.Body).Decode(errPayload)
 	if err != nil || len(errPayload.Errors) == 0 {
-		return errs, errors.New(r.Status)
+		return nil, errors.New(r.Status)
 	}
 
-	// Parse and format the errors.
-	for _, e := range errPayload.Errors {
+	return errPayload.Errors, nil
+}
+
+// errorPayloadMessages formats the errors of an error document.
+func errorPayloadMessages(objs []*jsonapi.ErrorObject) []string {
+	var errs []string
+	for _, e := range objs {
 		if e.Detail == "" {
 			errs = append(errs, e.Title)
 		} else {
@@ -1031,7 +1451,7 @@ func decodeErrorPayload(r *http.Response) ([]string, error) {
 		}
 	}
 
-	return errs, nil
+	return errs
 }
 
 func errorPayloadContains(payloadErrors []string, match string) bool {
@@ -1066,6 +1486,9 @@ func packContents(path string) (*bytes.Buffer, error) {
 	return body, nil
 }
 
+// validSliceKey reports whether the values of a query parameter are encoded
+// as a single comma-separated list, as the API expects for includes, filters
+// and searches.
 func validSliceKey(key string) bool {
-	return key == _includeQueryParam || strings.Contains(key, "filter[")
+	return key == _includeQueryParam || strings.Contains(key, "filter[") || strings.Contains(key, "search[")
 }
@@ -6,6 +6,8 @@ package tfe
 import (
 	"bytes"
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -16,6 +18,7 @@ import (
 
 	"github.com/hashicorp/jsonapi"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"golang.org/x/time/rate"
 )
 
@@ -104,6 +107,71 @@ func TestClient_newClient(t *testing.T) {
 	})
 }
 
+func TestClient_transportConfig(t *testing.T) {
+	ts := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		w.WriteHeader(204) // We query the configured ping URL which should return a 204.
+	}))
+	defer ts.Close()
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(ts.Certificate())
+
+	t.Run("trusts a private CA", func(t *testing.T) {
+		client, err := NewClient(&Config{
+			Address:   ts.URL,
+			Token:     "abcd1234",
+			TLSConfig: &tls.Config{RootCAs: rootCAs},
+		})
+		require.NoError(t, err)
+
+		transport, ok := client.http.HTTPClient.Transport.(*http.Transport)
+		require.True(t, ok)
+		assert.Equal(t, rootCAs, transport.TLSClientConfig.RootCAs)
+	})
+
+	t.Run("fails without the private CA", func(t *testing.T) {
+		_, err := NewClient(&Config{
+			Address: ts.URL,
+			Token:   "abcd1234",
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("tunes connection pooling and HTTP/2", func(t *testing.T) {
+		httpClient := ts.Client()
+		client, err := NewClient(&Config{
+			Address:             ts.URL,
+			Token:               "abcd1234",
+			HTTPClient:          httpClient,
+			MaxIdleConnsPerHost: 42,
+			DisableHTTP2:        true,
+		})
+		require.NoError(t, err)
+
+		transport, ok := client.http.HTTPClient.Transport.(*http.Transport)
+		require.True(t, ok)
+		assert.Equal(t, 42, transport.MaxIdleConnsPerHost)
+		assert.False(t, transport.ForceAttemptHTTP2)
+		assert.NotNil(t, transport.TLSNextProto)
+		assert.Empty(t, transport.TLSNextProto)
+
+		// The caller's HTTP client must be left untouched.
+		assert.NotSame(t, httpClient, client.http.HTTPClient)
+		assert.NotEqual(t, 42, httpClient.Transport.(*http.Transport).MaxIdleConnsPerHost)
+	})
+
+	t.Run("with an unsupported transport", func(t *testing.T) {
+		_, err := NewClient(&Config{
+			Address:             ts.URL,
+			Token:               "abcd1234",
+			HTTPClient:          &http.Client{Transport: http.NewFileTransport(http.Dir("."))},
+			MaxIdleConnsPerHost: 42,
+		})
+		assert.Equal(t, ErrUnsupportedTransport, err)
+	})
+}
+
 func TestClient_defaultConfig(t *testing.T) {
 	t.Run("with no environment variables", func(t *testing.T) {
 		defer setupEnvVars("", "")()
@@ -334,6 +402,31 @@ func TestClient_requestBodySerialization(t *testing.T) {
 		}
 	})
 
+	t.Run("attributes patch request", func(t *testing.T) {
+		body, err := newAttributesPatch("workspaces", "ws-123", map[string]interface{}{
+			"auto-apply": true,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		requestBody, err := createRequest(body)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		parsedRequest := new(jsonapi.OnePayload)
+		err = json.Unmarshal(requestBody, &parsedRequest)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if parsedRequest.Data.Type != "workspaces" || parsedRequest.Data.ID != "ws-123" {
+			t.Fatal("Request serialized incorrectly")
+		}
+		if len(parsedRequest.Data.Attributes) != 1 || parsedRequest.Data.Attributes["auto-apply"] != true {
+			t.Fatal("Request serialized incorrectly")
+		}
+	})
+
 	t.Run("nil request", func(t *testing.T) {
 		requestBody, err := createRequest(nil)
 		if err != nil {
@@ -8,9 +8,11 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"os"
+	"strings"
 	"testing"
 	"time"
 
@@ -123,6 +125,89 @@ func Test_unmarshalResponse(t *testing.T) {
 	})
 }
 
+func Test_checkResponseCode(t *testing.T) {
+	newResponse := func(status int, path, body string) *http.Response {
+		req := httptest.NewRequest("POST", path, nil)
+		resp := &http.Response{
+			StatusCode: status,
+			Status:     fmt.Sprintf("%d %s", status, http.StatusText(status)),
+			Header:     http.Header{},
+			Body:       io.NopCloser(strings.NewReader(body)),
+			Request:    req,
+		}
+		resp.Header.Set("X-Request-Id", "req-123")
+		return resp
+	}
+
+	t.Run("with a success status code", func(t *testing.T) {
+		assert.NoError(t, checkResponseCode(newResponse(200, "/api/v2/ping", "")))
+	})
+
+	t.Run("with a status code mapping to a sentinel error", func(t *testing.T) {
+		err := checkResponseCode(newResponse(404, "/api/v2/workspaces/ws-123", `{"errors":[{"status":"404","title":"not found"}]}`))
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("with rate limit headers", func(t *testing.T) {
+		resp := newResponse(422, "/api/v2/organizations/hashicorp/workspaces", `{"errors":[{"status":"422","title":"invalid attribute"}]}`)
+		resp.Header.Set("X-RateLimit-Limit", "30")
+		resp.Header.Set("X-RateLimit-Remaining", "29")
+		resp.Header.Set("X-RateLimit-Reset", "0.5")
+
+		err := checkResponseCode(resp)
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, "req-123", apiErr.RequestID)
+		assert.Equal(t, &APIRateLimit{Limit: 30, Remaining: 29, Reset: 500 * time.Millisecond}, apiErr.RateLimit)
+	})
+
+	t.Run("with an error document", func(t *testing.T) {
+		resp := newResponse(422, "/api/v2/organizations/hashicorp/workspaces", `{"errors":[
+			{"status":"422","code":"invalid_attribute","title":"invalid attribute","detail":"Name has already been taken","source":{"pointer":"/data/attributes/name"}},
+			{"status":"422","title":"invalid attribute"}
+		]}`)
+
+		err := checkResponseCode(resp)
+		assert.EqualError(t, err, "invalid attribute\n\nName has already been taken\ninvalid attribute")
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 422, apiErr.StatusCode)
+		assert.Nil(t, apiErr.RateLimit)
+		assert.Equal(t, []string{"invalid_attribute"}, apiErr.Codes())
+		require.Len(t, apiErr.Errors, 2)
+		assert.Equal(t, "/data/attributes/name", apiErr.Errors[0].Source.Pointer)
+	})
+
+	t.Run("with a refined conflict", func(t *testing.T) {
+		resp := newResponse(409, "/api/v2/workspaces/ws-123/actions/unlock", `{"errors":[{"status":"409","title":"Unable to unlock workspace. The workspace is locked by Run run-123."}]}`)
+		assert.Equal(t, ErrWorkspaceLockedByRun, checkResponseCode(resp))
+	})
+
+	t.Run("with an unrefined conflict", func(t *testing.T) {
+		resp := newResponse(409, "/api/v2/organizations/hashicorp/workspaces", `{"errors":[{"status":"409","title":"conflict"}]}`)
+
+		err := checkResponseCode(resp)
+		assert.EqualError(t, err, "conflict")
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 409, apiErr.StatusCode)
+	})
+
+	t.Run("without an error document", func(t *testing.T) {
+		err := checkResponseCode(newResponse(500, "/api/v2/runs", "<html>"))
+		assert.EqualError(t, err, "500 Internal Server Error")
+
+		var apiErr *APIError
+		require.ErrorAs(t, err, &apiErr)
+		assert.Equal(t, 500, apiErr.StatusCode)
+		assert.Empty(t, apiErr.Errors)
+		assert.Equal(t, "req-123", apiErr.RequestID)
+	})
+}
+
 func Test_BaseURL(t *testing.T) {
 	client, err := NewClient(&Config{
 		Address:  "https://example.com",
@@ -186,6 +271,120 @@ func Test_EncodeQueryParams(t *testing.T) {
 	})
 }
 
+func Test_MultiValueFilters(t *testing.T) {
+	client, done := newExampleClient(nil)
+	defer done()
+
+	t.Run("with list filters", func(t *testing.T) {
+		req, err := client.NewRequest("GET", "workspaces/ws-1/runs", &RunListOptions{
+			Statuses:   []RunStatus{RunPlanned, RunPolicyOverride},
+			Operations: []RunOperation{RunOperationPlanApply},
+		})
+		require.NoError(t, err)
+
+		q := req.retryableRequest.URL.Query()
+		assert.Equal(t, []string{"planned,policy_override"}, q["filter[status]"])
+		assert.Equal(t, []string{"plan_and_apply"}, q["filter[operation]"])
+	})
+
+	t.Run("with list searches", func(t *testing.T) {
+		req, err := client.NewRequest("GET", "organizations/acme/workspaces", &WorkspaceListOptions{
+			TagNames:        []string{"prod", "eu"},
+			ExcludeTagNames: []string{"legacy"},
+		})
+		require.NoError(t, err)
+
+		q := req.retryableRequest.URL.Query()
+		assert.Equal(t, []string{"prod,eu"}, q["search[tags]"])
+		assert.Equal(t, []string{"legacy"}, q["search[exclude-tags]"])
+	})
+
+	t.Run("with the deprecated string filters", func(t *testing.T) {
+		req, err := client.NewRequest("GET", "organizations/acme/workspaces", &WorkspaceListOptions{
+			Tags: "eu,us",
+		})
+		require.NoError(t, err)
+
+		q := req.retryableRequest.URL.Query()
+		assert.Equal(t, []string{"eu,us"}, q["search[tags]"])
+	})
+
+	t.Run("with both forms of a filter", func(t *testing.T) {
+		_, err := client.NewRequest("GET", "organizations/acme/workspaces", &WorkspaceListOptions{
+			TagNames: []string{"prod"},
+			Tags:     "eu,us",
+		})
+		assert.ErrorIs(t, err, ErrUnsupportedDuplicateQueryParam)
+		assert.ErrorContains(t, err, "search[tags]")
+
+		_, err = client.Runs.List(context.Background(), "ws-1", &RunListOptions{
+			Statuses: []RunStatus{RunPlanned},
+			Status:   string(RunApplied),
+		})
+		assert.ErrorIs(t, err, ErrUnsupportedDuplicateQueryParam)
+	})
+
+	t.Run("with an empty list filter and its deprecated string form", func(t *testing.T) {
+		req, err := client.NewRequest("GET", "organizations/acme/workspaces", &WorkspaceListOptions{
+			TagNames: []string{},
+			Tags:     "eu",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "eu", req.retryableRequest.URL.Query().Get("search[tags]"))
+	})
+
+	t.Run("with invalid admin run statuses", func(t *testing.T) {
+		_, err := client.Admin.Runs.List(context.Background(), &AdminRunsListOptions{
+			RunStatuses: []RunStatus{RunApplied, "nope"},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func Test_Fieldsets(t *testing.T) {
+	client, done := newExampleClient(nil)
+	defer done()
+
+	t.Run("with list options", func(t *testing.T) {
+		req, err := client.NewRequest("GET", "organizations/acme/workspaces", &WorkspaceListOptions{
+			ListOptions: ListOptions{PageSize: 100},
+			Include:     []WSIncludeOpt{WSProject},
+			Fields: Fieldsets{
+				"workspaces": {"name", "updated-at"},
+				"projects":   {"name"},
+			},
+		})
+		require.NoError(t, err)
+
+		q := req.retryableRequest.URL.Query()
+		assert.Equal(t, "name,updated-at", q.Get("fields[workspaces]"))
+		assert.Equal(t, "name", q.Get("fields[projects]"))
+		assert.Equal(t, "project", q.Get("include"))
+		assert.Equal(t, "100", q.Get("page[size]"))
+	})
+
+	t.Run("with read options", func(t *testing.T) {
+		req, err := client.NewRequest("GET", "runs/run-1", &RunReadOptions{
+			Fields: Fieldsets{"runs": {"status"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "fields%5Bruns%5D=status", req.retryableRequest.URL.RawQuery)
+	})
+
+	t.Run("without fieldsets", func(t *testing.T) {
+		req, err := client.NewRequest("GET", "runs/run-1", &RunReadOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, req.retryableRequest.URL.RawQuery)
+	})
+
+	t.Run("with an empty type", func(t *testing.T) {
+		_, err := client.NewRequest("GET", "runs/run-1", &RunReadOptions{
+			Fields: Fieldsets{"": {"status"}},
+		})
+		assert.ErrorIs(t, err, ErrInvalidFieldsetType)
+	})
+}
+
 func Test_RegistryBasePath(t *testing.T) {
 	client, err := NewClient(&Config{
 		Token: "foo",
@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfetest
+
+import (
+	"io"
+	"net/http"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) handleConfigurationVersions() {
+	s.handle("POST workspaces/:id/configuration-versions", s.withWorkspace(s.createConfigurationVersion))
+	s.handle("GET workspaces/:id/configuration-versions", s.withWorkspace(s.listConfigurationVersions))
+	s.handle("GET configuration-versions/:id", s.readConfigurationVersion)
+	s.handle("PUT _blobs/:name", s.uploadBlob)
+	s.handle("GET _blobs/:name", s.downloadBlob)
+}
+
+func (s *Server) createConfigurationVersion(w http.ResponseWriter, r *http.Request, ws *resource) {
+	data, err := decodeRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	cv := s.newResource("configuration-versions", "cv", map[string]any{
+		"auto-queue-runs": true,
+		"provisional":     false,
+		"source":          string(tfe.ConfigurationSourceAPI),
+		"speculative":     false,
+		"status":          string(tfe.ConfigurationPending),
+	})
+	for k, v := range data.Attributes {
+		cv.attributes[k] = v
+	}
+	cv.attributes["upload-url"] = s.blobURL(cv.id)
+	cv.relationships["workspace"] = ws
+
+	writeResource(w, http.StatusCreated, cv)
+}
+
+// listConfigurationVersions lists the configuration versions of a workspace,
+// newest first.
+func (s *Server) listConfigurationVersions(w http.ResponseWriter, r *http.Request, ws *resource) {
+	cvs := s.list("configuration-versions", func(cv *resource) bool {
+		return cv.relationships["workspace"] == ws
+	})
+	for i, j := 0, len(cvs)-1; i < j; i, j = i+1, j-1 {
+		cvs[i], cvs[j] = cvs[j], cvs[i]
+	}
+
+	writeList(w, r, cvs)
+}
+
+func (s *Server) readConfigurationVersion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	cv, ok := s.lookup("configuration-versions", params["id"])
+	if !ok {
+		writeError(w, http.StatusNotFound, "configuration version not found")
+		return
+	}
+
+	writeResource(w, http.StatusOK, cv)
+}
+
+// latestConfigurationVersion returns the last configuration version uploaded
+// to a workspace, or nil if none was.
+func (s *Server) latestConfigurationVersion(ws *resource) *resource {
+	var latest *resource
+	for _, cv := range s.list("configuration-versions", nil) {
+		if cv.relationships["workspace"] == ws && cv.attributes["status"] == string(tfe.ConfigurationUploaded) {
+			latest = cv
+		}
+	}
+	return latest
+}
+
+// uploadBlob stores the content uploaded to the upload URL of a configuration
+// version or state version, and updates the status of that resource. Like the
+// API, uploading a configuration version queues a run unless its
+// auto-queue-runs attribute is false.
+func (s *Server) uploadBlob(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	s.blobs[params["name"]] = body
+
+	if cv, ok := s.lookup("configuration-versions", params["name"]); ok {
+		cv.attributes["status"] = string(tfe.ConfigurationUploaded)
+		if cv.attributes["auto-queue-runs"] != false {
+			s.queueRun(cv.relationships["workspace"], cv, nil)
+		}
+	}
+	if sv, ok := s.lookup("state-versions", params["name"]); ok {
+		sv.attributes["status"] = string(tfe.StateVersionFinalized)
+	}
+
+	w.WriteHeader(http.StatusOK)
+}
+
+func (s *Server) downloadBlob(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	body, ok := s.blobs[params["name"]]
+	if !ok {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/octet-stream")
+	_, _ = w.Write(body)
+}
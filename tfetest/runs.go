@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfetest
+
+import (
+	"fmt"
+	"net/http"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) handleRuns() {
+	s.handle("POST runs", s.createRun)
+	s.handle("GET runs/:id", s.withRun(s.readRun))
+	s.handle("GET workspaces/:id/runs", s.withWorkspace(s.listRuns))
+	s.handle("POST runs/:id/actions/apply", s.withRun(s.runAction(tfe.RunApplied, tfe.RunPlanned, tfe.RunCostEstimated, tfe.RunPolicyChecked, tfe.RunPolicyOverride)))
+	s.handle("POST runs/:id/actions/discard", s.withRun(s.runAction(tfe.RunDiscarded, tfe.RunPlanned, tfe.RunCostEstimated, tfe.RunPolicyChecked, tfe.RunPolicyOverride)))
+	s.handle("POST runs/:id/actions/cancel", s.withRun(s.runAction(tfe.RunCanceled, tfe.RunPending, tfe.RunPlanQueued, tfe.RunPlanning, tfe.RunApplyQueued, tfe.RunApplying)))
+}
+
+// SetRunStatus sets the status of a run, to simulate its progress, as the
+// server does not execute runs.
+func (s *Server) SetRunStatus(runID string, status tfe.RunStatus) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	run, ok := s.lookup("runs", runID)
+	if !ok {
+		return fmt.Errorf("run %q not found", runID)
+	}
+
+	run.attributes["status"] = string(status)
+	return nil
+}
+
+// runHandler handles a request for an existing run.
+type runHandler func(w http.ResponseWriter, r *http.Request, run *resource)
+
+// withRun looks up the run of the "id" parameter of a request.
+func (s *Server) withRun(handler runHandler) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		run, ok := s.lookup("runs", params["id"])
+		if !ok {
+			writeError(w, http.StatusNotFound, "run not found")
+			return
+		}
+		handler(w, r, run)
+	}
+}
+
+func (s *Server) createRun(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	data, err := decodeRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	ws, ok := s.lookup("workspaces", data.relationshipID("workspace"))
+	if !ok {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	var cv *resource
+	if id := data.relationshipID("configuration-version"); id != "" {
+		if cv, ok = s.lookup("configuration-versions", id); !ok {
+			writeError(w, http.StatusNotFound, "configuration version not found")
+			return
+		}
+	} else if cv = s.latestConfigurationVersion(ws); cv == nil {
+		writeError(w, http.StatusUnprocessableEntity, "Configuration version is missing")
+		return
+	}
+
+	writeResource(w, http.StatusCreated, s.queueRun(ws, cv, data.Attributes))
+}
+
+// queueRun creates a pending run of a workspace for a configuration version.
+func (s *Server) queueRun(ws, cv *resource, attributes map[string]any) *resource {
+	run := s.newResource("runs", "run", map[string]any{
+		"auto-apply": ws.attributes["auto-apply"],
+		"is-destroy": false,
+		"message":    "Triggered via API",
+		"plan-only":  cv.attributes["speculative"],
+		"source":     "tfe-api",
+		"status":     string(tfe.RunPending),
+	})
+	for k, v := range attributes {
+		run.attributes[k] = v
+	}
+	run.relationships["workspace"] = ws
+	run.relationships["configuration-version"] = cv
+
+	return run
+}
+
+func (s *Server) readRun(w http.ResponseWriter, r *http.Request, run *resource) {
+	writeResource(w, http.StatusOK, run)
+}
+
+// listRuns lists the runs of a workspace, newest first.
+func (s *Server) listRuns(w http.ResponseWriter, r *http.Request, ws *resource) {
+	runs := s.list("runs", func(run *resource) bool {
+		return run.relationships["workspace"] == ws
+	})
+	for i, j := 0, len(runs)-1; i < j; i, j = i+1, j-1 {
+		runs[i], runs[j] = runs[j], runs[i]
+	}
+
+	writeList(w, r, runs)
+}
+
+// runAction returns a handler setting the status of a run, provided it is in
+// one of the given statuses.
+func (s *Server) runAction(status tfe.RunStatus, from ...tfe.RunStatus) runHandler {
+	return func(w http.ResponseWriter, r *http.Request, run *resource) {
+		for _, current := range from {
+			if run.attributes["status"] == string(current) {
+				run.attributes["status"] = string(status)
+				w.WriteHeader(http.StatusAccepted)
+				return
+			}
+		}
+
+		writeError(w, http.StatusConflict, fmt.Sprintf("Transition not allowed from status %v", run.attributes["status"]))
+	}
+}
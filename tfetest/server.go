@@ -0,0 +1,342 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tfetest provides an in-memory fake of the HCP Terraform and
+// Terraform Enterprise API, to test code using go-tfe without an account or
+// recorded responses.
+//
+// The fake implements the most common endpoints of workspaces, runs,
+// configuration versions and state versions, and keeps the resources created
+// through them in memory:
+//
+//	srv := tfetest.NewServer()
+//	defer srv.Close()
+//
+//	client, err := srv.NewClient()
+//	if err != nil {
+//		t.Fatal(err)
+//	}
+//
+//	ws, err := client.Workspaces.Create(ctx, "my-org", tfe.WorkspaceCreateOptions{
+//		Name: tfe.String("my-workspace"),
+//	})
+//
+// Requests to other endpoints return 404 Not Found. Runs are not executed:
+// they stay in the status they were created or last set with SetRunStatus,
+// until they are applied, canceled or discarded.
+package tfetest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+// Token is the API token accepted by the server.
+const Token = "tfetest-token"
+
+// Server is an in-memory fake of the API, served over HTTP by an
+// httptest.Server. It is safe for concurrent use.
+type Server struct {
+	*httptest.Server
+
+	mu        sync.Mutex
+	routes    []route
+	resources map[string]*resource
+	blobs     map[string][]byte
+	sequence  int
+}
+
+// NewServer starts a fake API server with no resources. The caller must call
+// Close once done with it.
+func NewServer() *Server {
+	s := &Server{
+		resources: make(map[string]*resource),
+		blobs:     make(map[string][]byte),
+	}
+
+	s.handleWorkspaces()
+	s.handleRuns()
+	s.handleConfigurationVersions()
+	s.handleStateVersions()
+
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Config returns the configuration of a client using the server.
+func (s *Server) Config() *tfe.Config {
+	return &tfe.Config{
+		Address:    s.URL,
+		Token:      Token,
+		HTTPClient: s.Client(),
+	}
+}
+
+// NewClient returns a client using the server.
+func (s *Server) NewClient() (*tfe.Client, error) {
+	return tfe.NewClient(s.Config())
+}
+
+// route is an endpoint of the API. The segments of its path starting with a
+// colon match any value, which is passed to the handler under that name.
+type route struct {
+	method   string
+	segments []string
+	handler  func(w http.ResponseWriter, r *http.Request, params map[string]string)
+}
+
+// handle registers the handler of an endpoint. The pattern is the method and
+// the path of the endpoint relative to the base path of the API, such as
+// "GET workspaces/:id".
+func (s *Server) handle(pattern string, handler func(w http.ResponseWriter, r *http.Request, params map[string]string)) {
+	method, path, _ := strings.Cut(pattern, " ")
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(path, "/"),
+		handler:  handler,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path := strings.TrimPrefix(r.URL.Path, tfe.DefaultBasePath)
+	if path == r.URL.Path {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+
+	if path == "ping" {
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+
+	// Blobs are uploaded and downloaded through URLs returned by the API,
+	// which do not require the API token.
+	if !strings.HasPrefix(path, "_blobs/") && r.Header.Get("Authorization") != "Bearer "+Token {
+		writeError(w, http.StatusUnauthorized, "unauthorized")
+		return
+	}
+
+	segments := strings.Split(path, "/")
+	for _, rt := range s.routes {
+		if params, ok := rt.match(r.Method, segments); ok {
+			s.mu.Lock()
+			defer s.mu.Unlock()
+
+			rt.handler(w, r, params)
+			return
+		}
+	}
+
+	writeError(w, http.StatusNotFound, "not found")
+}
+
+func (rt route) match(method string, segments []string) (map[string]string, bool) {
+	if rt.method != method || len(rt.segments) != len(segments) {
+		return nil, false
+	}
+
+	params := make(map[string]string)
+	for i, segment := range rt.segments {
+		if strings.HasPrefix(segment, ":") {
+			params[segment[1:]] = segments[i]
+			continue
+		}
+		if segment != segments[i] {
+			return nil, false
+		}
+	}
+
+	return params, true
+}
+
+// resource is a JSON:API resource stored by the server.
+type resource struct {
+	id            string
+	typ           string
+	attributes    map[string]any
+	relationships map[string]*resource
+
+	// The sequence number of the resource, which orders the resources by
+	// creation.
+	seq int
+}
+
+// newResource stores a new resource of the given type, with an ID made of
+// the given prefix and a sequence number.
+func (s *Server) newResource(typ, prefix string, attributes map[string]any) *resource {
+	s.sequence++
+
+	now := time.Now().UTC()
+	res := &resource{
+		id:            fmt.Sprintf("%s-tfetest%d", prefix, s.sequence),
+		typ:           typ,
+		attributes:    map[string]any{"created-at": now.Format(time.RFC3339)},
+		relationships: make(map[string]*resource),
+		seq:           s.sequence,
+	}
+	for k, v := range attributes {
+		res.attributes[k] = v
+	}
+
+	s.resources[res.id] = res
+	return res
+}
+
+// lookup returns the stored resource of the given type and ID, if any.
+func (s *Server) lookup(typ, id string) (*resource, bool) {
+	res, ok := s.resources[id]
+	if !ok || res.typ != typ {
+		return nil, false
+	}
+	return res, true
+}
+
+// list returns the stored resources of the given type matching the filter,
+// oldest first.
+func (s *Server) list(typ string, filter func(*resource) bool) []*resource {
+	var list []*resource
+	for _, res := range s.resources {
+		if res.typ == typ && (filter == nil || filter(res)) {
+			list = append(list, res)
+		}
+	}
+
+	sort.Slice(list, func(i, j int) bool {
+		return list[i].seq < list[j].seq
+	})
+	return list
+}
+
+func (res *resource) document() map[string]any {
+	relationships := make(map[string]any)
+	for name, rel := range res.relationships {
+		relationships[name] = map[string]any{
+			"data": map[string]any{"type": rel.typ, "id": rel.id},
+		}
+	}
+
+	return map[string]any{
+		"id":            res.id,
+		"type":          res.typ,
+		"attributes":    res.attributes,
+		"relationships": relationships,
+	}
+}
+
+// blobURL returns the URL to upload or download the blob of the given name.
+func (s *Server) blobURL(name string) string {
+	return s.URL + tfe.DefaultBasePath + "_blobs/" + name
+}
+
+// requestData is the primary data of a JSON:API request document.
+type requestData struct {
+	Type          string         `json:"type"`
+	Attributes    map[string]any `json:"attributes"`
+	Relationships map[string]struct {
+		Data json.RawMessage `json:"data"`
+	} `json:"relationships"`
+}
+
+// decodeRequest decodes the primary data of a JSON:API request document.
+func decodeRequest(r *http.Request) (*requestData, error) {
+	doc := struct {
+		Data *requestData `json:"data"`
+	}{}
+	if err := json.NewDecoder(r.Body).Decode(&doc); err != nil {
+		return nil, err
+	}
+	if doc.Data == nil {
+		return nil, fmt.Errorf("missing data")
+	}
+	if doc.Data.Attributes == nil {
+		doc.Data.Attributes = make(map[string]any)
+	}
+	return doc.Data, nil
+}
+
+// relationshipID returns the ID of a to-one relationship of the request, or
+// an empty string if the relationship is not set.
+func (d *requestData) relationshipID(name string) string {
+	rel, ok := d.Relationships[name]
+	if !ok {
+		return ""
+	}
+
+	var data struct {
+		ID string `json:"id"`
+	}
+	if err := json.Unmarshal(rel.Data, &data); err != nil {
+		return ""
+	}
+	return data.ID
+}
+
+func writeResource(w http.ResponseWriter, status int, res *resource) {
+	writeJSON(w, status, map[string]any{"data": res.document()})
+}
+
+// writeList writes a page of resources, using the page[number] and
+// page[size] query parameters of the request.
+func writeList(w http.ResponseWriter, r *http.Request, list []*resource) {
+	pageNumber, _ := strconv.Atoi(r.URL.Query().Get("page[number]"))
+	if pageNumber < 1 {
+		pageNumber = 1
+	}
+	pageSize, _ := strconv.Atoi(r.URL.Query().Get("page[size]"))
+	if pageSize < 1 {
+		pageSize = 20
+	}
+
+	totalPages := (len(list) + pageSize - 1) / pageSize
+	if totalPages == 0 {
+		totalPages = 1
+	}
+
+	pagination := map[string]any{
+		"current-page": pageNumber,
+		"page-size":    pageSize,
+		"total-pages":  totalPages,
+		"total-count":  len(list),
+	}
+	if pageNumber > 1 {
+		pagination["prev-page"] = pageNumber - 1
+	}
+	if pageNumber < totalPages {
+		pagination["next-page"] = pageNumber + 1
+	}
+
+	data := []any{}
+	for i := (pageNumber - 1) * pageSize; i < len(list) && i < pageNumber*pageSize; i++ {
+		data = append(data, list[i].document())
+	}
+
+	writeJSON(w, http.StatusOK, map[string]any{
+		"data": data,
+		"meta": map[string]any{"pagination": pagination},
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, doc any) {
+	w.Header().Set("Content-Type", tfe.ContentTypeJSONAPI)
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(doc)
+}
+
+func writeError(w http.ResponseWriter, status int, detail string) {
+	writeJSON(w, status, map[string]any{
+		"errors": []any{map[string]any{
+			"status": strconv.Itoa(status),
+			"title":  http.StatusText(status),
+			"detail": detail,
+		}},
+	})
+}
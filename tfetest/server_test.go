@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfetest_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	tfe "github.com/hashicorp/go-tfe"
+	"github.com/hashicorp/go-tfe/tfetest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestServer(t *testing.T) (*tfetest.Server, *tfe.Client) {
+	t.Helper()
+
+	srv := tfetest.NewServer()
+	t.Cleanup(srv.Close)
+
+	client, err := srv.NewClient()
+	require.NoError(t, err)
+
+	return srv, client
+}
+
+func TestServerWorkspaces(t *testing.T) {
+	t.Parallel()
+
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	ws, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{
+		Name:      tfe.String("prod"),
+		AutoApply: tfe.Bool(true),
+	})
+	require.NoError(t, err)
+	assert.True(t, ws.AutoApply)
+	assert.Equal(t, "acme", ws.Organization.Name)
+
+	t.Run("with a duplicate name", func(t *testing.T) {
+		_, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{Name: tfe.String("prod")})
+		assert.Error(t, err)
+	})
+
+	t.Run("read by name and by ID", func(t *testing.T) {
+		byName, err := client.Workspaces.Read(ctx, "acme", "prod")
+		require.NoError(t, err)
+		assert.Equal(t, ws.ID, byName.ID)
+
+		byID, err := client.Workspaces.ReadByID(ctx, ws.ID)
+		require.NoError(t, err)
+		assert.Equal(t, "prod", byID.Name)
+
+		_, err = client.Workspaces.Read(ctx, "other", "prod")
+		assert.ErrorIs(t, err, tfe.ErrResourceNotFound)
+	})
+
+	t.Run("list with pagination", func(t *testing.T) {
+		for i := 0; i < 3; i++ {
+			_, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{Name: tfe.String(fmt.Sprintf("staging-%d", i))})
+			require.NoError(t, err)
+		}
+
+		wl, err := client.Workspaces.List(ctx, "acme", &tfe.WorkspaceListOptions{
+			ListOptions: tfe.ListOptions{PageSize: 2},
+		})
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 2)
+		assert.Equal(t, 4, wl.TotalCount)
+		assert.Equal(t, 2, wl.NextPage)
+
+		wl, err = client.Workspaces.List(ctx, "acme", &tfe.WorkspaceListOptions{Search: "staging"})
+		require.NoError(t, err)
+		assert.Len(t, wl.Items, 3)
+	})
+
+	t.Run("lock and unlock", func(t *testing.T) {
+		_, err := client.Workspaces.Lock(ctx, ws.ID, tfe.WorkspaceLockOptions{})
+		require.NoError(t, err)
+
+		_, err = client.Workspaces.Lock(ctx, ws.ID, tfe.WorkspaceLockOptions{})
+		assert.ErrorIs(t, err, tfe.ErrWorkspaceLocked)
+
+		_, err = client.Workspaces.Unlock(ctx, ws.ID)
+		require.NoError(t, err)
+	})
+
+	t.Run("update and delete", func(t *testing.T) {
+		updated, err := client.Workspaces.UpdateByID(ctx, ws.ID, tfe.WorkspaceUpdateOptions{Name: tfe.String("production")})
+		require.NoError(t, err)
+		assert.Equal(t, "production", updated.Name)
+
+		require.NoError(t, client.Workspaces.Delete(ctx, "acme", "production"))
+
+		_, err = client.Workspaces.ReadByID(ctx, ws.ID)
+		assert.ErrorIs(t, err, tfe.ErrResourceNotFound)
+	})
+}
+
+func TestServerRuns(t *testing.T) {
+	t.Parallel()
+
+	srv, client := newTestServer(t)
+	ctx := context.Background()
+
+	ws, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{Name: tfe.String("prod")})
+	require.NoError(t, err)
+
+	t.Run("without a configuration version", func(t *testing.T) {
+		_, err := client.Runs.Create(ctx, tfe.RunCreateOptions{Workspace: ws})
+		assert.Error(t, err)
+	})
+
+	cv, err := client.ConfigurationVersions.Create(ctx, ws.ID, tfe.ConfigurationVersionCreateOptions{
+		AutoQueueRuns: tfe.Bool(false),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, tfe.ConfigurationPending, cv.Status)
+
+	require.NoError(t, client.ConfigurationVersions.UploadTarGzip(ctx, cv.UploadURL, bytes.NewReader([]byte("archive"))))
+
+	cv, err = client.ConfigurationVersions.Read(ctx, cv.ID)
+	require.NoError(t, err)
+	assert.Equal(t, tfe.ConfigurationUploaded, cv.Status)
+
+	run, err := client.Runs.Create(ctx, tfe.RunCreateOptions{
+		Workspace: ws,
+		Message:   tfe.String("deploy"),
+	})
+	require.NoError(t, err)
+	assert.Equal(t, tfe.RunPending, run.Status)
+	assert.Equal(t, "deploy", run.Message)
+	assert.Equal(t, cv.ID, run.ConfigurationVersion.ID)
+
+	t.Run("apply before planning", func(t *testing.T) {
+		assert.Error(t, client.Runs.Apply(ctx, run.ID, tfe.RunApplyOptions{}))
+	})
+
+	t.Run("apply once planned", func(t *testing.T) {
+		require.NoError(t, srv.SetRunStatus(run.ID, tfe.RunPlanned))
+		require.NoError(t, client.Runs.Apply(ctx, run.ID, tfe.RunApplyOptions{}))
+
+		run, err := client.Runs.Read(ctx, run.ID)
+		require.NoError(t, err)
+		assert.Equal(t, tfe.RunApplied, run.Status)
+	})
+
+	t.Run("queued by uploading a configuration version", func(t *testing.T) {
+		cv, err := client.ConfigurationVersions.Create(ctx, ws.ID, tfe.ConfigurationVersionCreateOptions{})
+		require.NoError(t, err)
+		require.NoError(t, client.ConfigurationVersions.UploadTarGzip(ctx, cv.UploadURL, bytes.NewReader([]byte("archive"))))
+
+		rl, err := client.Runs.List(ctx, ws.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, rl.Items, 2)
+		assert.Equal(t, cv.ID, rl.Items[0].ConfigurationVersion.ID)
+	})
+}
+
+func TestServerStateVersions(t *testing.T) {
+	t.Parallel()
+
+	_, client := newTestServer(t)
+	ctx := context.Background()
+
+	ws, err := client.Workspaces.Create(ctx, "acme", tfe.WorkspaceCreateOptions{Name: tfe.String("prod")})
+	require.NoError(t, err)
+
+	state := []byte(`{"version":4,"serial":1,"lineage":"l","outputs":{},"resources":[]}`)
+	options := tfe.StateVersionCreateOptions{
+		MD5:    tfe.String(fmt.Sprintf("%x", md5.Sum(state))),
+		Serial: tfe.Int64(1),
+		State:  tfe.String(base64.StdEncoding.EncodeToString(state)),
+	}
+
+	t.Run("without a lock", func(t *testing.T) {
+		_, err := client.StateVersions.Create(ctx, ws.ID, options)
+		assert.Error(t, err)
+	})
+
+	_, err = client.Workspaces.Lock(ctx, ws.ID, tfe.WorkspaceLockOptions{})
+	require.NoError(t, err)
+
+	t.Run("with the state", func(t *testing.T) {
+		sv, err := client.StateVersions.Create(ctx, ws.ID, options)
+		require.NoError(t, err)
+		assert.Equal(t, tfe.StateVersionFinalized, sv.Status)
+
+		current, err := client.StateVersions.ReadCurrent(ctx, ws.ID)
+		require.NoError(t, err)
+		assert.Equal(t, sv.ID, current.ID)
+
+		downloaded, err := client.StateVersions.Download(ctx, current.DownloadURL)
+		require.NoError(t, err)
+		assert.Equal(t, state, downloaded)
+	})
+
+	t.Run("with an upload", func(t *testing.T) {
+		options := options
+		options.Serial = tfe.Int64(2)
+		options.State = nil
+
+		sv, err := client.StateVersions.Upload(ctx, ws.ID, tfe.StateVersionUploadOptions{
+			StateVersionCreateOptions: options,
+			RawState:                  state,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, tfe.StateVersionFinalized, sv.Status)
+		assert.Equal(t, int64(2), sv.Serial)
+
+		svl, err := client.StateVersions.List(ctx, &tfe.StateVersionListOptions{
+			Organization: "acme",
+			Workspace:    "prod",
+		})
+		require.NoError(t, err)
+		require.Len(t, svl.Items, 2)
+		assert.Equal(t, sv.ID, svl.Items[0].ID)
+	})
+}
+
+func TestServerUnauthorized(t *testing.T) {
+	t.Parallel()
+
+	srv, _ := newTestServer(t)
+
+	config := srv.Config()
+	config.Token = "invalid"
+	client, err := tfe.NewClient(config)
+	require.NoError(t, err)
+
+	_, err = client.Workspaces.Read(context.Background(), "acme", "prod")
+	assert.ErrorIs(t, err, tfe.ErrUnauthorized)
+}
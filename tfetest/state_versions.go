@@ -0,0 +1,111 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfetest
+
+import (
+	"encoding/base64"
+	"net/http"
+
+	tfe "github.com/hashicorp/go-tfe"
+)
+
+func (s *Server) handleStateVersions() {
+	s.handle("POST workspaces/:id/state-versions", s.withWorkspace(s.createStateVersion))
+	s.handle("GET workspaces/:id/current-state-version", s.withWorkspace(s.readCurrentStateVersion))
+	s.handle("GET state-versions", s.listStateVersions)
+	s.handle("GET state-versions/:id", s.readStateVersion)
+}
+
+// createStateVersion creates a state version of a workspace, which must be
+// locked. Like the API, the state is either part of the request or uploaded
+// afterwards to the upload URL of the state version.
+func (s *Server) createStateVersion(w http.ResponseWriter, r *http.Request, ws *resource) {
+	if ws.attributes["locked"] != true {
+		writeError(w, http.StatusConflict, "The workspace must be locked to create a state version.")
+		return
+	}
+
+	data, err := decodeRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	var state []byte
+	if encoded, ok := data.Attributes["state"].(string); ok {
+		if state, err = base64.StdEncoding.DecodeString(encoded); err != nil {
+			writeError(w, http.StatusUnprocessableEntity, "State is not valid base64")
+			return
+		}
+	}
+	delete(data.Attributes, "state")
+	delete(data.Attributes, "json-state")
+	delete(data.Attributes, "json-state-outputs")
+
+	sv := s.newResource("state-versions", "sv", map[string]any{
+		"resources-processed": true,
+		"status":              string(tfe.StateVersionPending),
+	})
+	for k, v := range data.Attributes {
+		sv.attributes[k] = v
+	}
+	sv.attributes["hosted-state-download-url"] = s.blobURL(sv.id)
+	sv.attributes["hosted-json-state-download-url"] = s.blobURL(sv.id + "-json")
+	sv.relationships["workspace"] = ws
+
+	if state != nil {
+		s.blobs[sv.id] = state
+		sv.attributes["status"] = string(tfe.StateVersionFinalized)
+	} else {
+		sv.attributes["hosted-state-upload-url"] = s.blobURL(sv.id)
+		sv.attributes["hosted-json-state-upload-url"] = s.blobURL(sv.id + "-json")
+	}
+
+	writeResource(w, http.StatusCreated, sv)
+}
+
+// stateVersions returns the finalized state versions of a workspace, newest
+// first.
+func (s *Server) stateVersions(ws *resource) []*resource {
+	svs := s.list("state-versions", func(sv *resource) bool {
+		return sv.relationships["workspace"] == ws && sv.attributes["status"] == string(tfe.StateVersionFinalized)
+	})
+	for i, j := 0, len(svs)-1; i < j; i, j = i+1, j-1 {
+		svs[i], svs[j] = svs[j], svs[i]
+	}
+	return svs
+}
+
+func (s *Server) readCurrentStateVersion(w http.ResponseWriter, r *http.Request, ws *resource) {
+	svs := s.stateVersions(ws)
+	if len(svs) == 0 {
+		writeError(w, http.StatusNotFound, "state version not found")
+		return
+	}
+
+	writeResource(w, http.StatusOK, svs[0])
+}
+
+// listStateVersions lists the state versions of the workspace of the
+// filter[organization][name] and filter[workspace][name] query parameters.
+func (s *Server) listStateVersions(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	query := r.URL.Query()
+	ws := s.workspaceByName(query.Get("filter[organization][name]"), query.Get("filter[workspace][name]"))
+	if ws == nil {
+		writeError(w, http.StatusNotFound, "workspace not found")
+		return
+	}
+
+	writeList(w, r, s.stateVersions(ws))
+}
+
+func (s *Server) readStateVersion(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	sv, ok := s.lookup("state-versions", params["id"])
+	if !ok {
+		writeError(w, http.StatusNotFound, "state version not found")
+		return
+	}
+
+	writeResource(w, http.StatusOK, sv)
+}
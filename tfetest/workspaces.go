@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfetest
+
+import (
+	"net/http"
+	"strings"
+	"time"
+)
+
+func (s *Server) handleWorkspaces() {
+	s.handle("POST organizations/:org/workspaces", s.createWorkspace)
+	s.handle("GET organizations/:org/workspaces", s.listWorkspaces)
+	s.handle("GET organizations/:org/workspaces/:name", s.withWorkspaceByName(s.readWorkspace))
+	s.handle("PATCH organizations/:org/workspaces/:name", s.withWorkspaceByName(s.updateWorkspace))
+	s.handle("DELETE organizations/:org/workspaces/:name", s.withWorkspaceByName(s.deleteWorkspace))
+	s.handle("GET workspaces/:id", s.withWorkspace(s.readWorkspace))
+	s.handle("PATCH workspaces/:id", s.withWorkspace(s.updateWorkspace))
+	s.handle("DELETE workspaces/:id", s.withWorkspace(s.deleteWorkspace))
+	s.handle("POST workspaces/:id/actions/lock", s.withWorkspace(s.lockWorkspace))
+	s.handle("POST workspaces/:id/actions/unlock", s.withWorkspace(s.unlockWorkspace))
+	s.handle("POST workspaces/:id/actions/force-unlock", s.withWorkspace(s.unlockWorkspace))
+}
+
+// workspaceHandler handles a request for an existing workspace.
+type workspaceHandler func(w http.ResponseWriter, r *http.Request, ws *resource)
+
+// withWorkspace looks up the workspace of the "id" parameter of a request.
+func (s *Server) withWorkspace(handler workspaceHandler) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		ws, ok := s.lookup("workspaces", params["id"])
+		if !ok {
+			writeError(w, http.StatusNotFound, "workspace not found")
+			return
+		}
+		handler(w, r, ws)
+	}
+}
+
+// withWorkspaceByName looks up the workspace of the "org" and "name"
+// parameters of a request.
+func (s *Server) withWorkspaceByName(handler workspaceHandler) func(http.ResponseWriter, *http.Request, map[string]string) {
+	return func(w http.ResponseWriter, r *http.Request, params map[string]string) {
+		ws := s.workspaceByName(params["org"], params["name"])
+		if ws == nil {
+			writeError(w, http.StatusNotFound, "workspace not found")
+			return
+		}
+		handler(w, r, ws)
+	}
+}
+
+func (s *Server) workspaceByName(org, name string) *resource {
+	for _, ws := range s.list("workspaces", nil) {
+		if ws.relationships["organization"].id == org && ws.attributes["name"] == name {
+			return ws
+		}
+	}
+	return nil
+}
+
+func (s *Server) createWorkspace(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	data, err := decodeRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	name, _ := data.Attributes["name"].(string)
+	if name == "" {
+		writeError(w, http.StatusUnprocessableEntity, "Name can't be blank")
+		return
+	}
+	if s.workspaceByName(params["org"], name) != nil {
+		writeError(w, http.StatusUnprocessableEntity, "Name has already been taken")
+		return
+	}
+
+	ws := s.newResource("workspaces", "ws", map[string]any{
+		"auto-apply":        false,
+		"execution-mode":    "remote",
+		"locked":            false,
+		"terraform-version": "1.9.0",
+		"working-directory": "",
+	})
+	for k, v := range data.Attributes {
+		ws.attributes[k] = v
+	}
+	ws.attributes["updated-at"] = ws.attributes["created-at"]
+	ws.relationships["organization"] = &resource{id: params["org"], typ: "organizations"}
+
+	writeResource(w, http.StatusCreated, ws)
+}
+
+func (s *Server) listWorkspaces(w http.ResponseWriter, r *http.Request, params map[string]string) {
+	search := r.URL.Query().Get("search[name]")
+	writeList(w, r, s.list("workspaces", func(ws *resource) bool {
+		name, _ := ws.attributes["name"].(string)
+		return ws.relationships["organization"].id == params["org"] && strings.Contains(name, search)
+	}))
+}
+
+func (s *Server) readWorkspace(w http.ResponseWriter, r *http.Request, ws *resource) {
+	writeResource(w, http.StatusOK, ws)
+}
+
+func (s *Server) updateWorkspace(w http.ResponseWriter, r *http.Request, ws *resource) {
+	data, err := decodeRequest(r)
+	if err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if name, ok := data.Attributes["name"].(string); ok && name != ws.attributes["name"] {
+		if s.workspaceByName(ws.relationships["organization"].id, name) != nil {
+			writeError(w, http.StatusUnprocessableEntity, "Name has already been taken")
+			return
+		}
+	}
+
+	for k, v := range data.Attributes {
+		ws.attributes[k] = v
+	}
+	ws.attributes["updated-at"] = time.Now().UTC().Format(time.RFC3339)
+
+	writeResource(w, http.StatusOK, ws)
+}
+
+func (s *Server) deleteWorkspace(w http.ResponseWriter, r *http.Request, ws *resource) {
+	delete(s.resources, ws.id)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) lockWorkspace(w http.ResponseWriter, r *http.Request, ws *resource) {
+	if ws.attributes["locked"] == true {
+		writeError(w, http.StatusConflict, "Unable to lock workspace. The workspace is already locked.")
+		return
+	}
+
+	ws.attributes["locked"] = true
+	writeResource(w, http.StatusOK, ws)
+}
+
+func (s *Server) unlockWorkspace(w http.ResponseWriter, r *http.Request, ws *resource) {
+	if ws.attributes["locked"] != true {
+		writeError(w, http.StatusConflict, "Unable to unlock workspace. The workspace is not locked.")
+		return
+	}
+
+	ws.attributes["locked"] = false
+	writeResource(w, http.StatusOK, ws)
+}
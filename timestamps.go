@@ -0,0 +1,35 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"fmt"
+	"time"
+)
+
+// timestampLayouts are the layouts accepted by ParseTimestamp, in order of
+// preference. Fractional seconds of any precision are accepted by all of
+// them.
+var timestampLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999 -0700",
+	"2006-01-02 15:04:05.999999999 MST",
+	// Timestamps without a time zone are interpreted as UTC.
+	"2006-01-02T15:04:05.999999999",
+}
+
+// ParseTimestamp parses a timestamp returned by the API. Besides RFC3339, with
+// or without fractional seconds, it accepts the variants returned by some
+// endpoints: offsets without a colon, a space instead of the "T" separator and
+// a missing time zone, which is interpreted as UTC rather than local time.
+func ParseTimestamp(value string) (time.Time, error) {
+	for _, layout := range timestampLayouts {
+		if t, err := time.Parse(layout, value); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("invalid timestamp %q", value)
+}
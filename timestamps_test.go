@@ -0,0 +1,49 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	want := time.Date(2020, 3, 16, 23, 15, 59, 0, time.UTC)
+
+	cases := map[string]time.Time{
+		"2020-03-16T23:15:59Z":             want,
+		"2020-03-16T23:15:59+00:00":        want,
+		"2020-03-16T23:15:59.123Z":         want.Add(123 * time.Millisecond),
+		"2020-03-17T01:15:59.5+02:00":      want.Add(500 * time.Millisecond),
+		"2020-03-16T23:15:59.123+0000":     want.Add(123 * time.Millisecond),
+		"2020-03-16 23:15:59Z":             want,
+		"2020-03-16 23:15:59 +0000":        want,
+		"2020-03-16 23:15:59.123 UTC":      want.Add(123 * time.Millisecond),
+		"2020-03-16T23:15:59.123456":       want.Add(123456 * time.Microsecond),
+		"2020-03-16T23:15:59.123456789Z":   want.Add(123456789),
+		"2020-03-16T21:15:59.000000-02:00": want,
+	}
+
+	for value, expected := range cases {
+		t.Run(value, func(t *testing.T) {
+			got, err := ParseTimestamp(value)
+			require.NoError(t, err)
+			assert.True(t, got.Equal(expected), "got %s, want %s", got, expected)
+		})
+	}
+
+	t.Run("without a time zone", func(t *testing.T) {
+		got, err := ParseTimestamp("2020-03-16T23:15:59")
+		require.NoError(t, err)
+		assert.Equal(t, time.UTC, got.Location())
+	})
+
+	t.Run("with an invalid value", func(t *testing.T) {
+		_, err := ParseTimestamp("yesterday")
+		assert.EqualError(t, err, `invalid timestamp "yesterday"`)
+	})
+}
@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// TokenSource provides the API tokens of a client whose tokens are issued
+// dynamically, e.g. by Vault or through an OIDC token exchange. Set it with
+// Config.TokenSource.
+type TokenSource interface {
+	// Token returns the token to authenticate a request with. It is called
+	// before every request, so it should cache the token until it expires.
+	// When refresh is true, the API rejected the token last returned with a
+	// 401, and a new token should be obtained: the request is sent again,
+	// once, with the token returned.
+	//
+	// Token returns ErrNoToken when it has no token to provide.
+	Token(ctx context.Context, refresh bool) (string, error)
+}
+
+// TokenSourceFunc is an adapter to use a function as a TokenSource.
+type TokenSourceFunc func(ctx context.Context, refresh bool) (string, error)
+
+// Token calls f(ctx, refresh).
+func (f TokenSourceFunc) Token(ctx context.Context, refresh bool) (string, error) {
+	return f(ctx, refresh)
+}
+
+// StaticTokenSource returns a TokenSource that always returns token, or
+// ErrNoToken when token is empty.
+func StaticTokenSource(token string) TokenSource {
+	return TokenSourceFunc(func(context.Context, bool) (string, error) {
+		if token == "" {
+			return "", ErrNoToken
+		}
+		return token, nil
+	})
+}
+
+// ChainTokenSources returns a TokenSource that returns the token of the first
+// of the sources that has one, trying the next source when a source returns
+// ErrNoToken.
+func ChainTokenSources(sources ...TokenSource) TokenSource {
+	return TokenSourceFunc(func(ctx context.Context, refresh bool) (string, error) {
+		for _, source := range sources {
+			token, err := source.Token(ctx, refresh)
+			if errors.Is(err, ErrNoToken) {
+				continue
+			}
+			return token, err
+		}
+		return "", ErrNoToken
+	})
+}
+
+// DefaultTokenSource returns the credential chain of the Terraform CLI for
+// the given hostname, e.g. "app.terraform.io": the TFE_TOKEN environment
+// variable, then the token of the hostname as read by CLICredentialsTokenSource.
+func DefaultTokenSource(hostname string) TokenSource {
+	return ChainTokenSources(
+		StaticTokenSource(os.Getenv("TFE_TOKEN")),
+		CLICredentialsTokenSource(hostname),
+	)
+}
+
+// CLICredentialsTokenSource returns a TokenSource reading the token of the
+// given hostname the way the Terraform CLI does: from its TF_TOKEN_<hostname>
+// environment variable, e.g. TF_TOKEN_app_terraform_io, or else from the
+// credentials file written by `terraform login`, e.g.
+// ~/.terraform.d/credentials.tfrc.json. The token is read again when
+// refreshed, so a token renewed with `terraform login` is picked up.
+func CLICredentialsTokenSource(hostname string) TokenSource {
+	return &cliCredentials{hostname: strings.ToLower(hostname)}
+}
+
+// authToken returns the API token to authenticate a request with: the token
+// of the token source of the client, if any, or else its token.
+func (c *Client) authToken(ctx context.Context) (string, error) {
+	if c.tokenSource == nil {
+		return c.token, nil
+	}
+	return c.tokenSource.Token(ctx, false)
+}
+
+// cliCredentials implements CLICredentialsTokenSource.
+type cliCredentials struct {
+	hostname string
+
+	mu    sync.Mutex
+	token string
+}
+
+func (c *cliCredentials) Token(_ context.Context, refresh bool) (string, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.token != "" && !refresh {
+		return c.token, nil
+	}
+
+	token := os.Getenv(cliTokenEnvName(c.hostname))
+	if token == "" {
+		var err error
+		token, err = readCLICredentialsFile(cliCredentialsFilePath(), c.hostname)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	c.token = token
+	return token, nil
+}
+
+// cliTokenEnvName returns the name of the environment variable the Terraform
+// CLI reads the token of hostname from: periods are encoded as underscores
+// and hyphens as double underscores.
+func cliTokenEnvName(hostname string) string {
+	return "TF_TOKEN_" + strings.NewReplacer(".", "_", "-", "__").Replace(hostname)
+}
+
+// cliCredentialsFilePath returns the path of the credentials file of the
+// Terraform CLI.
+func cliCredentialsFilePath() string {
+	if runtime.GOOS == "windows" {
+		return filepath.Join(os.Getenv("APPDATA"), "terraform.d", "credentials.tfrc.json")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, ".terraform.d", "credentials.tfrc.json")
+}
+
+// readCLICredentialsFile reads the token of hostname from a credentials file
+// of the Terraform CLI. It returns ErrNoToken when the file does not exist or
+// holds no token for the hostname.
+func readCLICredentialsFile(path, hostname string) (string, error) {
+	if path == "" {
+		return "", ErrNoToken
+	}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return "", ErrNoToken
+	}
+	if err != nil {
+		return "", err
+	}
+
+	var file struct {
+		Credentials map[string]struct {
+			Token string `json:"token"`
+		} `json:"credentials"`
+	}
+	if err := json.Unmarshal(data, &file); err != nil {
+		return "", fmt.Errorf("invalid credentials file %s: %w", path, err)
+	}
+
+	for host, creds := range file.Credentials {
+		if strings.EqualFold(host, hostname) && creds.Token != "" {
+			return creds.Token, nil
+		}
+	}
+	return "", ErrNoToken
+}
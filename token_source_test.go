@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCLICredentialsTokenSource(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("TF_TOKEN_tfe_example__corp_com", "")
+
+	ctx := context.Background()
+
+	t.Run("without credentials", func(t *testing.T) {
+		_, err := CLICredentialsTokenSource("app.terraform.io").Token(ctx, false)
+		assert.ErrorIs(t, err, ErrNoToken)
+	})
+
+	require.NoError(t, os.MkdirAll(filepath.Join(home, ".terraform.d"), 0o700))
+	writeCredentials := func(t *testing.T, token string) {
+		err := os.WriteFile(filepath.Join(home, ".terraform.d", "credentials.tfrc.json"), []byte(`{
+			"credentials": {
+				"app.terraform.io": {"token": "`+token+`"},
+				"TFE.example-corp.com": {"token": "file-token"}
+			}
+		}`), 0o600)
+		require.NoError(t, err)
+	}
+	writeCredentials(t, "login-token")
+
+	t.Run("from the credentials file", func(t *testing.T) {
+		source := CLICredentialsTokenSource("app.terraform.io")
+		token, err := source.Token(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, "login-token", token)
+
+		writeCredentials(t, "renewed-token")
+		token, err = source.Token(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, "login-token", token, "the token is cached")
+
+		token, err = source.Token(ctx, true)
+		require.NoError(t, err)
+		assert.Equal(t, "renewed-token", token, "the file is read again on refresh")
+	})
+
+	t.Run("with a hostname in another case", func(t *testing.T) {
+		token, err := CLICredentialsTokenSource("tfe.example-corp.com").Token(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, "file-token", token)
+	})
+
+	t.Run("from the environment", func(t *testing.T) {
+		t.Setenv("TF_TOKEN_tfe_example__corp_com", "env-token")
+		token, err := CLICredentialsTokenSource("tfe.example-corp.com").Token(ctx, false)
+		require.NoError(t, err)
+		assert.Equal(t, "env-token", token)
+	})
+
+	t.Run("without credentials for the hostname", func(t *testing.T) {
+		_, err := CLICredentialsTokenSource("other.example.com").Token(ctx, false)
+		assert.ErrorIs(t, err, ErrNoToken)
+	})
+}
+
+func TestChainTokenSources(t *testing.T) {
+	ctx := context.Background()
+
+	token, err := ChainTokenSources(StaticTokenSource(""), StaticTokenSource("second")).Token(ctx, false)
+	require.NoError(t, err)
+	assert.Equal(t, "second", token)
+
+	_, err = ChainTokenSources(StaticTokenSource(""), StaticTokenSource("")).Token(ctx, false)
+	assert.ErrorIs(t, err, ErrNoToken)
+}
+
+func TestClient_TokenSource(t *testing.T) {
+	// The fake API only accepts the current token.
+	var mu sync.Mutex
+	current := "token-1"
+	var rejected int
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+		if r.Header.Get("Authorization") != "Bearer "+current {
+			rejected++
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_, _ = w.Write([]byte(`{"data":{"id":"user-1","type":"users","attributes":{"username":"admin"}}}`))
+	}))
+	t.Cleanup(srv.Close)
+
+	// The token source issues the token of the fake API, but only fetches it
+	// again when refreshed.
+	var refreshes int
+	var issued string
+	source := TokenSourceFunc(func(_ context.Context, refresh bool) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if issued == "" || refresh {
+			if refresh {
+				refreshes++
+			}
+			issued = current
+		}
+		return issued, nil
+	})
+
+	client, err := NewClient(&Config{
+		Address:     srv.URL,
+		TokenSource: source,
+		HTTPClient:  srv.Client(),
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("with a valid token", func(t *testing.T) {
+		u, err := client.Users.ReadCurrent(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "admin", u.Username)
+		assert.Equal(t, 0, refreshes)
+	})
+
+	t.Run("with a rotated token", func(t *testing.T) {
+		mu.Lock()
+		current = "token-2"
+		rejected = 0
+		mu.Unlock()
+
+		u, err := client.Users.ReadCurrent(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "admin", u.Username)
+		assert.Equal(t, 1, rejected)
+		assert.Equal(t, 1, refreshes)
+	})
+
+	t.Run("with a revoked token", func(t *testing.T) {
+		rejecting := TokenSourceFunc(func(context.Context, bool) (string, error) {
+			return "revoked", nil
+		})
+		revoked, err := NewClient(&Config{
+			Address:     srv.URL,
+			TokenSource: rejecting,
+			HTTPClient:  srv.Client(),
+		})
+		require.NoError(t, err)
+
+		mu.Lock()
+		rejected = 0
+		mu.Unlock()
+
+		_, err = revoked.Users.ReadCurrent(ctx)
+		assert.ErrorIs(t, err, ErrUnauthorized)
+		assert.Equal(t, 1, rejected, "the request is not sent again with the same token")
+	})
+
+	t.Run("without a token", func(t *testing.T) {
+		_, err := NewClient(&Config{
+			Address:     srv.URL,
+			TokenSource: StaticTokenSource(""),
+			HTTPClient:  srv.Client(),
+		})
+		assert.EqualError(t, err, "missing API token")
+	})
+}
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+)
+
+// UploadOptions represents the options for uploading an archive. The upload
+// URLs of HCP Terraform do not support resuming a partial upload, so an upload
+// that fails is attempted again from the start of the archive.
+type UploadOptions struct {
+	// Optional: The number of times the upload is attempted again when it
+	// fails with a transient error, such as a network error or a server error.
+	// The archive is read again from its start for each attempt.
+	Retries int
+
+	// Optional: The SHA-256 checksum of the archive, hex-encoded. The archive
+	// is read once to verify it before it is uploaded, and is not uploaded
+	// when it does not match.
+	Checksum string
+}
+
+// sizedReadSeeker reports the size of an archive, so it is uploaded with a
+// Content-Length header instead of being buffered or chunked.
+type sizedReadSeeker struct {
+	io.ReadSeeker
+	size int
+}
+
+// Len implements retryablehttp.LenReader.
+func (r *sizedReadSeeker) Len() int {
+	return r.size
+}
+
+// doForeignPUTRequestWithOptions performs a PUT request like
+// doForeignPUTRequest, reading the data from its start. The data is verified
+// against the checksum of the options first, and the request is performed
+// again when it fails with a transient error.
+func (c *Client) doForeignPUTRequestWithOptions(ctx context.Context, foreignURL string, data io.ReadSeeker, options UploadOptions) error {
+	if err := options.valid(); err != nil {
+		return err
+	}
+
+	if options.Checksum != "" {
+		if err := verifyChecksum(data, options.Checksum); err != nil {
+			return err
+		}
+	}
+
+	size, err := data.Seek(0, io.SeekEnd)
+	if err != nil {
+		return fmt.Errorf("failed to read the archive: %w", err)
+	}
+	body := &sizedReadSeeker{ReadSeeker: data, size: int(size)}
+
+	for attempt := 0; ; attempt++ {
+		err := c.doForeignPUTRequest(ctx, foreignURL, body)
+		if err == nil || attempt >= options.Retries || !isTransientError(err) {
+			return err
+		}
+
+		if err := retryBackoff.Wait(ctx, attempt+1, err); err != nil {
+			return err
+		}
+	}
+}
+
+// verifyChecksum reads data from its start and returns ErrChecksumMismatch
+// when its SHA-256 checksum is not the hex-encoded checksum.
+func verifyChecksum(data io.ReadSeeker, checksum string) error {
+	if _, err := data.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("failed to read the archive: %w", err)
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, data); err != nil {
+		return fmt.Errorf("failed to read the archive: %w", err)
+	}
+
+	want, _ := hex.DecodeString(checksum)
+	if sum := h.Sum(nil); !bytes.Equal(sum, want) {
+		return fmt.Errorf("%w: expected %x, got %x", ErrChecksumMismatch, want, sum)
+	}
+	return nil
+}
+
+func (o UploadOptions) valid() error {
+	if o.Retries < 0 {
+		return ErrInvalidRetries
+	}
+	if o.Checksum != "" {
+		if b, err := hex.DecodeString(o.Checksum); err != nil || len(b) != sha256.Size {
+			return ErrInvalidChecksum
+		}
+	}
+	return nil
+}
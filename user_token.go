@@ -30,6 +30,9 @@ type UserTokens interface {
 
 	// Delete a user token by its ID.
 	Delete(ctx context.Context, tokenID string) error
+
+	// Rotate replaces a user token with a new token of the same user.
+	Rotate(ctx context.Context, userID string, tokenID string, options UserTokenCreateOptions) (*UserTokenRotation, error)
 }
 
 // userTokens implements UserTokens.
@@ -71,6 +74,14 @@ type UserTokenCreateOptions struct {
 	ExpiredAt *time.Time `jsonapi:"attr,expired-at,iso8601,omitempty"`
 }
 
+// UserTokenRotation is the result of rotating a user token.
+type UserTokenRotation struct {
+	// The token that was replaced, as read before it was deleted.
+	Old *UserToken
+	// The token that replaced it, including its secret value.
+	New *UserToken
+}
+
 // Create a new user token
 func (s *userTokens) Create(ctx context.Context, userID string, options UserTokenCreateOptions) (*UserToken, error) {
 	if !validStringID(&userID) {
@@ -148,3 +159,38 @@ func (s *userTokens) Delete(ctx context.Context, tokenID string) error {
 
 	return req.Do(ctx, nil)
 }
+
+// Rotate creates a new token for the user and then deletes the token being
+// replaced. The new token gets the description of the old one, unless the
+// options set another one. If creating the new token fails, the old token is
+// left untouched. If deleting the old token fails, the rotation is returned
+// along with the error, so the new token is not lost.
+func (s *userTokens) Rotate(ctx context.Context, userID string, tokenID string, options UserTokenCreateOptions) (*UserTokenRotation, error) {
+	if !validStringID(&userID) {
+		return nil, ErrInvalidUserID
+	}
+	if !validStringID(&tokenID) {
+		return nil, ErrInvalidTokenID
+	}
+
+	old, err := s.Read(ctx, tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Description == "" {
+		options.Description = old.Description
+	}
+
+	created, err := s.Create(ctx, userID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	rotation := &UserTokenRotation{Old: old, New: created}
+	if err := s.Delete(ctx, tokenID); err != nil {
+		return rotation, err
+	}
+
+	return rotation, nil
+}
@@ -152,3 +152,37 @@ func createToken(t *testing.T, client *Client, user *User) (*UserToken, func())
 		}
 	}
 }
+
+func TestUserTokensRotate(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/authentication-tokens/at-old":        `{"data":{"id":"at-old","type":"authentication-tokens","attributes":{"description":"ci","last-used-at":"2024-03-01T00:00:00Z"}}}`,
+		"POST /api/v2/users/user-1/authentication-tokens": `{"data":{"id":"at-new","type":"authentication-tokens","attributes":{"description":"ci","token":"secret"}}}`,
+		"DELETE /api/v2/authentication-tokens/at-old":     ``,
+	})
+	defer done()
+
+	ctx := context.Background()
+
+	t.Run("with an existing token", func(t *testing.T) {
+		rotation, err := client.UserTokens.Rotate(ctx, "user-1", "at-old", UserTokenCreateOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, "at-old", rotation.Old.ID)
+		assert.False(t, rotation.Old.LastUsedAt.IsZero())
+		assert.Equal(t, "at-new", rotation.New.ID)
+		assert.Equal(t, "secret", rotation.New.Token)
+	})
+
+	t.Run("with an unknown token", func(t *testing.T) {
+		rotation, err := client.UserTokens.Rotate(ctx, "user-1", "at-unknown", UserTokenCreateOptions{})
+		assert.Nil(t, rotation)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("with an invalid token ID", func(t *testing.T) {
+		rotation, err := client.UserTokens.Rotate(ctx, "user-1", badIdentifier, UserTokenCreateOptions{})
+		assert.Nil(t, rotation)
+		assert.Equal(t, ErrInvalidTokenID, err)
+	})
+}
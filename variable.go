@@ -5,8 +5,12 @@ package tfe
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"net/url"
+	"strings"
 )
 
 // Compile-time proof of interface implementation.
@@ -31,6 +35,28 @@ type Variables interface {
 
 	// Delete a variable by its ID.
 	Delete(ctx context.Context, workspaceID string, variableID string) error
+
+	// WriteSensitive creates or updates a sensitive variable together with
+	// a non-sensitive companion environment variable holding a keyed hash of
+	// its value, so the value can later be checked with VerifySensitive.
+	WriteSensitive(ctx context.Context, workspaceID string, options VariableWriteSensitiveOptions) (*Variable, error)
+
+	// VerifySensitive checks the value of a sensitive variable written with
+	// WriteSensitive against the expected hash, as returned by
+	// SensitiveValueHash, without reading the value itself.
+	VerifySensitive(ctx context.Context, workspaceID string, key string, expectedHash string) (SensitiveVariableStatus, error)
+
+	// CreateBatch concurrently creates many variables, returning a result
+	// per variable in the order of the options.
+	CreateBatch(ctx context.Context, workspaceID string, options []VariableCreateOptions) ([]*VariableBatchResult, error)
+
+	// UpdateBatch concurrently updates many variables, returning a result
+	// per variable in the order of the options.
+	UpdateBatch(ctx context.Context, workspaceID string, options []VariableBatchUpdateOptions) ([]*VariableBatchResult, error)
+
+	// Sync reconciles the variables of the workspace with the desired set,
+	// creating, updating and deleting variables as needed.
+	Sync(ctx context.Context, workspaceID string, desired []VariableCreateOptions) ([]*VariableSyncResult, error)
 }
 
 // variables implements Variables.
@@ -48,6 +74,41 @@ const (
 	CategoryTerraform CategoryType = "terraform"
 )
 
+// SensitiveVariableStatus represents the outcome of verifying a sensitive
+// variable with VerifySensitive.
+type SensitiveVariableStatus string
+
+// List all available sensitive variable statuses.
+const (
+	// The value of the variable matches the expected hash.
+	SensitiveVariableMatch SensitiveVariableStatus = "match"
+	// The value of the variable does not match the expected hash, or the
+	// variable was changed after its companion hash variable was written,
+	// for example in the UI.
+	SensitiveVariableDrift SensitiveVariableStatus = "drift"
+	// The value of the variable cannot be verified because it has no
+	// companion hash variable.
+	SensitiveVariableUnverified SensitiveVariableStatus = "unverified"
+)
+
+// VariableSyncAction represents the change Sync made to a variable.
+type VariableSyncAction string
+
+// List all available variable sync actions.
+const (
+	VariableSyncCreate VariableSyncAction = "create"
+	VariableSyncUpdate VariableSyncAction = "update"
+	VariableSyncDelete VariableSyncAction = "delete"
+)
+
+// variableBatchConcurrency is the number of requests made concurrently by
+// CreateBatch, UpdateBatch and Sync.
+const variableBatchConcurrency = 10
+
+// sensitiveHashKeyPrefix is the prefix of the key of the companion variables
+// maintained by WriteSensitive.
+const sensitiveHashKeyPrefix = "TFE_SENSITIVE_HMAC_SHA256_"
+
 // VariableList represents a list of variables.
 type VariableList struct {
 	*Pagination
@@ -128,6 +189,81 @@ type VariableUpdateOptions struct {
 	Sensitive *bool `jsonapi:"attr,sensitive,omitempty"`
 }
 
+// VariableBatchUpdateOptions represents the options for updating a single
+// variable with UpdateBatch.
+type VariableBatchUpdateOptions struct {
+	// Required: The ID of the variable to update.
+	VariableID string
+
+	VariableUpdateOptions
+}
+
+// VariableBatchResult represents the outcome of creating or updating a single
+// variable with CreateBatch or UpdateBatch.
+type VariableBatchResult struct {
+	// The created or updated variable, or nil if the request failed.
+	Variable *Variable
+	// The error returned for the variable, if any.
+	Err error
+}
+
+// VariableSyncResult represents a change made to a single variable by Sync.
+type VariableSyncResult struct {
+	Action VariableSyncAction
+	// The created, updated or deleted variable. For failed creations it only
+	// holds the key and category of the variable.
+	Variable *Variable
+	// The error returned for the variable, if any.
+	Err error
+}
+
+// VariableWriteSensitiveOptions represents the options for writing a
+// sensitive variable with WriteSensitive.
+type VariableWriteSensitiveOptions struct {
+	// Required: The name of the variable.
+	Key *string
+
+	// Required: The value of the variable.
+	Value *string
+
+	// Optional: The description of the variable.
+	Description *string
+
+	// Required: Whether this is a Terraform or environment variable.
+	Category *CategoryType
+
+	// Optional: Whether to evaluate the value of the variable as a string of HCL code.
+	HCL *bool
+
+	// Required: The secret key the hash of the value is computed with, as
+	// passed to SensitiveValueHash. Keep it outside of HCP Terraform: the
+	// hash is readable by anyone with access to the variables of the
+	// workspace, and cannot be brute-forced without the key.
+	HashKey []byte
+}
+
+// SensitiveValueHash returns the hash WriteSensitive records for the value of
+// a sensitive variable, and VerifySensitive expects: the hex encoded
+// HMAC-SHA256 of the value, keyed with hashKey.
+func SensitiveValueHash(hashKey []byte, value string) string {
+	mac := hmac.New(sha256.New, hashKey)
+	mac.Write([]byte(value))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// SensitiveHashVariableKey returns the key of the companion variable that
+// holds the hash of the sensitive variable with the given key.
+func SensitiveHashVariableKey(key string) string {
+	return sensitiveHashKeyPrefix + key
+}
+
+// ChangedSince reports whether the variable was updated after the given
+// version of it was read, which allows detecting changes of sensitive values
+// without knowing them.
+func (v *Variable) ChangedSince(versionID string) bool {
+	return v.VersionID != versionID
+}
+
 // List all the variables associated with the given workspace.
 func (s *variables) List(ctx context.Context, workspaceID string, options *VariableListOptions) (*VariableList, error) {
 	if !validStringID(&workspaceID) {
@@ -239,6 +375,344 @@ func (s *variables) Delete(ctx context.Context, workspaceID, variableID string)
 	return req.Do(ctx, nil)
 }
 
+// WriteSensitive creates or updates a sensitive variable together with a
+// non-sensitive companion variable, named after SensitiveHashVariableKey,
+// holding the version of the variable and the HMAC of its value keyed with
+// options.HashKey.
+//
+// The API has no category of workspace variables kept out of runs, so the
+// companion is an environment variable: it is set in the environment of
+// every run of the workspace and can be read by anyone with access to the
+// variables of the workspace. The HMAC does not reveal the value to anyone
+// who does not hold the hash key.
+//
+// If the variable is written but its companion cannot be, the variable is
+// returned together with the error.
+func (s *variables) WriteSensitive(ctx context.Context, workspaceID string, options VariableWriteSensitiveOptions) (*Variable, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	vs, err := s.listAll(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var v *Variable
+	if existing := findVariable(vs, *options.Key, *options.Category); existing != nil {
+		v, err = s.Update(ctx, workspaceID, existing.ID, VariableUpdateOptions{
+			Value:       options.Value,
+			Description: options.Description,
+			HCL:         options.HCL,
+			Sensitive:   Bool(true),
+		})
+	} else {
+		v, err = s.Create(ctx, workspaceID, VariableCreateOptions{
+			Key:         options.Key,
+			Value:       options.Value,
+			Description: options.Description,
+			Category:    options.Category,
+			HCL:         options.HCL,
+			Sensitive:   Bool(true),
+		})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	hashKey := SensitiveHashVariableKey(*options.Key)
+	hashValue := v.VersionID + ":" + SensitiveValueHash(options.HashKey, *options.Value)
+	if companion := findVariable(vs, hashKey, CategoryEnv); companion != nil {
+		_, err = s.Update(ctx, workspaceID, companion.ID, VariableUpdateOptions{
+			Value:     String(hashValue),
+			Sensitive: Bool(false),
+		})
+	} else {
+		_, err = s.Create(ctx, workspaceID, VariableCreateOptions{
+			Key:         String(hashKey),
+			Value:       String(hashValue),
+			Description: String(fmt.Sprintf("Hash of the value of the sensitive variable %q", *options.Key)),
+			Category:    Category(CategoryEnv),
+			Sensitive:   Bool(false),
+		})
+	}
+	if err != nil {
+		return v, fmt.Errorf("failed to write hash of variable %q: %w", *options.Key, err)
+	}
+
+	return v, nil
+}
+
+// VerifySensitive checks the value of a sensitive variable written with
+// WriteSensitive against the expected hash, as returned by
+// SensitiveValueHash. It compares the expected hash with the one recorded in
+// the companion variable, after checking the variable was not changed since
+// the companion was written; a variable changed since is reported as drifted.
+func (s *variables) VerifySensitive(ctx context.Context, workspaceID, key, expectedHash string) (SensitiveVariableStatus, error) {
+	if !validStringID(&workspaceID) {
+		return "", ErrInvalidWorkspaceID
+	}
+	if !validString(&key) {
+		return "", ErrRequiredKey
+	}
+	if !validString(&expectedHash) {
+		return "", ErrRequiredValue
+	}
+
+	vs, err := s.listAll(ctx, workspaceID)
+	if err != nil {
+		return "", err
+	}
+
+	var candidates []*Variable
+	for _, v := range vs {
+		if v.Key == key {
+			candidates = append(candidates, v)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", ErrResourceNotFound
+	}
+
+	companion := findVariable(vs, SensitiveHashVariableKey(key), CategoryEnv)
+	if companion == nil {
+		return SensitiveVariableUnverified, nil
+	}
+	versionID, hash, ok := strings.Cut(companion.Value, ":")
+	if !ok {
+		return SensitiveVariableUnverified, nil
+	}
+
+	// The version of a variable changes whenever it is updated, so a
+	// variable whose version differs from the recorded one was changed
+	// without updating its companion.
+	for _, v := range candidates {
+		if v.Sensitive && v.VersionID == versionID && hmac.Equal([]byte(strings.ToLower(hash)), []byte(strings.ToLower(expectedHash))) {
+			return SensitiveVariableMatch, nil
+		}
+	}
+
+	return SensitiveVariableDrift, nil
+}
+
+// CreateBatch creates a variable for each of the options. The variables are
+// created concurrently and the results are returned in the order of the
+// options; a failure to create one variable does not stop the others from
+// being created. All the options are validated before any variable is created.
+func (s *variables) CreateBatch(ctx context.Context, workspaceID string, options []VariableCreateOptions) ([]*VariableBatchResult, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	for _, o := range options {
+		if err := o.valid(); err != nil {
+			return nil, err
+		}
+	}
+
+	results := make([]*VariableBatchResult, len(options))
+	forEachConcurrently(len(options), variableBatchConcurrency, func(i int) {
+		v, err := s.Create(ctx, workspaceID, options[i])
+		results[i] = &VariableBatchResult{Variable: v, Err: err}
+	})
+
+	return results, nil
+}
+
+// UpdateBatch updates the variable of each of the options. The variables are
+// updated concurrently and the results are returned in the order of the
+// options; a failure to update one variable does not stop the others from
+// being updated. All the options are validated before any variable is updated.
+func (s *variables) UpdateBatch(ctx context.Context, workspaceID string, options []VariableBatchUpdateOptions) ([]*VariableBatchResult, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	for _, o := range options {
+		if !validStringID(&o.VariableID) {
+			return nil, ErrInvalidVariableID
+		}
+	}
+
+	results := make([]*VariableBatchResult, len(options))
+	forEachConcurrently(len(options), variableBatchConcurrency, func(i int) {
+		v, err := s.Update(ctx, workspaceID, options[i].VariableID, options[i].VariableUpdateOptions)
+		results[i] = &VariableBatchResult{Variable: v, Err: err}
+	})
+
+	return results, nil
+}
+
+// Sync reconciles the variables of the workspace with the desired set. Desired
+// variables are matched with the current ones by key and category: missing
+// ones are created, existing ones that differ are updated and current
+// variables that are not desired are deleted, including the companion hash
+// variables maintained by WriteSensitive unless they are part of the desired
+// set. Since the values of sensitive variables cannot be read, desired
+// sensitive variables are always updated.
+//
+// A result is returned for each change, creations first, then updates and
+// deletions; unchanged variables have no result.
+func (s *variables) Sync(ctx context.Context, workspaceID string, desired []VariableCreateOptions) ([]*VariableSyncResult, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	seen := make(map[string]bool, len(desired))
+	for _, o := range desired {
+		if err := o.valid(); err != nil {
+			return nil, err
+		}
+		id := string(*o.Category) + "/" + *o.Key
+		if seen[id] {
+			return nil, ErrDuplicateVariable
+		}
+		seen[id] = true
+	}
+
+	current, err := s.listAll(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var creates []VariableCreateOptions
+	var updates []VariableBatchUpdateOptions
+	for _, o := range desired {
+		v := findVariable(current, *o.Key, *o.Category)
+		if v == nil {
+			creates = append(creates, o)
+			continue
+		}
+		if variableChanged(v, o) {
+			updates = append(updates, VariableBatchUpdateOptions{
+				VariableID: v.ID,
+				VariableUpdateOptions: VariableUpdateOptions{
+					Value:       o.Value,
+					Description: o.Description,
+					HCL:         o.HCL,
+					Sensitive:   o.Sensitive,
+				},
+			})
+		}
+	}
+
+	var deletes []*Variable
+	for _, v := range current {
+		if !seen[string(v.Category)+"/"+v.Key] {
+			deletes = append(deletes, v)
+		}
+	}
+
+	results := make([]*VariableSyncResult, 0, len(creates)+len(updates)+len(deletes))
+	for _, o := range creates {
+		results = append(results, &VariableSyncResult{
+			Action:   VariableSyncCreate,
+			Variable: &Variable{Key: *o.Key, Category: *o.Category},
+		})
+	}
+	for _, o := range updates {
+		results = append(results, &VariableSyncResult{
+			Action:   VariableSyncUpdate,
+			Variable: findVariableByID(current, o.VariableID),
+		})
+	}
+	for _, v := range deletes {
+		results = append(results, &VariableSyncResult{
+			Action:   VariableSyncDelete,
+			Variable: v,
+		})
+	}
+
+	forEachConcurrently(len(results), variableBatchConcurrency, func(i int) {
+		result := results[i]
+		switch {
+		case i < len(creates):
+			if v, err := s.Create(ctx, workspaceID, creates[i]); err != nil {
+				result.Err = err
+			} else {
+				result.Variable = v
+			}
+		case i < len(creates)+len(updates):
+			o := updates[i-len(creates)]
+			if v, err := s.Update(ctx, workspaceID, o.VariableID, o.VariableUpdateOptions); err != nil {
+				result.Err = err
+			} else {
+				result.Variable = v
+			}
+		default:
+			result.Err = s.Delete(ctx, workspaceID, result.Variable.ID)
+		}
+	})
+
+	return results, nil
+}
+
+// variableChanged reports whether the variable differs from the desired
+// options. Sensitive variables are always considered changed, as their value
+// cannot be compared.
+func variableChanged(v *Variable, o VariableCreateOptions) bool {
+	if v.Sensitive {
+		return true
+	}
+	if o.Sensitive != nil && *o.Sensitive {
+		return true
+	}
+	if o.Value != nil && *o.Value != v.Value {
+		return true
+	}
+	if o.Description != nil && *o.Description != v.Description {
+		return true
+	}
+	if o.HCL != nil && *o.HCL != v.HCL {
+		return true
+	}
+	return false
+}
+
+// listAll returns all the variables of the given workspace.
+func (s *variables) listAll(ctx context.Context, workspaceID string) ([]*Variable, error) {
+	options := &VariableListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+	}
+
+	var vs []*Variable
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		vl, err := s.List(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+		vs = append(vs, vl.Items...)
+		return vl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return vs, nil
+}
+
+// findVariableByID returns the variable with the given ID, or nil if there is
+// none.
+func findVariableByID(vs []*Variable, id string) *Variable {
+	for _, v := range vs {
+		if v.ID == id {
+			return v
+		}
+	}
+	return nil
+}
+
+// findVariable returns the variable with the given key and category, or nil
+// if there is none.
+func findVariable(vs []*Variable, key string, category CategoryType) *Variable {
+	for _, v := range vs {
+		if v.Key == key && v.Category == category {
+			return v
+		}
+	}
+	return nil
+}
+
 func (o VariableCreateOptions) valid() error {
 	if !validString(o.Key) {
 		return ErrRequiredKey
@@ -248,3 +722,19 @@ func (o VariableCreateOptions) valid() error {
 	}
 	return nil
 }
+
+func (o VariableWriteSensitiveOptions) valid() error {
+	if !validString(o.Key) {
+		return ErrRequiredKey
+	}
+	if o.Value == nil {
+		return ErrRequiredValue
+	}
+	if o.Category == nil {
+		return ErrRequiredCategory
+	}
+	if len(o.HashKey) == 0 {
+		return ErrRequiredHashKey
+	}
+	return nil
+}
@@ -363,3 +363,277 @@ func TestVariablesDelete(t *testing.T) {
 		assert.Equal(t, err, ErrInvalidVariableID)
 	})
 }
+
+func TestVariablesWriteSensitive(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	hashKey := []byte(randomString(t))
+	options := VariableWriteSensitiveOptions{
+		Key:      String(randomString(t)),
+		Value:    String(randomString(t)),
+		Category: Category(CategoryTerraform),
+		HashKey:  hashKey,
+	}
+
+	t.Run("when the variable does not exist", func(t *testing.T) {
+		v, err := client.Variables.WriteSensitive(ctx, wTest.ID, options)
+		require.NoError(t, err)
+		assert.True(t, v.Sensitive)
+		assert.Empty(t, v.Value)
+
+		status, err := client.Variables.VerifySensitive(ctx, wTest.ID, *options.Key, SensitiveValueHash(hashKey, *options.Value))
+		require.NoError(t, err)
+		assert.Equal(t, SensitiveVariableMatch, status)
+	})
+
+	t.Run("when the variable is rotated", func(t *testing.T) {
+		previous := *options.Value
+		options.Value = String(randomString(t))
+
+		_, err := client.Variables.WriteSensitive(ctx, wTest.ID, options)
+		require.NoError(t, err)
+
+		status, err := client.Variables.VerifySensitive(ctx, wTest.ID, *options.Key, SensitiveValueHash(hashKey, *options.Value))
+		require.NoError(t, err)
+		assert.Equal(t, SensitiveVariableMatch, status)
+
+		status, err = client.Variables.VerifySensitive(ctx, wTest.ID, *options.Key, SensitiveValueHash(hashKey, previous))
+		require.NoError(t, err)
+		assert.Equal(t, SensitiveVariableDrift, status)
+	})
+
+	t.Run("when the variable is changed outside the helper", func(t *testing.T) {
+		vl, err := client.Variables.List(ctx, wTest.ID, nil)
+		require.NoError(t, err)
+		for _, v := range vl.Items {
+			if v.Key == *options.Key {
+				_, err = client.Variables.Update(ctx, wTest.ID, v.ID, VariableUpdateOptions{Value: String(randomString(t))})
+				require.NoError(t, err)
+			}
+		}
+
+		status, err := client.Variables.VerifySensitive(ctx, wTest.ID, *options.Key, SensitiveValueHash(hashKey, *options.Value))
+		require.NoError(t, err)
+		assert.Equal(t, SensitiveVariableDrift, status)
+	})
+
+	t.Run("without a value", func(t *testing.T) {
+		v, err := client.Variables.WriteSensitive(ctx, wTest.ID, VariableWriteSensitiveOptions{
+			Key:      String(randomString(t)),
+			Category: Category(CategoryTerraform),
+			HashKey:  hashKey,
+		})
+		assert.Nil(t, v)
+		assert.Equal(t, ErrRequiredValue, err)
+	})
+
+	t.Run("without a hash key", func(t *testing.T) {
+		v, err := client.Variables.WriteSensitive(ctx, wTest.ID, VariableWriteSensitiveOptions{
+			Key:      String(randomString(t)),
+			Value:    String(randomString(t)),
+			Category: Category(CategoryTerraform),
+		})
+		assert.Nil(t, v)
+		assert.Equal(t, ErrRequiredHashKey, err)
+	})
+
+	t.Run("with invalid workspace ID", func(t *testing.T) {
+		v, err := client.Variables.WriteSensitive(ctx, badIdentifier, options)
+		assert.Nil(t, v)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestSensitiveValueHash(t *testing.T) {
+	t.Parallel()
+
+	hash := SensitiveValueHash([]byte("hash-key"), "s3cr3t")
+	assert.Len(t, hash, 64)
+	assert.Equal(t, hash, SensitiveValueHash([]byte("hash-key"), "s3cr3t"))
+	assert.NotEqual(t, hash, SensitiveValueHash([]byte("other-key"), "s3cr3t"))
+}
+
+func TestVariablesVerifySensitive(t *testing.T) {
+	t.Parallel()
+
+	hashKey := []byte("hash-key")
+	hash := SensitiveValueHash(hashKey, "s3cr3t")
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-match/vars": `{"data":[
+			{"id":"var-1","type":"vars","attributes":{"key":"token","category":"terraform","sensitive":true,"version-id":"v1"}},
+			{"id":"var-2","type":"vars","attributes":{"key":"TFE_SENSITIVE_HMAC_SHA256_token","category":"env","value":"v1:` + hash + `"}}
+		]}`,
+		"GET /api/v2/workspaces/ws-stale/vars": `{"data":[
+			{"id":"var-1","type":"vars","attributes":{"key":"token","category":"terraform","sensitive":true,"version-id":"v2"}},
+			{"id":"var-2","type":"vars","attributes":{"key":"TFE_SENSITIVE_HMAC_SHA256_token","category":"env","value":"v1:` + hash + `"}}
+		]}`,
+		"GET /api/v2/workspaces/ws-nohash/vars": `{"data":[
+			{"id":"var-1","type":"vars","attributes":{"key":"token","category":"terraform","sensitive":true,"version-id":"v1"}}
+		]}`,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("when the hash matches", func(t *testing.T) {
+		status, err := client.Variables.VerifySensitive(ctx, "ws-match", "token", hash)
+		require.NoError(t, err)
+		assert.Equal(t, SensitiveVariableMatch, status)
+	})
+
+	t.Run("when the hash does not match", func(t *testing.T) {
+		status, err := client.Variables.VerifySensitive(ctx, "ws-match", "token", SensitiveValueHash(hashKey, "rotated"))
+		require.NoError(t, err)
+		assert.Equal(t, SensitiveVariableDrift, status)
+	})
+
+	t.Run("when the variable changed after its hash", func(t *testing.T) {
+		status, err := client.Variables.VerifySensitive(ctx, "ws-stale", "token", hash)
+		require.NoError(t, err)
+		assert.Equal(t, SensitiveVariableDrift, status)
+	})
+
+	t.Run("without a companion hash variable", func(t *testing.T) {
+		status, err := client.Variables.VerifySensitive(ctx, "ws-nohash", "token", hash)
+		require.NoError(t, err)
+		assert.Equal(t, SensitiveVariableUnverified, status)
+	})
+
+	t.Run("when the variable does not exist", func(t *testing.T) {
+		_, err := client.Variables.VerifySensitive(ctx, "ws-match", "missing", hash)
+		assert.Equal(t, ErrResourceNotFound, err)
+	})
+
+	t.Run("without a key", func(t *testing.T) {
+		_, err := client.Variables.VerifySensitive(ctx, "ws-match", "", hash)
+		assert.Equal(t, ErrRequiredKey, err)
+	})
+}
+
+func TestVariablesCreateBatch(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	defer wTestCleanup()
+
+	options := []VariableCreateOptions{
+		{Key: String(randomString(t)), Value: String("one"), Category: Category(CategoryTerraform)},
+		{Key: String(randomString(t)), Value: String("two"), Category: Category(CategoryEnv)},
+		{Key: String(randomString(t)), Value: String("three"), Category: Category(CategoryTerraform), Sensitive: Bool(true)},
+	}
+
+	t.Run("with valid options", func(t *testing.T) {
+		results, err := client.Variables.CreateBatch(ctx, wTest.ID, options)
+		require.NoError(t, err)
+		require.Len(t, results, len(options))
+		for i, result := range results {
+			require.NoError(t, result.Err)
+			assert.Equal(t, *options[i].Key, result.Variable.Key)
+			assert.Equal(t, *options[i].Category, result.Variable.Category)
+		}
+
+		updates := make([]VariableBatchUpdateOptions, len(results))
+		for i, result := range results {
+			updates[i] = VariableBatchUpdateOptions{
+				VariableID:            result.Variable.ID,
+				VariableUpdateOptions: VariableUpdateOptions{Description: String("updated")},
+			}
+		}
+
+		updated, err := client.Variables.UpdateBatch(ctx, wTest.ID, updates)
+		require.NoError(t, err)
+		require.Len(t, updated, len(updates))
+		for i, result := range updated {
+			require.NoError(t, result.Err)
+			assert.Equal(t, results[i].Variable.ID, result.Variable.ID)
+			assert.Equal(t, "updated", result.Variable.Description)
+		}
+	})
+
+	t.Run("when a variable already exists", func(t *testing.T) {
+		results, err := client.Variables.CreateBatch(ctx, wTest.ID, []VariableCreateOptions{
+			options[0],
+			{Key: String(randomString(t)), Value: String("four"), Category: Category(CategoryTerraform)},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		assert.Error(t, results[0].Err)
+		assert.Nil(t, results[0].Variable)
+		require.NoError(t, results[1].Err)
+		assert.Equal(t, "four", results[1].Variable.Value)
+	})
+
+	t.Run("without a key", func(t *testing.T) {
+		results, err := client.Variables.CreateBatch(ctx, wTest.ID, []VariableCreateOptions{
+			{Value: String("five"), Category: Category(CategoryTerraform)},
+		})
+		assert.Nil(t, results)
+		assert.Equal(t, ErrRequiredKey, err)
+	})
+
+	t.Run("with invalid variable ID", func(t *testing.T) {
+		results, err := client.Variables.UpdateBatch(ctx, wTest.ID, []VariableBatchUpdateOptions{
+			{VariableID: badIdentifier},
+		})
+		assert.Nil(t, results)
+		assert.Equal(t, ErrInvalidVariableID, err)
+	})
+}
+
+func TestVariablesSync(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-123/vars": `{"data":[
+			{"id":"var-1","type":"vars","attributes":{"key":"region","category":"terraform","value":"us-east-1"}},
+			{"id":"var-2","type":"vars","attributes":{"key":"size","category":"terraform","value":"small"}},
+			{"id":"var-3","type":"vars","attributes":{"key":"OLD","category":"env","value":"1"}}
+		]}`,
+		"POST /api/v2/workspaces/ws-123/vars":         `{"data":{"id":"var-4","type":"vars","attributes":{"key":"NEW","category":"env","value":"2"}}}`,
+		"PATCH /api/v2/workspaces/ws-123/vars/var-2":  `{"data":{"id":"var-2","type":"vars","attributes":{"key":"size","category":"terraform","value":"large"}}}`,
+		"DELETE /api/v2/workspaces/ws-123/vars/var-3": ``,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("with a desired set", func(t *testing.T) {
+		results, err := client.Variables.Sync(ctx, "ws-123", []VariableCreateOptions{
+			{Key: String("region"), Value: String("us-east-1"), Category: Category(CategoryTerraform)},
+			{Key: String("size"), Value: String("large"), Category: Category(CategoryTerraform)},
+			{Key: String("NEW"), Value: String("2"), Category: Category(CategoryEnv)},
+		})
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+
+		assert.Equal(t, VariableSyncCreate, results[0].Action)
+		assert.Equal(t, "var-4", results[0].Variable.ID)
+		assert.NoError(t, results[0].Err)
+
+		assert.Equal(t, VariableSyncUpdate, results[1].Action)
+		assert.Equal(t, "large", results[1].Variable.Value)
+		assert.NoError(t, results[1].Err)
+
+		assert.Equal(t, VariableSyncDelete, results[2].Action)
+		assert.Equal(t, "var-3", results[2].Variable.ID)
+		assert.NoError(t, results[2].Err)
+	})
+
+	t.Run("with duplicate variables", func(t *testing.T) {
+		results, err := client.Variables.Sync(ctx, "ws-123", []VariableCreateOptions{
+			{Key: String("region"), Value: String("us-east-1"), Category: Category(CategoryTerraform)},
+			{Key: String("region"), Value: String("eu-west-1"), Category: Category(CategoryTerraform)},
+		})
+		assert.Nil(t, results)
+		assert.Equal(t, ErrDuplicateVariable, err)
+	})
+
+	t.Run("with invalid workspace ID", func(t *testing.T) {
+		results, err := client.Variables.Sync(ctx, badIdentifier, nil)
+		assert.Nil(t, results)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
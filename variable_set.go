@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"net/url"
+	"sort"
 )
 
 // Compile-time proof of interface implementation.
@@ -44,6 +45,11 @@ type VariableSets interface {
 	// Remove variable set from workspaces in the supplied list.
 	RemoveFromWorkspaces(ctx context.Context, variableSetID string, options *VariableSetRemoveFromWorkspacesOptions) error
 
+	// ApplyByFilter applies the variable set to every workspace of its
+	// organization matching the filter, or removes it from them when the
+	// revert option is set.
+	ApplyByFilter(ctx context.Context, variableSetID string, filter WorkspaceFilter, options *VariableSetApplyByFilterOptions) ([]*VariableSetApplyResult, error)
+
 	// Apply variable set to projects in the supplied list.
 	ApplyToProjects(ctx context.Context, variableSetID string, options VariableSetApplyToProjectsOptions) error
 
@@ -52,6 +58,11 @@ type VariableSets interface {
 
 	// Update list of workspaces to which the variable set is applied to match the supplied list.
 	UpdateWorkspaces(ctx context.Context, variableSetID string, options *VariableSetUpdateWorkspacesOptions) (*VariableSet, error)
+
+	// EffectiveVariables lists the variables in effect for the runs of a
+	// workspace, merging its own variables with the ones of the variable sets
+	// applied to it according to their precedence.
+	EffectiveVariables(ctx context.Context, workspaceID string) ([]*EffectiveVariable, error)
 }
 
 // variableSets implements VariableSets.
@@ -80,6 +91,8 @@ type VariableSet struct {
 	Global      bool   `jsonapi:"attr,global"`
 	Priority    bool   `jsonapi:"attr,priority"`
 
+	Permissions *VariableSetPermissions `jsonapi:"attr,permissions"`
+
 	// Relations
 	Organization *Organization `jsonapi:"relation,organization"`
 	// Optional: Parent represents the variable set's parent (currently only organizations and projects are supported).
@@ -90,6 +103,14 @@ type VariableSet struct {
 	Variables  []*VariableSetVariable `jsonapi:"relation,vars,omitempty"`
 }
 
+// VariableSetPermissions represents the variable set permissions of the API
+// consumer.
+type VariableSetPermissions struct {
+	CanRead    bool `jsonapi:"attr,can-read"`
+	CanUpdate  bool `jsonapi:"attr,can-update"`
+	CanDestroy bool `jsonapi:"attr,can-destroy"`
+}
+
 // A list of relations to include. See available resources
 // https://developer.hashicorp.com/terraform/enterprise/api-docs/admin/organizations#available-related-resources
 type VariableSetIncludeOpt string
@@ -108,6 +129,9 @@ type VariableSetListOptions struct {
 	// Optional: A query string used to filter variable sets.
 	// Any variable sets with a name partially matching this value will be returned.
 	Query string `url:"q,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // VariableSetCreateOptions represents the options for creating a new variable set within in a organization.
@@ -141,6 +165,9 @@ type VariableSetCreateOptions struct {
 // VariableSetReadOptions represents the options for reading variable sets.
 type VariableSetReadOptions struct {
 	Include *[]VariableSetIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // VariableSetUpdateOptions represents the options for updating a variable set.
@@ -179,6 +206,58 @@ type VariableSetRemoveFromWorkspacesOptions struct {
 	Workspaces []*Workspace
 }
 
+// WorkspaceFilter selects the workspaces of an organization. A workspace must
+// match all of the given criteria.
+type WorkspaceFilter struct {
+	// Optional: The tag bindings a workspace must have.
+	TagBindings []*TagBinding
+
+	// Optional: The project a workspace must belong to.
+	Project *Project
+}
+
+// VariableSetApplyByFilterOptions represents the options for applying a
+// variable set to the workspaces matching a filter.
+type VariableSetApplyByFilterOptions struct {
+	// Optional: Remove the variable set from the matching workspaces instead
+	// of applying it.
+	Revert bool
+
+	// Optional: The maximum number of workspaces to update concurrently.
+	// Defaults to 1.
+	Concurrency int
+}
+
+// VariableSetApplyResult represents the outcome of applying a variable set to,
+// or removing it from, a single workspace.
+type VariableSetApplyResult struct {
+	Workspace *Workspace
+
+	// Err is the error returned while updating the workspace, if any.
+	Err error
+}
+
+// EffectiveVariable represents a variable in effect for the runs of a
+// workspace: either one of its own variables or one of a variable set applied
+// to it.
+type EffectiveVariable struct {
+	ID          string
+	Key         string
+	Value       string
+	Description string
+	Category    CategoryType
+	HCL         bool
+	Sensitive   bool
+
+	// The variable set the variable belongs to, or nil for a variable of the
+	// workspace itself.
+	VariableSet *VariableSet
+
+	// The variables with the same key and category the variable takes
+	// precedence over, from the highest precedence to the lowest.
+	Overridden []*EffectiveVariable
+}
+
 // VariableSetApplyToProjectsOptions represents the options for applying variable sets to projects.
 type VariableSetApplyToProjectsOptions struct {
 	// The projects to apply the variable set to (additive).
@@ -406,6 +485,52 @@ func (s *variableSets) RemoveFromWorkspaces(ctx context.Context, variableSetID s
 	return req.Do(ctx, nil)
 }
 
+// ApplyByFilter lists the workspaces of the organization of the variable set
+// that match the filter and applies the variable set to each of them, or
+// removes it when options.Revert is set. The workspaces are updated
+// concurrently up to options.Concurrency and a failed workspace does not stop
+// the others. Results are returned in the order the workspaces were listed.
+func (s *variableSets) ApplyByFilter(ctx context.Context, variableSetID string, filter WorkspaceFilter, options *VariableSetApplyByFilterOptions) ([]*VariableSetApplyResult, error) {
+	if !validStringID(&variableSetID) {
+		return nil, ErrInvalidVariableSetID
+	}
+	if err := filter.valid(); err != nil {
+		return nil, err
+	}
+	if options == nil {
+		options = &VariableSetApplyByFilterOptions{}
+	}
+
+	vs, err := s.Read(ctx, variableSetID, nil)
+	if err != nil {
+		return nil, err
+	}
+	if vs.Organization == nil {
+		return nil, ErrInvalidOrg
+	}
+
+	workspaces, err := listWorkspacesByFilter(ctx, s.client, vs.Organization.Name, &filter)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*VariableSetApplyResult, len(workspaces))
+	for i, ws := range workspaces {
+		results[i] = &VariableSetApplyResult{Workspace: ws}
+	}
+
+	forEachConcurrently(len(results), options.Concurrency, func(i int) {
+		ws := []*Workspace{results[i].Workspace}
+		if options.Revert {
+			results[i].Err = s.RemoveFromWorkspaces(ctx, variableSetID, &VariableSetRemoveFromWorkspacesOptions{Workspaces: ws})
+		} else {
+			results[i].Err = s.ApplyToWorkspaces(ctx, variableSetID, &VariableSetApplyToWorkspacesOptions{Workspaces: ws})
+		}
+	})
+
+	return results, nil
+}
+
 // ApplyToProjects applies the variable set to projects in the supplied list.
 // This method will return an error if the variable set has global = true.
 func (s variableSets) ApplyToProjects(ctx context.Context, variableSetID string, options VariableSetApplyToProjectsOptions) error {
@@ -472,6 +597,91 @@ func (s *variableSets) UpdateWorkspaces(ctx context.Context, variableSetID strin
 	return v, nil
 }
 
+// EffectiveVariables lists the variables in effect for the runs of a
+// workspace, sorted by category and key. Of the variables with the same key
+// and category, the one with the highest precedence is in effect:
+//
+//  1. variables of priority variable sets, which override all others,
+//  2. variables of the workspace,
+//  3. variables of the other variable sets.
+//
+// Among variable sets of the same priority, a set applied to the workspace
+// overrides a set applied to its project, which overrides a global set, and
+// sets of the same scope take precedence in the lexical order of their names.
+// Variables given to a run, such as RunCreateOptions.Variables, are not
+// included.
+func (s *variableSets) EffectiveVariables(ctx context.Context, workspaceID string) ([]*EffectiveVariable, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	var candidates []*effectiveCandidate
+
+	vsOpts := &VariableSetListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+		Include:     string(VariableSetVars),
+	}
+	err := forEachPage(&vsOpts.ListOptions, func() (*Pagination, error) {
+		vl, err := s.ListForWorkspace(ctx, workspaceID, vsOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, vs := range vl.Items {
+			rank := variableSetRank(vs, workspaceID)
+			for _, v := range vs.Variables {
+				candidates = append(candidates, &effectiveCandidate{
+					rank: rank,
+					name: vs.Name,
+					variable: &EffectiveVariable{
+						ID:          v.ID,
+						Key:         v.Key,
+						Value:       v.Value,
+						Description: v.Description,
+						Category:    v.Category,
+						HCL:         v.HCL,
+						Sensitive:   v.Sensitive,
+						VariableSet: vs,
+					},
+				})
+			}
+		}
+		return vl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	varOpts := &VariableListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+	}
+	err = forEachPage(&varOpts.ListOptions, func() (*Pagination, error) {
+		vl, err := s.client.Variables.List(ctx, workspaceID, varOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range vl.Items {
+			candidates = append(candidates, &effectiveCandidate{
+				rank: workspaceVariableRank,
+				variable: &EffectiveVariable{
+					ID:          v.ID,
+					Key:         v.Key,
+					Value:       v.Value,
+					Description: v.Description,
+					Category:    v.Category,
+					HCL:         v.HCL,
+					Sensitive:   v.Sensitive,
+				},
+			})
+		}
+		return vl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return mergeEffectiveVariables(candidates), nil
+}
+
 func (o *VariableSetListOptions) valid() error {
 	return nil
 }
@@ -507,6 +717,122 @@ func (o *VariableSetRemoveFromWorkspacesOptions) valid() error {
 	return nil
 }
 
+// listWorkspacesByFilter lists all the workspaces of an organization matching
+// the filter, or all the workspaces of the organization if the filter is nil.
+func listWorkspacesByFilter(ctx context.Context, client *Client, organization string, filter *WorkspaceFilter) ([]*Workspace, error) {
+	listOpts := &WorkspaceListOptions{ListOptions: ListOptions{PageSize: 100}}
+	if filter != nil {
+		listOpts.TagBindings = filter.TagBindings
+		if filter.Project != nil {
+			listOpts.ProjectID = filter.Project.ID
+		}
+	}
+
+	var workspaces []*Workspace
+	err := forEachPage(&listOpts.ListOptions, func() (*Pagination, error) {
+		wl, err := client.Workspaces.List(ctx, organization, listOpts)
+		if err != nil {
+			return nil, err
+		}
+		workspaces = append(workspaces, wl.Items...)
+		return wl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return workspaces, nil
+}
+
+// The ranks of the sources of the variables of a workspace, from the highest
+// precedence to the lowest.
+const (
+	priorityWorkspaceVariableSetRank = iota
+	priorityProjectVariableSetRank
+	priorityGlobalVariableSetRank
+	workspaceVariableRank
+	workspaceVariableSetRank
+	projectVariableSetRank
+	globalVariableSetRank
+)
+
+// effectiveCandidate is a variable that may be in effect for a workspace,
+// with the rank and name of its source.
+type effectiveCandidate struct {
+	rank     int
+	name     string
+	variable *EffectiveVariable
+}
+
+// variableSetRank returns the rank of a variable set applied to a workspace.
+// A variable set that is neither global nor applied to the workspace itself
+// is applied to its project.
+func variableSetRank(vs *VariableSet, workspaceID string) int {
+	rank := projectVariableSetRank
+	if vs.Global {
+		rank = globalVariableSetRank
+	} else {
+		for _, w := range vs.Workspaces {
+			if w != nil && w.ID == workspaceID {
+				rank = workspaceVariableSetRank
+				break
+			}
+		}
+	}
+
+	if vs.Priority {
+		rank -= workspaceVariableSetRank - priorityWorkspaceVariableSetRank
+	}
+	return rank
+}
+
+// mergeEffectiveVariables returns the variable in effect for each key and
+// category of the candidates, sorted by category and key.
+func mergeEffectiveVariables(candidates []*effectiveCandidate) []*EffectiveVariable {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		if candidates[i].rank != candidates[j].rank {
+			return candidates[i].rank < candidates[j].rank
+		}
+		return candidates[i].name < candidates[j].name
+	})
+
+	type variableKey struct {
+		category CategoryType
+		key      string
+	}
+
+	var effective []*EffectiveVariable
+	byKey := make(map[variableKey]*EffectiveVariable)
+	for _, c := range candidates {
+		k := variableKey{category: c.variable.Category, key: c.variable.Key}
+		if v, ok := byKey[k]; ok {
+			v.Overridden = append(v.Overridden, c.variable)
+			continue
+		}
+		byKey[k] = c.variable
+		effective = append(effective, c.variable)
+	}
+
+	sort.Slice(effective, func(i, j int) bool {
+		if effective[i].Category != effective[j].Category {
+			return effective[i].Category < effective[j].Category
+		}
+		return effective[i].Key < effective[j].Key
+	})
+
+	return effective
+}
+
+func (f WorkspaceFilter) valid() error {
+	if len(f.TagBindings) == 0 && f.Project == nil {
+		return ErrRequiredWorkspaceFilter
+	}
+	if f.Project != nil && !validStringID(&f.Project.ID) {
+		return ErrInvalidProjectID
+	}
+	return nil
+}
+
 func (o *VariableSetApplyToProjectsOptions) valid() error {
 	for _, s := range o.Projects {
 		if !validStringID(&s.ID) {
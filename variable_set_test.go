@@ -4,7 +4,11 @@
 package tfe
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -463,6 +467,93 @@ func TestVariableSetsApplyToAndRemoveFromWorkspaces(t *testing.T) {
 	})
 }
 
+func TestVariableSetsApplyByFilter(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	vsTest, vsTestCleanup := createVariableSet(t, client, orgTest, VariableSetCreateOptions{})
+	t.Cleanup(vsTestCleanup)
+
+	prjTest, prjTestCleanup := createProject(t, client, orgTest)
+	t.Cleanup(prjTestCleanup)
+
+	wTest1, wTest1Cleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:        String(randomString(t)),
+		Project:     prjTest,
+		TagBindings: []*TagBinding{{Key: "team", Value: "payments"}},
+	})
+	t.Cleanup(wTest1Cleanup)
+	wTest2, wTest2Cleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:        String(randomString(t)),
+		TagBindings: []*TagBinding{{Key: "team", Value: "payments"}},
+	})
+	t.Cleanup(wTest2Cleanup)
+	_, wTest3Cleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:        String(randomString(t)),
+		TagBindings: []*TagBinding{{Key: "team", Value: "search"}},
+	})
+	t.Cleanup(wTest3Cleanup)
+
+	paymentsFilter := WorkspaceFilter{
+		TagBindings: []*TagBinding{{Key: "team", Value: "payments"}},
+	}
+
+	t.Run("with tag bindings", func(t *testing.T) {
+		results, err := client.VariableSets.ApplyByFilter(ctx, vsTest.ID, paymentsFilter, &VariableSetApplyByFilterOptions{Concurrency: 2})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		for _, r := range results {
+			assert.NoError(t, r.Err)
+		}
+
+		vsAfter, err := client.VariableSets.Read(ctx, vsTest.ID, nil)
+		require.NoError(t, err)
+		require.Len(t, vsAfter.Workspaces, 2)
+		wsIDs := []string{vsAfter.Workspaces[0].ID, vsAfter.Workspaces[1].ID}
+		assert.Contains(t, wsIDs, wTest1.ID)
+		assert.Contains(t, wsIDs, wTest2.ID)
+	})
+
+	t.Run("with revert", func(t *testing.T) {
+		results, err := client.VariableSets.ApplyByFilter(ctx, vsTest.ID, paymentsFilter, &VariableSetApplyByFilterOptions{Revert: true})
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+
+		vsAfter, err := client.VariableSets.Read(ctx, vsTest.ID, nil)
+		require.NoError(t, err)
+		assert.Empty(t, vsAfter.Workspaces)
+	})
+
+	t.Run("with tag bindings and project", func(t *testing.T) {
+		filter := paymentsFilter
+		filter.Project = prjTest
+
+		results, err := client.VariableSets.ApplyByFilter(ctx, vsTest.ID, filter, nil)
+		require.NoError(t, err)
+		require.Len(t, results, 1)
+		assert.Equal(t, wTest1.ID, results[0].Workspace.ID)
+		assert.NoError(t, results[0].Err)
+	})
+
+	t.Run("without a filter", func(t *testing.T) {
+		_, err := client.VariableSets.ApplyByFilter(ctx, vsTest.ID, WorkspaceFilter{}, nil)
+		assert.Equal(t, ErrRequiredWorkspaceFilter, err)
+	})
+
+	t.Run("with an invalid project", func(t *testing.T) {
+		_, err := client.VariableSets.ApplyByFilter(ctx, vsTest.ID, WorkspaceFilter{Project: &Project{ID: badIdentifier}}, nil)
+		assert.Equal(t, ErrInvalidProjectID, err)
+	})
+
+	t.Run("when variable set ID is invalid", func(t *testing.T) {
+		_, err := client.VariableSets.ApplyByFilter(ctx, badIdentifier, paymentsFilter, nil)
+		assert.EqualError(t, err, ErrInvalidVariableSetID.Error())
+	})
+}
+
 func TestVariableSetsApplyToAndRemoveFromProjects(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -598,3 +689,133 @@ func TestVariableSetsUpdateWorkspaces(t *testing.T) {
 		assert.Equal(t, len(options.Workspaces), len(vsAfter.Workspaces))
 	})
 }
+
+func TestVariableSet_Unmarshal(t *testing.T) {
+	data := map[string]interface{}{
+		"data": map[string]interface{}{
+			"type": "varsets",
+			"id":   "varset-1234",
+			"attributes": map[string]interface{}{
+				"name": "my-set",
+				"permissions": map[string]interface{}{
+					"can-read":    true,
+					"can-update":  true,
+					"can-destroy": false,
+				},
+			},
+		},
+	}
+
+	byteData, err := json.Marshal(data)
+	require.NoError(t, err)
+
+	s := &VariableSet{}
+	err = unmarshalResponse(bytes.NewReader(byteData), s)
+	require.NoError(t, err)
+
+	assert.Equal(t, "varset-1234", s.ID)
+	require.NotNil(t, s.Permissions)
+	assert.True(t, s.Permissions.CanRead)
+	assert.True(t, s.Permissions.CanUpdate)
+	assert.False(t, s.Permissions.CanDestroy)
+}
+
+func TestVariableSetsEffectiveVariables(t *testing.T) {
+	t.Parallel()
+
+	varset := func(id, name string, global, priority bool, workspaces string, vars ...string) string {
+		return `{"id":"` + id + `","type":"varsets","attributes":{"name":"` + name + `","global":` +
+			strconv.FormatBool(global) + `,"priority":` + strconv.FormatBool(priority) + `},"relationships":{
+			"workspaces":{"data":[` + workspaces + `]},
+			"vars":{"data":[` + strings.Join(vars, ",") + `]}}}`
+	}
+	ref := func(id string) string {
+		return `{"id":"` + id + `","type":"vars"}`
+	}
+	variable := func(id, key, value, category string) string {
+		return `{"id":"` + id + `","type":"vars","attributes":{"key":"` + key + `","value":"` + value + `","category":"` + category + `"}}`
+	}
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-1/varsets": `{"data":[` +
+			varset("varset-global", "Defaults", true, false, ``, ref("var-g1"), ref("var-g2")) + `,` +
+			varset("varset-project", "Network", false, false, ``, ref("var-p1")) + `,` +
+			varset("varset-ws", "Overrides", false, false, `{"id":"ws-1","type":"workspaces"}`, ref("var-w1")) + `,` +
+			varset("varset-priority", "Policy", true, true, ``, ref("var-pr1")) + `],
+			"included":[` +
+			variable("var-g1", "region", "us-east-1", "terraform") + `,` +
+			variable("var-g2", "AWS_REGION", "us-east-1", "env") + `,` +
+			variable("var-p1", "region", "eu-west-1", "terraform") + `,` +
+			variable("var-w1", "instance_type", "t3.small", "terraform") + `,` +
+			variable("var-pr1", "cost_center", "42", "terraform") + `],
+			"meta":{"pagination":{"current-page":1,"next-page":null,"total-pages":1,"total-count":4}}}`,
+		"GET /api/v2/workspaces/ws-1/vars": `{"data":[` +
+			variable("var-1", "instance_type", "t3.large", "terraform") + `,` +
+			variable("var-2", "cost_center", "7", "terraform") + `,` +
+			variable("var-3", "region", "ap-south-1", "env") + `],
+			"meta":{"pagination":{"current-page":1,"next-page":null,"total-pages":1,"total-count":3}}}`,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("merges the variables by precedence", func(t *testing.T) {
+		vars, err := client.VariableSets.EffectiveVariables(ctx, "ws-1")
+		require.NoError(t, err)
+
+		byID := make(map[string]*EffectiveVariable)
+		var keys []string
+		for _, v := range vars {
+			byID[v.ID] = v
+			keys = append(keys, string(v.Category)+"/"+v.Key)
+		}
+		assert.Equal(t, []string{
+			"env/AWS_REGION", "env/region",
+			"terraform/cost_center", "terraform/instance_type", "terraform/region",
+		}, keys)
+
+		// A priority variable set overrides the workspace.
+		require.Contains(t, byID, "var-pr1")
+		require.NotNil(t, byID["var-pr1"].VariableSet)
+		assert.True(t, byID["var-pr1"].VariableSet.Priority)
+		require.Len(t, byID["var-pr1"].Overridden, 1)
+		assert.Equal(t, "var-2", byID["var-pr1"].Overridden[0].ID)
+
+		// The workspace overrides the other variable sets.
+		require.Contains(t, byID, "var-1")
+		assert.Nil(t, byID["var-1"].VariableSet)
+		require.Len(t, byID["var-1"].Overridden, 1)
+		assert.Equal(t, "var-w1", byID["var-1"].Overridden[0].ID)
+
+		// A project variable set overrides a global one.
+		require.Contains(t, byID, "var-p1")
+		require.Len(t, byID["var-p1"].Overridden, 1)
+		assert.Equal(t, "var-g1", byID["var-p1"].Overridden[0].ID)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		_, err := client.VariableSets.EffectiveVariables(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
+func TestVariableSetRank(t *testing.T) {
+	t.Parallel()
+
+	workspace := &VariableSet{Name: "b", Workspaces: []*Workspace{{ID: "ws-1"}}}
+	project := &VariableSet{Name: "b", Workspaces: []*Workspace{{ID: "ws-2"}}}
+	global := &VariableSet{Name: "b", Global: true}
+
+	assert.Less(t, variableSetRank(workspace, "ws-1"), variableSetRank(project, "ws-1"))
+	assert.Less(t, variableSetRank(project, "ws-1"), variableSetRank(global, "ws-1"))
+	assert.Less(t, workspaceVariableRank, variableSetRank(workspace, "ws-1"))
+
+	global.Priority = true
+	assert.Less(t, variableSetRank(global, "ws-1"), workspaceVariableRank)
+
+	merged := mergeEffectiveVariables([]*effectiveCandidate{
+		{rank: globalVariableSetRank, name: "b", variable: &EffectiveVariable{ID: "var-b", Key: "k", Category: CategoryTerraform}},
+		{rank: globalVariableSetRank, name: "a", variable: &EffectiveVariable{ID: "var-a", Key: "k", Category: CategoryTerraform}},
+	})
+	require.Len(t, merged, 1)
+	assert.Equal(t, "var-a", merged[0].ID)
+}
@@ -0,0 +1,18 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tfe
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestVariableChangedSince(t *testing.T) {
+	t.Parallel()
+
+	v := &Variable{ID: "var-1", Sensitive: true, VersionID: "v2"}
+	assert.False(t, v.ChangedSince("v2"))
+	assert.True(t, v.ChangedSince("v1"))
+}
@@ -4,14 +4,21 @@
 package tfe
 
 import (
+	"container/list"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"net/url"
+	"reflect"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
+	version "github.com/hashicorp/go-version"
 	"github.com/hashicorp/jsonapi"
+	"golang.org/x/sync/errgroup"
 )
 
 // Compile-time proof of interface implementation.
@@ -25,6 +32,10 @@ type Workspaces interface {
 	// List all the workspaces within an organization.
 	List(ctx context.Context, organization string, options *WorkspaceListOptions) (*WorkspaceList, error)
 
+	// ListAll streams all the workspaces of an organization matching the
+	// given options, page by page.
+	ListAll(ctx context.Context, organization string, options *WorkspaceListOptions) (<-chan *Workspace, <-chan error)
+
 	// Create is used to create a new workspace.
 	Create(ctx context.Context, organization string, options WorkspaceCreateOptions) (*Workspace, error)
 
@@ -37,18 +48,53 @@ type Workspaces interface {
 	// Readme gets the readme of a workspace by its ID.
 	Readme(ctx context.Context, workspaceID string) (io.Reader, error)
 
+	// ReadReadme reads the README of a workspace, along with its metadata,
+	// from its current configuration version.
+	ReadReadme(ctx context.Context, workspaceID string) (*ConfigurationReadme, error)
+
+	// ReadAutoDestroyStatus reads when a workspace is next scheduled to be
+	// destroyed automatically, and whether that is set on the workspace or
+	// inherited from its project.
+	ReadAutoDestroyStatus(ctx context.Context, workspaceID string) (*WorkspaceAutoDestroyStatus, error)
+
 	// ReadByID reads a workspace by its ID.
 	ReadByID(ctx context.Context, workspaceID string) (*Workspace, error)
 
 	// ReadByIDWithOptions reads a workspace by its ID with the given options.
 	ReadByIDWithOptions(ctx context.Context, workspaceID string, options *WorkspaceReadOptions) (*Workspace, error)
 
+	// PreviewAutoDestroy lists the workspaces of an organization scheduled
+	// to be destroyed automatically within the given duration, soonest first.
+	// Nothing is destroyed.
+	PreviewAutoDestroy(ctx context.Context, organization string, within time.Duration) ([]*WorkspaceAutoDestroyStatus, error)
+
+	// ReadCurrentConfigurationVersion reads the current configuration
+	// version of a workspace, with its ingress attributes.
+	ReadCurrentConfigurationVersion(ctx context.Context, workspaceID string) (*ConfigurationVersion, error)
+
+	// ReadFollowRename reads a workspace by name and organization name,
+	// following renames of workspaces previously read by this client.
+	ReadFollowRename(ctx context.Context, organization, workspace string) (*Workspace, error)
+
+	// ReadMany resolves the given workspace names of an organization to
+	// their workspaces, reporting the names of the workspaces not found.
+	ReadMany(ctx context.Context, organization string, names []string) (*WorkspaceReadManyResult, error)
+
 	// Update settings of an existing workspace.
 	Update(ctx context.Context, organization string, workspace string, options WorkspaceUpdateOptions) (*Workspace, error)
 
 	// UpdateByID updates the settings of an existing workspace.
 	UpdateByID(ctx context.Context, workspaceID string, options WorkspaceUpdateOptions) (*Workspace, error)
 
+	// UpdateAttributes sends exactly the given attributes, keyed by their API
+	// name, to partially update an existing workspace. It is meant as an escape
+	// hatch for attributes not yet supported by WorkspaceUpdateOptions.
+	UpdateAttributes(ctx context.Context, workspaceID string, attributes map[string]interface{}) (*Workspace, error)
+
+	// UpdateRelationship replaces a single relationship of an existing
+	// workspace, such as its project, without sending any attribute.
+	UpdateRelationship(ctx context.Context, workspaceID string, options RelationshipUpdateOptions) (*Workspace, error)
+
 	// Delete a workspace by its name.
 	Delete(ctx context.Context, organization string, workspace string) error
 
@@ -61,12 +107,25 @@ type Workspaces interface {
 	// SafeDeleteByID deletes a workspace by its ID.
 	SafeDeleteByID(ctx context.Context, workspaceID string) error
 
+	// SafeDeleteDryRun reports whether a workspace could be safely deleted,
+	// and why not, without deleting it.
+	SafeDeleteDryRun(ctx context.Context, workspaceID string) (*WorkspaceSafeDeleteCheck, error)
+
 	// RemoveVCSConnection from a workspace.
 	RemoveVCSConnection(ctx context.Context, organization, workspace string) (*Workspace, error)
 
 	// RemoveVCSConnectionByID removes a VCS connection from a workspace.
 	RemoveVCSConnectionByID(ctx context.Context, workspaceID string) (*Workspace, error)
 
+	// ResolveEffectiveTerraformVersion reports the Terraform version that the
+	// Terraform version, or version constraint, of a workspace resolves to.
+	ResolveEffectiveTerraformVersion(ctx context.Context, workspaceID string) (*EffectiveTerraformVersion, error)
+
+	// UpdateVCSConnection connects a workspace to a VCS repository through
+	// either an OAuth token or a GitHub App installation, replacing its
+	// current connection.
+	UpdateVCSConnection(ctx context.Context, workspaceID string, options WorkspaceVCSConnectionOptions) (*Workspace, error)
+
 	// Lock a workspace by its ID.
 	Lock(ctx context.Context, workspaceID string, options WorkspaceLockOptions) (*Workspace, error)
 
@@ -76,6 +135,13 @@ type Workspaces interface {
 	// ForceUnlock a workspace by its ID.
 	ForceUnlock(ctx context.Context, workspaceID string) (*Workspace, error)
 
+	// ReadLockInfo reads who holds the lock of a workspace, and why.
+	ReadLockInfo(ctx context.Context, workspaceID string) (*WorkspaceLockInfo, error)
+
+	// ReadOutputs reads the outputs of the current state version of a
+	// workspace, keyed by name, including the values of sensitive outputs.
+	ReadOutputs(ctx context.Context, workspaceID string) (map[string]StateVersionOutput, error)
+
 	// AssignSSHKey to a workspace.
 	AssignSSHKey(ctx context.Context, workspaceID string, options WorkspaceAssignSSHKeyOptions) (*Workspace, error)
 
@@ -95,6 +161,19 @@ type Workspaces interface {
 	// to match the workspaces in the update options.
 	UpdateRemoteStateConsumers(ctx context.Context, workspaceID string, options WorkspaceUpdateRemoteStateConsumersOptions) error
 
+	// ListAllRemoteStateConsumers lists all the remote state consumers of a
+	// workspace, across all pages.
+	ListAllRemoteStateConsumers(ctx context.Context, workspaceID string) ([]*Workspace, error)
+
+	// IsRemoteStateConsumer reports whether a workspace is a remote state
+	// consumer of another workspace.
+	IsRemoteStateConsumer(ctx context.Context, producerID string, consumerID string) (bool, error)
+
+	// ReplaceRemoteStateConsumers adds and removes the remote state consumers
+	// of a workspace to match the workspaces in the replace options, and
+	// returns the changes applied.
+	ReplaceRemoteStateConsumers(ctx context.Context, workspaceID string, options WorkspaceReplaceRemoteStateConsumersOptions) (*RemoteStateConsumersChanges, error)
+
 	// ListTags reads the tags for a workspace.
 	ListTags(ctx context.Context, workspaceID string, options *WorkspaceTagListOptions) (*TagList, error)
 
@@ -139,16 +218,191 @@ type Workspaces interface {
 	// either inherited from a project or binded to the workspace itself.
 	ListEffectiveTagBindings(ctx context.Context, workspaceID string) ([]*EffectiveTagBinding, error)
 
+	// ListEffectiveTagBindingsWithOptions lists the effective tag bindings of the workspace
+	// using the options supplied, e.g. to leave out the bindings inherited from its project.
+	ListEffectiveTagBindingsWithOptions(ctx context.Context, workspaceID string, options *WorkspaceEffectiveTagBindingsListOptions) ([]*EffectiveTagBinding, error)
+
 	// AddTagBindings adds or modifies the value of existing tag binding keys for a workspace.
 	AddTagBindings(ctx context.Context, workspaceID string, options WorkspaceAddTagBindingsOptions) ([]*TagBinding, error)
 
+	// RemoveTagBindings removes the tag bindings with the given keys from a workspace.
+	RemoveTagBindings(ctx context.Context, workspaceID string, keys []string) error
+
+	// DeleteTagBindings removes the tag bindings with the given keys from a workspace.
+	//
+	// Deprecated: Use RemoveTagBindings instead.
+	DeleteTagBindings(ctx context.Context, workspaceID string, keys []string) error
+
 	// DeleteAllTagBindings removes all tag bindings for a workspace.
 	DeleteAllTagBindings(ctx context.Context, workspaceID string) error
+
+	// Snapshot reads a workspace by its ID and returns its settings in a
+	// normalized form that can be compared with DiffSettings.
+	Snapshot(ctx context.Context, workspaceID string) (*WorkspaceSettings, error)
+
+	// Can reads the permissions the current user has on a workspace and
+	// reports whether the operation is allowed. When it is not, the name of
+	// the missing permission is returned as well.
+	Can(ctx context.Context, workspaceID string, operation WorkspaceOperation) (bool, string, error)
 }
 
 // workspaces implements Workspaces.
 type workspaces struct {
 	client *Client
+
+	// ids maps the "organization/name" of the workspaces read by the client
+	// to their IDs, so ReadFollowRename can find them once renamed.
+	ids workspaceIDs
+}
+
+const (
+	// workspaceIDsSize is the maximum number of workspace names whose IDs are
+	// remembered for ReadFollowRename.
+	workspaceIDsSize = 10000
+
+	// workspaceIDsTTL is how long the ID of a workspace name is remembered
+	// for ReadFollowRename after the workspace was last read under that name.
+	workspaceIDsTTL = 24 * time.Hour
+)
+
+// workspaceIDs remembers the IDs of the workspaces read by the client by
+// their "organization/name". It holds at most size names, evicting the least
+// recently read one, and forgets the names not read again within ttl. The
+// zero value is ready to use, with workspaceIDsSize and workspaceIDsTTL.
+type workspaceIDs struct {
+	mu      sync.Mutex
+	size    int
+	ttl     time.Duration
+	entries map[string]*list.Element
+	order   *list.List // The most recently read name is in front.
+}
+
+type workspaceIDEntry struct {
+	key     string
+	id      string
+	expires time.Time
+}
+
+// Store records the ID of the workspace read under the key.
+func (c *workspaceIDs) Store(key, id string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[string]*list.Element)
+		c.order = list.New()
+	}
+	size, ttl := c.size, c.ttl
+	if size <= 0 {
+		size = workspaceIDsSize
+	}
+	if ttl <= 0 {
+		ttl = workspaceIDsTTL
+	}
+
+	entry := &workspaceIDEntry{key: key, id: id, expires: time.Now().Add(ttl)}
+	if e, ok := c.entries[key]; ok {
+		e.Value = entry
+		c.order.MoveToFront(e)
+		return
+	}
+	c.entries[key] = c.order.PushFront(entry)
+
+	for c.order.Len() > size {
+		oldest := c.order.Back()
+		c.order.Remove(oldest)
+		delete(c.entries, oldest.Value.(*workspaceIDEntry).key)
+	}
+}
+
+// Load returns the ID of the workspace last read under the key, unless it
+// was forgotten.
+func (c *workspaceIDs) Load(key string) (string, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return "", false
+	}
+	entry := e.Value.(*workspaceIDEntry)
+	if time.Now().After(entry.expires) {
+		c.order.Remove(e)
+		delete(c.entries, key)
+		return "", false
+	}
+
+	return entry.id, true
+}
+
+// WorkspaceRenamedError is returned by ReadFollowRename along with the
+// workspace when the workspace was found under a new name. It wraps
+// ErrWorkspaceRenamed.
+type WorkspaceRenamedError struct {
+	WorkspaceID string
+	OldName     string
+	NewName     string
+}
+
+// Error returns a message naming both the old and the new name.
+func (e *WorkspaceRenamedError) Error() string {
+	return fmt.Sprintf("%s: %q is now %q", ErrWorkspaceRenamed, e.OldName, e.NewName)
+}
+
+// Unwrap returns ErrWorkspaceRenamed.
+func (e *WorkspaceRenamedError) Unwrap() error {
+	return ErrWorkspaceRenamed
+}
+
+// listAllPageAttempts is the number of times ListAll requests a page that
+// failed with a transient error before giving up.
+const listAllPageAttempts = 3
+
+// readManyConcurrency is the maximum number of concurrent requests made by
+// ReadMany.
+const readManyConcurrency = 10
+
+// WorkspaceReadManyResult represents the workspaces resolved by ReadMany.
+type WorkspaceReadManyResult struct {
+	// The workspaces found, keyed by name.
+	Workspaces map[string]*Workspace
+
+	// The names without a matching workspace, in the order they were given.
+	Missing []string
+}
+
+// AutoDestroyOrigin represents where the auto-destroy settings of a
+// workspace are set.
+type AutoDestroyOrigin string
+
+// List of available auto-destroy origins.
+const (
+	AutoDestroyOriginNone      AutoDestroyOrigin = "none"
+	AutoDestroyOriginWorkspace AutoDestroyOrigin = "workspace"
+	AutoDestroyOriginProject   AutoDestroyOrigin = "project"
+)
+
+// WorkspaceAutoDestroyStatus represents when a workspace is next scheduled to
+// be destroyed automatically.
+type WorkspaceAutoDestroyStatus struct {
+	Workspace *Workspace
+
+	// The time of the next scheduled destroy run, as reported by the
+	// auto-destroy-at attribute of the workspace, or the zero time when none
+	// is scheduled.
+	ScheduledAt time.Time
+
+	// The duration of inactivity after which the workspace is destroyed,
+	// e.g. "14d", or an empty string when none applies.
+	ActivityDuration string
+
+	// Where the auto-destroy settings applying to the workspace are set.
+	Origin AutoDestroyOrigin
+}
+
+// Scheduled reports whether a destroy run is scheduled for the workspace.
+func (s *WorkspaceAutoDestroyStatus) Scheduled() bool {
+	return !s.ScheduledAt.IsZero()
 }
 
 // WorkspaceList represents a list of workspaces.
@@ -163,6 +417,14 @@ type WorkspaceAddTagBindingsOptions struct {
 	TagBindings []*TagBinding
 }
 
+// WorkspaceEffectiveTagBindingsListOptions represents the options for listing
+// the effective tag bindings of a workspace.
+type WorkspaceEffectiveTagBindingsListOptions struct {
+	// Optional: Leave out the tag bindings inherited from the workspace's
+	// project. This filter is applied by the client after the list is read.
+	ExcludeInherited bool `url:"-"`
+}
+
 // LockedByChoice is a choice type struct that represents the possible values
 // within a polymorphic relation. If a value is available, exactly one field
 // will be non-nil.
@@ -191,6 +453,7 @@ type Workspace struct {
 	GlobalRemoteState           bool                            `jsonapi:"attr,global-remote-state"`
 	InheritsProjectAutoDestroy  bool                            `jsonapi:"attr,inherits-project-auto-destroy"`
 	Locked                      bool                            `jsonapi:"attr,locked"`
+	LockedReason                string                          `jsonapi:"attr,locked-reason"`
 	MigrationEnvironment        string                          `jsonapi:"attr,migration-environment"`
 	Name                        string                          `jsonapi:"attr,name"`
 	NoCodeUpgradeAvailable      bool                            `jsonapi:"attr,no-code-upgrade-available"`
@@ -275,6 +538,46 @@ type VCSRepo struct {
 	WebhookURL        string `jsonapi:"attr,webhook-url"`
 }
 
+// WorkspaceSettings is a normalized snapshot of the settings of a workspace.
+// Relations are reduced to their ID and lists are sorted, so two snapshots
+// can be compared with DiffSettings. The setting tag of each field holds the
+// name of the attribute reported in a WorkspaceSettingsChange.
+type WorkspaceSettings struct {
+	Name                       string   `setting:"name"`
+	Description                string   `setting:"description"`
+	ExecutionMode              string   `setting:"execution-mode"`
+	AgentPoolID                string   `setting:"agent-pool"`
+	ProjectID                  string   `setting:"project"`
+	SSHKeyID                   string   `setting:"ssh-key"`
+	AllowDestroyPlan           bool     `setting:"allow-destroy-plan"`
+	AssessmentsEnabled         bool     `setting:"assessments-enabled"`
+	AutoApply                  bool     `setting:"auto-apply"`
+	AutoApplyRunTrigger        bool     `setting:"auto-apply-run-trigger"`
+	FileTriggersEnabled        bool     `setting:"file-triggers-enabled"`
+	GlobalRemoteState          bool     `setting:"global-remote-state"`
+	QueueAllRuns               bool     `setting:"queue-all-runs"`
+	SpeculativeEnabled         bool     `setting:"speculative-enabled"`
+	StructuredRunOutputEnabled bool     `setting:"structured-run-output-enabled"`
+	TerraformVersion           string   `setting:"terraform-version"`
+	TriggerPrefixes            []string `setting:"trigger-prefixes"`
+	TriggerPatterns            []string `setting:"trigger-patterns"`
+	WorkingDirectory           string   `setting:"working-directory"`
+	VCSRepoIdentifier          string   `setting:"vcs-repo.identifier"`
+	VCSRepoBranch              string   `setting:"vcs-repo.branch"`
+	VCSRepoIngressSubmodules   bool     `setting:"vcs-repo.ingress-submodules"`
+	VCSRepoTagsRegex           string   `setting:"vcs-repo.tags-regex"`
+	TagNames                   []string `setting:"tag-names"`
+}
+
+// WorkspaceSettingsChange represents a setting that differs between two
+// workspace settings snapshots.
+type WorkspaceSettingsChange struct {
+	// The name of the attribute, e.g. "auto-apply" or "vcs-repo.branch".
+	Attribute string
+	Old       interface{}
+	New       interface{}
+}
+
 // Note: the fields of this struct are bool pointers instead of bool values, in order to simplify support for
 // future TFE versions that support *some but not all* of the inherited defaults that go-tfe knows about.
 type WorkspaceSettingOverwrites struct {
@@ -303,6 +606,50 @@ type WorkspacePermissions struct {
 	CanForceDelete    *bool `jsonapi:"attr,can-force-delete"` // pointer b/c it will be useful to check if this property exists, as opposed to having it default to false
 }
 
+// WorkspaceOperation represents a common operation on a workspace whose
+// permissions can be checked before it is attempted.
+type WorkspaceOperation string
+
+// List of available workspace operations.
+const (
+	OperationQueuePlan       WorkspaceOperation = "queue-plan"
+	OperationApply           WorkspaceOperation = "apply"
+	OperationManageVariables WorkspaceOperation = "manage-variables"
+	OperationLockUnlock      WorkspaceOperation = "lock-unlock"
+)
+
+// Can reports whether the permissions allow the operation. When they do not,
+// the name of the first missing permission, e.g. "can-queue-apply", is
+// returned as well.
+func (p *WorkspacePermissions) Can(operation WorkspaceOperation) (bool, string, error) {
+	type permission struct {
+		name    string
+		granted bool
+	}
+
+	var required []permission
+	switch operation {
+	case OperationQueuePlan:
+		required = []permission{{"can-queue-run", p.CanQueueRun}}
+	case OperationApply:
+		required = []permission{{"can-queue-apply", p.CanQueueApply}}
+	case OperationManageVariables:
+		required = []permission{{"can-update-variable", p.CanUpdateVariable}}
+	case OperationLockUnlock:
+		required = []permission{{"can-lock", p.CanLock}, {"can-unlock", p.CanUnlock}}
+	default:
+		return false, "", fmt.Errorf("%w: %q", ErrInvalidWorkspaceOperation, operation)
+	}
+
+	for _, perm := range required {
+		if !perm.granted {
+			return false, perm.name, nil
+		}
+	}
+
+	return true, "", nil
+}
+
 // WSIncludeOpt represents the available options for include query params.
 // https://developer.hashicorp.com/terraform/cloud-docs/api-docs/workspaces#available-related-resources
 type WSIncludeOpt string
@@ -328,6 +675,9 @@ type WorkspaceReadOptions struct {
 	// Optional: A list of relations to include.
 	// https://developer.hashicorp.com/terraform/cloud-docs/api-docs/workspaces#available-related-resources
 	Include []WSIncludeOpt `url:"include,omitempty"`
+
+	// Optional: The sparse fieldsets of the response.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // WorkspaceListOptions represents the options for listing workspaces.
@@ -337,10 +687,20 @@ type WorkspaceListOptions struct {
 	// Optional: A search string (partial workspace name) used to filter the results.
 	Search string `url:"search[name],omitempty"`
 
+	// Optional: A list of tag names used to filter the results.
+	TagNames []string `url:"search[tags],omitempty"`
+
+	// Optional: A list of tag names to exclude from the results.
+	ExcludeTagNames []string `url:"search[exclude-tags],omitempty"`
+
 	// Optional: A search string (comma-separated tag names) used to filter the results.
+	//
+	// Deprecated: Use TagNames instead.
 	Tags string `url:"search[tags],omitempty"`
 
 	// Optional: A search string (comma-separated tag names to exclude) used to filter the results.
+	//
+	// Deprecated: Use ExcludeTagNames instead.
 	ExcludeTags string `url:"search[exclude-tags],omitempty"`
 
 	// Optional: A search on substring matching to filter the results.
@@ -349,7 +709,13 @@ type WorkspaceListOptions struct {
 	// Optional: A filter string to list all the workspaces linked to a given project id in the organization.
 	ProjectID string `url:"filter[project][id],omitempty"`
 
+	// Optional: A list of run statuses to list the workspaces whose current
+	// run has one of them.
+	CurrentRunStatuses []RunStatus `url:"filter[current-run][status],omitempty"`
+
 	// Optional: A filter string to list all the workspaces filtered by current run status.
+	//
+	// Deprecated: Use CurrentRunStatuses instead.
 	CurrentRunStatus string `url:"filter[current-run][status],omitempty"`
 
 	// Optional: A filter string to list workspaces filtered by key/value tags.
@@ -362,6 +728,9 @@ type WorkspaceListOptions struct {
 	// Optional: May sort on "name" (the default) and "current-run.created-at" (which sorts by the time of the current run)
 	// Prepending a hyphen to the sort parameter will reverse the order (e.g. "-name" to reverse the default order)
 	Sort string `url:"sort,omitempty"`
+
+	// Optional: The sparse fieldsets of the response, to trim large lists.
+	Fields Fieldsets `url:"fields,omitempty"`
 }
 
 // WorkspaceCreateOptions represents the options for creating a new workspace.
@@ -463,6 +832,12 @@ type WorkspaceCreateOptions struct {
 	// workspace, the latest version is selected unless otherwise specified.
 	TerraformVersion *string `jsonapi:"attr,terraform-version,omitempty"`
 
+	// A version constraint, such as "~> 1.7.0", selecting the version of
+	// Terraform to use for this workspace. It is validated before being sent
+	// as the Terraform version of the workspace, and cannot be set along with
+	// TerraformVersion.
+	TerraformVersionConstraint *string
+
 	// List of repository-root-relative paths which list all locations to be
 	// tracked for changes. See FileTriggersEnabled above for more details.
 	TriggerPrefixes []string `jsonapi:"attr,trigger-prefixes,omitempty"`
@@ -502,12 +877,22 @@ type WorkspaceCreateOptions struct {
 	// of the organization will be assigned to the workspace.
 	Project *Project `jsonapi:"relation,project,omitempty"`
 
+	// The name of the project to associate with the workspace, as an
+	// alternative to Project. The project is read by its name with
+	// Projects.ReadByName before the workspace is created, and cannot be set
+	// along with Project.
+	ProjectName *string
+
 	// Associated TagBindings of the workspace.
 	TagBindings []*TagBinding `jsonapi:"relation,tag-bindings,omitempty"`
 }
 
 // TODO: move this struct out. VCSRepoOptions is used by workspaces, policy sets, and registry modules
 // VCSRepoOptions represents the configuration options of a VCS integration.
+//
+// OAuthTokenID and GHAInstallationID are mutually exclusive. To switch the
+// connection of a workspace from one to the other, set the one to use and
+// set the other to an empty string, or use Workspaces.UpdateVCSConnection.
 type VCSRepoOptions struct {
 	Branch            *string `json:"branch,omitempty"`
 	Identifier        *string `json:"identifier,omitempty"`
@@ -517,6 +902,77 @@ type VCSRepoOptions struct {
 	GHAInstallationID *string `json:"github-app-installation-id,omitempty"`
 }
 
+// EffectiveTerraformVersionSource represents how the effective Terraform
+// version of a workspace was resolved.
+type EffectiveTerraformVersionSource string
+
+// List all available effective Terraform version sources.
+const (
+	// The workspace uses an exact Terraform version.
+	EffectiveTerraformVersionExact EffectiveTerraformVersionSource = "exact"
+	// The highest enabled Terraform version of the instance matching the
+	// constraint of the workspace.
+	EffectiveTerraformVersionAvailable EffectiveTerraformVersionSource = "available-versions"
+	// The Terraform version of the latest run of the workspace, used when the
+	// Terraform versions of the instance cannot be listed.
+	EffectiveTerraformVersionLatestRun EffectiveTerraformVersionSource = "latest-run"
+)
+
+// EffectiveTerraformVersion represents the Terraform version a workspace
+// currently resolves to.
+type EffectiveTerraformVersion struct {
+	// The Terraform version, or version constraint, of the workspace.
+	Constraint string
+
+	// The Terraform version the constraint resolves to.
+	Version string
+
+	Source EffectiveTerraformVersionSource
+}
+
+// WorkspaceVCSConnectionOptions represents the options for connecting a
+// workspace to a VCS repository. Exactly one of OAuthTokenID and
+// GHAInstallationID must be set. The other settings default to those of the
+// current VCS repository of the workspace.
+type WorkspaceVCSConnectionOptions struct {
+	// The ID of the OAuth token to connect the repository with.
+	OAuthTokenID *string
+
+	// The ID of the GitHub App installation to connect the repository with.
+	GHAInstallationID *string
+
+	// Required if the workspace has no VCS repository: the reference of the
+	// repository, in the format :org/:repo.
+	Identifier *string
+
+	// Optional: The branch of the repository to track.
+	Branch *string
+
+	// Optional: Whether submodules are fetched along with the repository.
+	IngressSubmodules *bool
+
+	// Optional: The regular expression of the tags triggering runs.
+	TagsRegex *string
+}
+
+// workspaceVCSConnection is the VCS repository of a workspace. Unlike
+// VCSRepoOptions, the connection it does not use is sent as null, so the API
+// clears it.
+type workspaceVCSConnection struct {
+	Branch            *string `json:"branch,omitempty"`
+	Identifier        *string `json:"identifier"`
+	IngressSubmodules *bool   `json:"ingress-submodules,omitempty"`
+	OAuthTokenID      *string `json:"oauth-token-id"`
+	TagsRegex         *string `json:"tags-regex,omitempty"`
+	GHAInstallationID *string `json:"github-app-installation-id"`
+}
+
+// workspaceUpdateVCSConnectionOptions
+type workspaceUpdateVCSConnectionOptions struct {
+	ID      string                  `jsonapi:"primary,workspaces"`
+	VCSRepo *workspaceVCSConnection `jsonapi:"attr,vcs-repo"`
+}
+
 type WorkspaceSettingOverwritesOptions struct {
 	// If false, the workspace will defer to its organization or project's DefaultExecutionMode value.
 	ExecutionMode *bool `json:"execution-mode,omitempty"`
@@ -609,6 +1065,12 @@ type WorkspaceUpdateOptions struct {
 	// Optional: The version of Terraform to use for this workspace.
 	TerraformVersion *string `jsonapi:"attr,terraform-version,omitempty"`
 
+	// Optional: A version constraint, such as "~> 1.7.0", selecting the
+	// version of Terraform to use for this workspace. It is validated before
+	// being sent as the Terraform version of the workspace, and cannot be set
+	// along with TerraformVersion.
+	TerraformVersionConstraint *string
+
 	// Optional: List of repository-root-relative paths which list all locations to be
 	// tracked for changes. See FileTriggersEnabled above for more details.
 	TriggerPrefixes []string `jsonapi:"attr,trigger-prefixes,omitempty"`
@@ -658,6 +1120,80 @@ type WorkspaceLockOptions struct {
 	Reason *string `jsonapi:"attr,reason,omitempty"`
 }
 
+// WorkspaceLockInfo represents the lock of a workspace.
+type WorkspaceLockInfo struct {
+	Locked bool
+
+	// The reason given when the workspace was locked.
+	Reason string
+
+	// The holder of the lock. Exactly one of them is set when the workspace
+	// is locked.
+	Run  *Run
+	User *User
+	Team *Team
+
+	// An approximation of the time the lock was acquired, which the API does
+	// not report. When the lock is held by a run, it is the time the run was
+	// queued for planning, which is when a run locks its workspace. When the
+	// lock is held by a user or a team, it is zero.
+	AcquiredAt time.Time
+}
+
+// WorkspaceSafeDeleteReason represents a reason a workspace cannot be safely
+// deleted.
+type WorkspaceSafeDeleteReason string
+
+// List of available reasons a workspace cannot be safely deleted.
+const (
+	// The workspace is locked, and must be unlocked first.
+	SafeDeleteLocked WorkspaceSafeDeleteReason = "locked"
+	// The latest state of the workspace is still being processed to discover
+	// its resources.
+	SafeDeleteStillProcessing WorkspaceSafeDeleteReason = "still-processing"
+	// The workspace is still managing resources, which must be destroyed
+	// first.
+	SafeDeleteManagingResources WorkspaceSafeDeleteReason = "managing-resources"
+	// The caller is not permitted to delete the workspace.
+	SafeDeleteNotPermitted WorkspaceSafeDeleteReason = "not-permitted"
+)
+
+// WorkspaceSafeDeleteCheck represents whether a workspace can be safely
+// deleted, as reported by SafeDeleteDryRun.
+type WorkspaceSafeDeleteCheck struct {
+	Workspace *Workspace
+
+	// The reasons the workspace cannot be safely deleted, empty when it can.
+	Reasons []WorkspaceSafeDeleteReason
+
+	// Whether the caller is permitted to force delete the workspace with
+	// Delete or DeleteByID, regardless of its resources.
+	CanForceDelete bool
+}
+
+// Safe reports whether the workspace can be safely deleted.
+func (c *WorkspaceSafeDeleteCheck) Safe() bool {
+	return len(c.Reasons) == 0
+}
+
+// Err returns the error SafeDelete would return for the first reason the
+// workspace cannot be safely deleted, or nil when it can.
+func (c *WorkspaceSafeDeleteCheck) Err() error {
+	if c.Safe() {
+		return nil
+	}
+	switch c.Reasons[0] {
+	case SafeDeleteLocked:
+		return ErrWorkspaceLockedCannotDelete
+	case SafeDeleteStillProcessing:
+		return ErrWorkspaceStillProcessing
+	case SafeDeleteManagingResources:
+		return ErrWorkspaceNotSafeToDelete
+	default:
+		return ErrUnauthorized
+	}
+}
+
 // workspaceRemoveVCSConnectionOptions
 type workspaceRemoveVCSConnectionOptions struct {
 	ID      string          `jsonapi:"primary,workspaces"`
@@ -715,6 +1251,21 @@ type WorkspaceUpdateRemoteStateConsumersOptions struct {
 	Workspaces []*Workspace
 }
 
+// WorkspaceReplaceRemoteStateConsumersOptions represents the options for
+// replacing the remote state consumers of a workspace.
+type WorkspaceReplaceRemoteStateConsumersOptions struct {
+	// The workspaces to be the remote state consumers of the workspace. An
+	// empty list removes all the remote state consumers.
+	Workspaces []*Workspace
+}
+
+// RemoteStateConsumersChanges represents the remote state consumers added to
+// and removed from a workspace when replacing them.
+type RemoteStateConsumersChanges struct {
+	Added   []*Workspace
+	Removed []*Workspace
+}
+
 type WorkspaceTagListOptions struct {
 	ListOptions
 
@@ -761,6 +1312,201 @@ func (s *workspaces) List(ctx context.Context, organization string, options *Wor
 	return wl, nil
 }
 
+// ListAll streams all the workspaces of an organization matching the given
+// options, starting at options.PageNumber. Pages are requested one at a time
+// as the workspaces are received, so only a single page is held in memory,
+// and a page failing with a transient error, such as a server error, is
+// requested again up to listAllPageAttempts times.
+//
+// The workspaces channel is closed once all the workspaces have been sent or
+// listing failed. The error channel then receives the error, if any, and is
+// closed. Cancel ctx to stop listing early:
+//
+//	workspaces, errs := client.Workspaces.ListAll(ctx, "acme", nil)
+//	for w := range workspaces {
+//		fmt.Println(w.Name)
+//	}
+//	if err := <-errs; err != nil {
+//		log.Fatal(err)
+//	}
+func (s *workspaces) ListAll(ctx context.Context, organization string, options *WorkspaceListOptions) (<-chan *Workspace, <-chan error) {
+	workspaces := make(chan *Workspace)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(errs)
+		defer close(workspaces)
+
+		if err := s.listAll(ctx, organization, options, workspaces); err != nil {
+			errs <- err
+		}
+	}()
+
+	return workspaces, errs
+}
+
+// listAll sends the workspaces of an organization to workspaces, page by
+// page.
+func (s *workspaces) listAll(ctx context.Context, organization string, options *WorkspaceListOptions, workspaces chan<- *Workspace) error {
+	opts := WorkspaceListOptions{}
+	if options != nil {
+		opts = *options
+	}
+	if opts.PageSize == 0 {
+		opts.PageSize = 100
+	}
+
+	return forEachPage(&opts.ListOptions, func() (*Pagination, error) {
+		wl, err := s.listPage(ctx, organization, &opts)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range wl.Items {
+			select {
+			case workspaces <- w:
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+		return wl.Pagination, nil
+	})
+}
+
+// listPage lists a page of the workspaces of an organization, requesting it
+// again when it fails with a transient error.
+func (s *workspaces) listPage(ctx context.Context, organization string, options *WorkspaceListOptions) (*WorkspaceList, error) {
+	for attempt := 1; ; attempt++ {
+		wl, err := s.List(ctx, organization, options)
+		if err == nil || attempt == listAllPageAttempts || !isTransientError(err) {
+			return wl, err
+		}
+
+		if err := retryBackoff.Wait(ctx, attempt, err); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// Snapshot reads a workspace by its ID and returns its normalized settings.
+func (s *workspaces) Snapshot(ctx context.Context, workspaceID string) (*WorkspaceSettings, error) {
+	w, err := s.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newWorkspaceSettings(w), nil
+}
+
+// Can reads a workspace by its ID and reports whether the current user is
+// allowed to perform the operation on it.
+func (s *workspaces) Can(ctx context.Context, workspaceID string, operation WorkspaceOperation) (bool, string, error) {
+	// Reject unknown operations before making any request.
+	if _, _, err := (&WorkspacePermissions{}).Can(operation); err != nil {
+		return false, "", err
+	}
+
+	w, err := s.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return false, "", err
+	}
+
+	permissions := w.Permissions
+	if permissions == nil {
+		permissions = &WorkspacePermissions{}
+	}
+
+	return permissions.Can(operation)
+}
+
+// newWorkspaceSettings returns the normalized settings of a workspace.
+func newWorkspaceSettings(w *Workspace) *WorkspaceSettings {
+	settings := &WorkspaceSettings{
+		Name:                       w.Name,
+		Description:                w.Description,
+		ExecutionMode:              w.ExecutionMode,
+		AllowDestroyPlan:           w.AllowDestroyPlan,
+		AssessmentsEnabled:         w.AssessmentsEnabled,
+		AutoApply:                  w.AutoApply,
+		AutoApplyRunTrigger:        w.AutoApplyRunTrigger,
+		FileTriggersEnabled:        w.FileTriggersEnabled,
+		GlobalRemoteState:          w.GlobalRemoteState,
+		QueueAllRuns:               w.QueueAllRuns,
+		SpeculativeEnabled:         w.SpeculativeEnabled,
+		StructuredRunOutputEnabled: w.StructuredRunOutputEnabled,
+		TerraformVersion:           w.TerraformVersion,
+		TriggerPrefixes:            sortedStrings(w.TriggerPrefixes),
+		TriggerPatterns:            sortedStrings(w.TriggerPatterns),
+		WorkingDirectory:           w.WorkingDirectory,
+		TagNames:                   sortedStrings(w.TagNames),
+	}
+
+	if w.AgentPool != nil {
+		settings.AgentPoolID = w.AgentPool.ID
+	}
+	if w.Project != nil {
+		settings.ProjectID = w.Project.ID
+	}
+	if w.SSHKey != nil {
+		settings.SSHKeyID = w.SSHKey.ID
+	}
+	if w.VCSRepo != nil {
+		settings.VCSRepoIdentifier = w.VCSRepo.Identifier
+		settings.VCSRepoBranch = w.VCSRepo.Branch
+		settings.VCSRepoIngressSubmodules = w.VCSRepo.IngressSubmodules
+		settings.VCSRepoTagsRegex = w.VCSRepo.TagsRegex
+	}
+
+	return settings
+}
+
+// DiffSettings compares two workspace settings snapshots and returns the
+// settings that differ, in the order the fields of WorkspaceSettings are
+// declared. Lists are compared regardless of order, and a nil list is equal
+// to an empty one.
+func DiffSettings(a, b *WorkspaceSettings) []*WorkspaceSettingsChange {
+	if a == nil {
+		a = &WorkspaceSettings{}
+	}
+	if b == nil {
+		b = &WorkspaceSettings{}
+	}
+
+	var changes []*WorkspaceSettingsChange
+
+	av := reflect.ValueOf(a).Elem()
+	bv := reflect.ValueOf(b).Elem()
+	for i := 0; i < av.NumField(); i++ {
+		field := av.Type().Field(i)
+		oldValue := av.Field(i).Interface()
+		newValue := bv.Field(i).Interface()
+
+		if list, ok := oldValue.([]string); ok {
+			oldValue = sortedStrings(list)
+			newValue = sortedStrings(newValue.([]string))
+		}
+
+		if !reflect.DeepEqual(oldValue, newValue) {
+			changes = append(changes, &WorkspaceSettingsChange{
+				Attribute: field.Tag.Get("setting"),
+				Old:       oldValue,
+				New:       newValue,
+			})
+		}
+	}
+
+	return changes
+}
+
+// sortedStrings returns a sorted copy of a list of strings. A nil list is
+// returned as an empty list.
+func sortedStrings(list []string) []string {
+	sorted := make([]string, len(list))
+	copy(sorted, list)
+	sort.Strings(sorted)
+	return sorted
+}
+
 func (s *workspaces) ListTagBindings(ctx context.Context, workspaceID string) ([]*TagBinding, error) {
 	if !validStringID(&workspaceID) {
 		return nil, ErrInvalidWorkspaceID
@@ -786,6 +1532,12 @@ func (s *workspaces) ListTagBindings(ctx context.Context, workspaceID string) ([
 }
 
 func (s *workspaces) ListEffectiveTagBindings(ctx context.Context, workspaceID string) ([]*EffectiveTagBinding, error) {
+	return s.ListEffectiveTagBindingsWithOptions(ctx, workspaceID, nil)
+}
+
+// ListEffectiveTagBindingsWithOptions lists the effective tag bindings of a
+// workspace using the options supplied.
+func (s *workspaces) ListEffectiveTagBindingsWithOptions(ctx context.Context, workspaceID string, options *WorkspaceEffectiveTagBindingsListOptions) ([]*EffectiveTagBinding, error) {
 	if !validStringID(&workspaceID) {
 		return nil, ErrInvalidWorkspaceID
 	}
@@ -806,7 +1558,18 @@ func (s *workspaces) ListEffectiveTagBindings(ctx context.Context, workspaceID s
 		return nil, err
 	}
 
-	return list.Items, nil
+	if options == nil || !options.ExcludeInherited {
+		return list.Items, nil
+	}
+
+	bindings := make([]*EffectiveTagBinding, 0, len(list.Items))
+	for _, tb := range list.Items {
+		if !tb.Inherited() {
+			bindings = append(bindings, tb)
+		}
+	}
+
+	return bindings, nil
 }
 
 // AddTagBindings adds or modifies the value of existing tag binding keys for a workspace.
@@ -834,6 +1597,51 @@ func (s *workspaces) AddTagBindings(ctx context.Context, workspaceID string, opt
 	return response.Items, err
 }
 
+// RemoveTagBindings removes the tag bindings with the given keys from a
+// workspace. The API only supports replacing the full set of tag bindings, so
+// the current bindings are read first and the remaining ones are written back.
+// Like DeleteAllTagBindings, this method will not remove inherited tag bindings.
+func (s *workspaces) RemoveTagBindings(ctx context.Context, workspaceID string, keys []string) error {
+	if !validStringID(&workspaceID) {
+		return ErrInvalidWorkspaceID
+	}
+	if len(keys) == 0 {
+		return ErrRequiredTagBindingKeys
+	}
+
+	current, err := s.ListTagBindings(ctx, workspaceID)
+	if err != nil {
+		return err
+	}
+
+	remove := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		remove[k] = true
+	}
+
+	remaining := make([]*TagBinding, 0, len(current))
+	for _, tb := range current {
+		if !remove[tb.Key] {
+			remaining = append(remaining, &TagBinding{Key: tb.Key, Value: tb.Value})
+		}
+	}
+
+	// Nothing to do when none of the keys are bound to the workspace.
+	if len(remaining) == len(current) {
+		return nil
+	}
+
+	return s.replaceTagBindings(ctx, workspaceID, remaining)
+}
+
+// DeleteTagBindings removes the tag bindings with the given keys from a
+// workspace.
+//
+// Deprecated: Use RemoveTagBindings instead.
+func (s *workspaces) DeleteTagBindings(ctx context.Context, workspaceID string, keys []string) error {
+	return s.RemoveTagBindings(ctx, workspaceID, keys)
+}
+
 // DeleteAllTagBindings removes all tag bindings associated with a workspace.
 // This method will not remove any inherited tag bindings, which must be
 // explicitly removed from the parent project.
@@ -842,13 +1650,18 @@ func (s *workspaces) DeleteAllTagBindings(ctx context.Context, workspaceID strin
 		return ErrInvalidWorkspaceID
 	}
 
+	return s.replaceTagBindings(ctx, workspaceID, []*TagBinding{})
+}
+
+// replaceTagBindings replaces the full set of tag bindings of a workspace.
+func (s *workspaces) replaceTagBindings(ctx context.Context, workspaceID string, bindings []*TagBinding) error {
 	type aliasOpts struct {
 		Type        string        `jsonapi:"primary,workspaces"`
 		TagBindings []*TagBinding `jsonapi:"relation,tag-bindings"`
 	}
 
 	opts := &aliasOpts{
-		TagBindings: []*TagBinding{},
+		TagBindings: bindings,
 	}
 
 	u := fmt.Sprintf("workspaces/%s", url.PathEscape(workspaceID))
@@ -868,6 +1681,16 @@ func (s *workspaces) Create(ctx context.Context, organization string, options Wo
 	if err := options.valid(); err != nil {
 		return nil, err
 	}
+	if err := options.applyTerraformVersionConstraint(); err != nil {
+		return nil, err
+	}
+	if options.ProjectName != nil {
+		p, err := s.client.Projects.ReadByName(ctx, organization, *options.ProjectName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read project %q: %w", *options.ProjectName, err)
+		}
+		options.Project = p
+	}
 
 	u := fmt.Sprintf("organizations/%s/workspaces", url.PathEscape(organization))
 	req, err := s.client.NewRequest("POST", u, &options)
@@ -924,6 +1747,8 @@ func (s *workspaces) ReadWithOptions(ctx context.Context, organization, workspac
 	w.ApplyDurationAverage *= time.Millisecond
 	w.PlanDurationAverage *= time.Millisecond
 
+	s.rememberID(organization, w)
+
 	return w, nil
 }
 
@@ -959,33 +1784,298 @@ func (s *workspaces) ReadByIDWithOptions(ctx context.Context, workspaceID string
 	w.ApplyDurationAverage *= time.Millisecond
 	w.PlanDurationAverage *= time.Millisecond
 
+	if w.Organization != nil {
+		s.rememberID(w.Organization.Name, w)
+	}
+
 	return w, nil
 }
 
-// Readme gets the readme of a workspace by its ID.
-func (s *workspaces) Readme(ctx context.Context, workspaceID string) (io.Reader, error) {
-	if !validStringID(&workspaceID) {
-		return nil, ErrInvalidWorkspaceID
-	}
-
-	u := fmt.Sprintf("workspaces/%s?include=readme", url.PathEscape(workspaceID))
-	req, err := s.client.NewRequest("GET", u, nil)
+// ReadAutoDestroyStatus reads when a workspace is next scheduled to be
+// destroyed automatically, reading its project along with it to report the
+// activity duration it inherits.
+func (s *workspaces) ReadAutoDestroyStatus(ctx context.Context, workspaceID string) (*WorkspaceAutoDestroyStatus, error) {
+	w, err := s.ReadByIDWithOptions(ctx, workspaceID, &WorkspaceReadOptions{
+		Include: []WSIncludeOpt{WSProject},
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	r := &workspaceWithReadme{}
-	err = req.Do(ctx, r)
-	if err != nil {
-		return nil, err
-	}
-	if r.Readme == nil {
-		return nil, nil
+	return workspaceAutoDestroyStatus(w), nil
+}
+
+// PreviewAutoDestroy lists the workspaces of an organization whose next
+// scheduled destroy run is within the given duration from now, soonest
+// first. Workspaces whose destroy run is overdue are included. Nothing is
+// destroyed.
+func (s *workspaces) PreviewAutoDestroy(ctx context.Context, organization string, within time.Duration) ([]*WorkspaceAutoDestroyStatus, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	deadline := time.Now().Add(within)
+	var statuses []*WorkspaceAutoDestroyStatus
+
+	workspaces, errs := s.ListAll(ctx, organization, &WorkspaceListOptions{
+		Include: []WSIncludeOpt{WSProject},
+	})
+	for w := range workspaces {
+		status := workspaceAutoDestroyStatus(w)
+		if status.Scheduled() && !status.ScheduledAt.After(deadline) {
+			statuses = append(statuses, status)
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	sort.SliceStable(statuses, func(i, j int) bool {
+		return statuses[i].ScheduledAt.Before(statuses[j].ScheduledAt)
+	})
+
+	return statuses, nil
+}
+
+// workspaceAutoDestroyStatus returns the auto-destroy status of a workspace
+// read with its project included. A workspace inheriting the auto-destroy
+// settings of its project uses the activity duration of the project.
+func workspaceAutoDestroyStatus(w *Workspace) *WorkspaceAutoDestroyStatus {
+	status := &WorkspaceAutoDestroyStatus{
+		Workspace: w,
+		Origin:    AutoDestroyOriginNone,
+	}
+
+	if at, err := w.AutoDestroyAt.Get(); err == nil {
+		status.ScheduledAt = at
+		status.Origin = AutoDestroyOriginWorkspace
+	}
+
+	if w.InheritsProjectAutoDestroy && w.Project != nil {
+		if d, err := w.Project.AutoDestroyActivityDuration.Get(); err == nil && d != "" {
+			status.ActivityDuration = d
+			status.Origin = AutoDestroyOriginProject
+			return status
+		}
+	}
+
+	if d, err := w.AutoDestroyActivityDuration.Get(); err == nil && d != "" {
+		status.ActivityDuration = d
+		status.Origin = AutoDestroyOriginWorkspace
+	}
+
+	return status
+}
+
+// ReadCurrentConfigurationVersion reads the current configuration version of
+// a workspace, including its ingress attributes when it was sourced from VCS,
+// in a single request. It returns ErrWorkspaceNoConfigurationVersion if the
+// workspace has no configuration version yet.
+func (s *workspaces) ReadCurrentConfigurationVersion(ctx context.Context, workspaceID string) (*ConfigurationVersion, error) {
+	w, err := s.ReadByIDWithOptions(ctx, workspaceID, &WorkspaceReadOptions{
+		Include: []WSIncludeOpt{WSCurrentConfigVer, WSCurrentConfigVerIngress},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if w.CurrentConfigurationVersion == nil {
+		return nil, ErrWorkspaceNoConfigurationVersion
+	}
+
+	return w.CurrentConfigurationVersion, nil
+}
+
+// ReadFollowRename reads a workspace by name and organization name. When no
+// workspace has that name, but a workspace read by this client under that
+// name still exists, that workspace is returned along with a
+// *WorkspaceRenamedError holding its new name. Callers that only want to
+// follow the rename can ignore the error using errors.Is with
+// ErrWorkspaceRenamed.
+//
+// The API does not redirect reads of renamed workspaces, so only renames of
+// workspaces previously read by this client, by name or by ID, can be
+// followed. The client remembers the names of the 10000 workspaces read most
+// recently, each for 24 hours after it was last read. Other workspaces that
+// are not found return ErrResourceNotFound.
+func (s *workspaces) ReadFollowRename(ctx context.Context, organization, workspace string) (*Workspace, error) {
+	w, err := s.Read(ctx, organization, workspace)
+	if err == nil || !errors.Is(err, ErrResourceNotFound) {
+		return w, err
+	}
+
+	id, ok := s.ids.Load(workspaceKey(organization, workspace))
+	if !ok {
+		return nil, err
+	}
+
+	w, err = s.ReadByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if w.Name == workspace {
+		return w, nil
+	}
+
+	return w, &WorkspaceRenamedError{
+		WorkspaceID: w.ID,
+		OldName:     workspace,
+		NewName:     w.Name,
+	}
+}
+
+// ReadMany resolves the given workspace names of an organization to their
+// workspaces. It lists the first page of the workspaces of the organization,
+// then either lists the remaining pages or reads the remaining names one by
+// one, whichever takes the fewer requests. Requests are made concurrently,
+// at most readManyConcurrency at a time.
+//
+// Duplicate names are resolved once. Names without a matching workspace are
+// returned in result.Missing rather than as an error.
+func (s *workspaces) ReadMany(ctx context.Context, organization string, names []string) (*WorkspaceReadManyResult, error) {
+	if !validStringID(&organization) {
+		return nil, ErrInvalidOrg
+	}
+
+	wanted := make(map[string]bool, len(names))
+	var unique []string
+	for _, name := range names {
+		name := name
+		if !validStringID(&name) {
+			return nil, ErrInvalidWorkspaceValue
+		}
+		if !wanted[name] {
+			wanted[name] = true
+			unique = append(unique, name)
+		}
+	}
+
+	result := &WorkspaceReadManyResult{Workspaces: make(map[string]*Workspace, len(unique))}
+	if len(unique) == 0 {
+		return result, nil
+	}
+
+	var mu sync.Mutex
+	found := func(ws ...*Workspace) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, w := range ws {
+			if wanted[w.Name] {
+				result.Workspaces[w.Name] = w
+				s.rememberID(organization, w)
+			}
+		}
+	}
+
+	listOptions := func(page int) *WorkspaceListOptions {
+		return &WorkspaceListOptions{ListOptions: ListOptions{PageNumber: page, PageSize: 100}}
+	}
+
+	wl, err := s.List(ctx, organization, listOptions(1))
+	if err != nil {
+		return nil, err
+	}
+	found(wl.Items...)
+
+	var remaining []string
+	for _, name := range unique {
+		if result.Workspaces[name] == nil {
+			remaining = append(remaining, name)
+		}
+	}
+
+	g, gctx := errgroup.WithContext(ctx)
+	g.SetLimit(readManyConcurrency)
+
+	switch {
+	case len(remaining) == 0 || wl.Pagination == nil || wl.NextPage == 0:
+		// Every workspace of the organization has been listed.
+	case wl.TotalPages-1 <= len(remaining):
+		for page := wl.NextPage; page <= wl.TotalPages; page++ {
+			page := page
+			g.Go(func() error {
+				wl, err := s.List(gctx, organization, listOptions(page))
+				if err != nil {
+					return err
+				}
+				found(wl.Items...)
+				return nil
+			})
+		}
+	default:
+		for _, name := range remaining {
+			name := name
+			g.Go(func() error {
+				w, err := s.Read(gctx, organization, name)
+				if errors.Is(err, ErrResourceNotFound) {
+					return nil
+				}
+				if err != nil {
+					return err
+				}
+				found(w)
+				return nil
+			})
+		}
+	}
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	for _, name := range unique {
+		if result.Workspaces[name] == nil {
+			result.Missing = append(result.Missing, name)
+		}
+	}
+
+	return result, nil
+}
+
+// Readme gets the readme of a workspace by its ID.
+func (s *workspaces) Readme(ctx context.Context, workspaceID string) (io.Reader, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	u := fmt.Sprintf("workspaces/%s?include=readme", url.PathEscape(workspaceID))
+	req, err := s.client.NewRequest("GET", u, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &workspaceWithReadme{}
+	err = req.Do(ctx, r)
+	if err != nil {
+		return nil, err
+	}
+	if r.Readme == nil {
+		return nil, nil
 	}
 
 	return strings.NewReader(r.Readme.RawMarkdown), nil
 }
 
+// ReadReadme reads the README of a workspace from the archive of its current
+// configuration version, as returned by ConfigurationVersions.ReadReadme.
+// Unlike Readme, it downloads the archive, to report the path, format and
+// modification time of the README along with its content. It returns
+// ErrWorkspaceNoConfigurationVersion for a workspace without a
+// configuration version and ErrResourceNotFound for a configuration without
+// a README.
+func (s *workspaces) ReadReadme(ctx context.Context, workspaceID string) (*ConfigurationReadme, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	cv, err := s.ReadCurrentConfigurationVersion(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.client.ConfigurationVersions.ReadReadme(ctx, cv.ID)
+}
+
 // Update settings of an existing workspace.
 func (s *workspaces) Update(ctx context.Context, organization, workspace string, options WorkspaceUpdateOptions) (*Workspace, error) {
 	if !validStringID(&organization) {
@@ -997,6 +2087,9 @@ func (s *workspaces) Update(ctx context.Context, organization, workspace string,
 	if err := options.valid(); err != nil {
 		return nil, err
 	}
+	if err := options.applyTerraformVersionConstraint(); err != nil {
+		return nil, err
+	}
 
 	u := fmt.Sprintf(
 		"organizations/%s/workspaces/%s",
@@ -1022,6 +2115,12 @@ func (s *workspaces) UpdateByID(ctx context.Context, workspaceID string, options
 	if !validStringID(&workspaceID) {
 		return nil, ErrInvalidWorkspaceID
 	}
+	if err := validTagBindings(options.TagBindings); err != nil {
+		return nil, err
+	}
+	if err := options.applyTerraformVersionConstraint(); err != nil {
+		return nil, err
+	}
 
 	u := fmt.Sprintf("workspaces/%s", url.PathEscape(workspaceID))
 	req, err := s.client.NewRequest("PATCH", u, &options)
@@ -1038,6 +2137,55 @@ func (s *workspaces) UpdateByID(ctx context.Context, workspaceID string, options
 	return w, nil
 }
 
+// UpdateAttributes partially updates an existing workspace, sending only the
+// given attributes.
+func (s *workspaces) UpdateAttributes(ctx context.Context, workspaceID string, attributes map[string]interface{}) (*Workspace, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	body, err := newAttributesPatch("workspaces", workspaceID, attributes)
+	if err != nil {
+		return nil, err
+	}
+
+	u := fmt.Sprintf("workspaces/%s", url.PathEscape(workspaceID))
+	req, err := s.client.NewRequest("PATCH", u, body)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Workspace{}
+	err = req.Do(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
+// UpdateRelationship partially updates an existing workspace, replacing only
+// the given relationship.
+func (s *workspaces) UpdateRelationship(ctx context.Context, workspaceID string, options RelationshipUpdateOptions) (*Workspace, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	u := fmt.Sprintf("workspaces/%s", url.PathEscape(workspaceID))
+	req, err := s.client.newRelationshipRequest(u, "workspaces", workspaceID, options)
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Workspace{}
+	err = req.Do(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
 // Delete a workspace by its name.
 func (s *workspaces) Delete(ctx context.Context, organization, workspace string) error {
 	if !validStringID(&organization) {
@@ -1112,6 +2260,41 @@ func (s *workspaces) SafeDeleteByID(ctx context.Context, workspaceID string) err
 	return req.Do(ctx, nil)
 }
 
+// SafeDeleteDryRun reads a workspace, including its current state version,
+// and reports whether SafeDeleteByID would delete it. The checks mirror the
+// ones of the API, but the workspace could change before it is deleted, so
+// SafeDeleteByID may still fail.
+func (s *workspaces) SafeDeleteDryRun(ctx context.Context, workspaceID string) (*WorkspaceSafeDeleteCheck, error) {
+	w, err := s.ReadByIDWithOptions(ctx, workspaceID, &WorkspaceReadOptions{
+		Include: []WSIncludeOpt{WSCurrentStateVer},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	check := &WorkspaceSafeDeleteCheck{Workspace: w}
+	if w.Locked {
+		check.Reasons = append(check.Reasons, SafeDeleteLocked)
+	}
+	if sv := w.CurrentStateVersion; sv != nil && !sv.ResourcesProcessed {
+		check.Reasons = append(check.Reasons, SafeDeleteStillProcessing)
+	} else if w.ResourceCount > 0 {
+		check.Reasons = append(check.Reasons, SafeDeleteManagingResources)
+	}
+
+	// Deleting a workspace requires the destroy permission. Versions of
+	// Terraform Enterprise that do not report the force delete permission
+	// allow whoever may delete a workspace to force delete it.
+	if p := w.Permissions; p != nil {
+		if !p.CanDestroy {
+			check.Reasons = append(check.Reasons, SafeDeleteNotPermitted)
+		}
+		check.CanForceDelete = p.CanDestroy && (p.CanForceDelete == nil || *p.CanForceDelete)
+	}
+
+	return check, nil
+}
+
 // RemoveVCSConnection from a workspace.
 func (s *workspaces) RemoveVCSConnection(ctx context.Context, organization, workspace string) (*Workspace, error) {
 	if !validStringID(&organization) {
@@ -1163,6 +2346,157 @@ func (s *workspaces) RemoveVCSConnectionByID(ctx context.Context, workspaceID st
 	return w, nil
 }
 
+// ResolveEffectiveTerraformVersion reports the Terraform version that the
+// Terraform version of a workspace resolves to. An exact version resolves to
+// itself. A version constraint, or "latest", resolves to the highest enabled,
+// non-beta Terraform version of the instance matching it. Listing the
+// Terraform versions of the instance requires a Terraform Enterprise admin
+// token; when they cannot be listed, the version used by the latest run of
+// the workspace is reported instead, as indicated by the returned source.
+func (s *workspaces) ResolveEffectiveTerraformVersion(ctx context.Context, workspaceID string) (*EffectiveTerraformVersion, error) {
+	ws, err := s.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	effective := &EffectiveTerraformVersion{Constraint: ws.TerraformVersion}
+	if validVersion(ws.TerraformVersion) {
+		effective.Version = ws.TerraformVersion
+		effective.Source = EffectiveTerraformVersionExact
+		return effective, nil
+	}
+
+	constraint := ws.TerraformVersion
+	if constraint == "latest" || constraint == "" {
+		constraint = ">= 0.0.0"
+	}
+	constraints, err := version.NewConstraint(constraint)
+	if err != nil {
+		return nil, ErrInvalidTerraformVersionConstraint
+	}
+
+	var highest *version.Version
+	var adminUnavailable bool
+	options := &AdminTerraformVersionsListOptions{ListOptions: ListOptions{PageSize: 100}}
+	err = forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		tvl, err := s.client.Admin.TerraformVersions.List(ctx, options)
+		if errors.Is(err, ErrResourceNotFound) || errors.Is(err, ErrUnauthorized) {
+			adminUnavailable = true
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, tv := range tvl.Items {
+			if !tv.Enabled || tv.Beta {
+				continue
+			}
+			v, err := version.NewVersion(tv.Version)
+			if err != nil || !constraints.Check(v) {
+				continue
+			}
+			if highest == nil || v.GreaterThan(highest) {
+				highest = v
+			}
+		}
+		return tvl.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if adminUnavailable {
+		return s.latestRunTerraformVersion(ctx, effective, workspaceID)
+	}
+
+	if highest == nil {
+		return nil, ErrUnresolvedTerraformVersion
+	}
+
+	effective.Version = highest.Original()
+	effective.Source = EffectiveTerraformVersionAvailable
+	return effective, nil
+}
+
+// latestRunTerraformVersion resolves the effective Terraform version of a
+// workspace to the Terraform version of its latest run.
+func (s *workspaces) latestRunTerraformVersion(ctx context.Context, effective *EffectiveTerraformVersion, workspaceID string) (*EffectiveTerraformVersion, error) {
+	rl, err := s.client.Runs.List(ctx, workspaceID, &RunListOptions{ListOptions: ListOptions{PageSize: 1}})
+	if err != nil {
+		return nil, err
+	}
+	if len(rl.Items) == 0 || rl.Items[0].TerraformVersion == "" {
+		return nil, ErrUnresolvedTerraformVersion
+	}
+
+	effective.Version = rl.Items[0].TerraformVersion
+	effective.Source = EffectiveTerraformVersionLatestRun
+	return effective, nil
+}
+
+// UpdateVCSConnection connects a workspace to a VCS repository through the
+// OAuth token or the GitHub App installation of the options, and clears the
+// other one, so a workspace can be migrated from one kind of connection to the
+// other. The settings not given in the options are kept from the current VCS
+// repository of the workspace.
+func (s *workspaces) UpdateVCSConnection(ctx context.Context, workspaceID string, options WorkspaceVCSConnectionOptions) (*Workspace, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	ws, err := s.ReadByID(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	conn := &workspaceVCSConnection{
+		Identifier:        options.Identifier,
+		Branch:            options.Branch,
+		IngressSubmodules: options.IngressSubmodules,
+		TagsRegex:         options.TagsRegex,
+	}
+	if validString(options.OAuthTokenID) {
+		conn.OAuthTokenID = options.OAuthTokenID
+	} else {
+		conn.GHAInstallationID = options.GHAInstallationID
+	}
+
+	if current := ws.VCSRepo; current != nil {
+		if conn.Identifier == nil {
+			conn.Identifier = String(current.Identifier)
+		}
+		if conn.Branch == nil {
+			conn.Branch = String(current.Branch)
+		}
+		if conn.IngressSubmodules == nil {
+			conn.IngressSubmodules = Bool(current.IngressSubmodules)
+		}
+		if conn.TagsRegex == nil && current.TagsRegex != "" {
+			conn.TagsRegex = String(current.TagsRegex)
+		}
+	}
+	if !validString(conn.Identifier) {
+		return nil, ErrRequiredIdentifier
+	}
+
+	u := fmt.Sprintf("workspaces/%s", url.PathEscape(workspaceID))
+	req, err := s.client.NewRequest("PATCH", u, &workspaceUpdateVCSConnectionOptions{VCSRepo: conn})
+	if err != nil {
+		return nil, err
+	}
+
+	w := &Workspace{}
+	err = req.Do(ctx, w)
+	if err != nil {
+		return nil, err
+	}
+
+	return w, nil
+}
+
 // Lock a workspace by its ID.
 func (s *workspaces) Lock(ctx context.Context, workspaceID string, options WorkspaceLockOptions) (*Workspace, error) {
 	if !validStringID(&workspaceID) {
@@ -1229,6 +2563,80 @@ func (s *workspaces) ForceUnlock(ctx context.Context, workspaceID string) (*Work
 	return w, nil
 }
 
+// ReadLockInfo reads a workspace, including the holder of its lock, and
+// returns the lock details.
+func (s *workspaces) ReadLockInfo(ctx context.Context, workspaceID string) (*WorkspaceLockInfo, error) {
+	w, err := s.ReadByIDWithOptions(ctx, workspaceID, &WorkspaceReadOptions{
+		Include: []WSIncludeOpt{WSLockedBy},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	info := &WorkspaceLockInfo{Locked: w.Locked}
+	if !w.Locked {
+		return info, nil
+	}
+
+	info.Reason = w.LockedReason
+	if w.LockedBy != nil {
+		info.Run = w.LockedBy.Run
+		info.User = w.LockedBy.User
+		info.Team = w.LockedBy.Team
+	}
+	if info.Run != nil && info.Run.StatusTimestamps != nil {
+		info.AcquiredAt = info.Run.StatusTimestamps.PlanQueuedAt
+	}
+
+	return info, nil
+}
+
+// ReadOutputs reads the outputs of the current state version of a workspace,
+// keyed by name. The values of sensitive outputs are omitted when listing
+// outputs, so each sensitive output is read individually to include its
+// value, which requires permission to read the state version outputs of the
+// workspace.
+func (s *workspaces) ReadOutputs(ctx context.Context, workspaceID string) (map[string]StateVersionOutput, error) {
+	if !validStringID(&workspaceID) {
+		return nil, ErrInvalidWorkspaceID
+	}
+
+	u := fmt.Sprintf("workspaces/%s/current-state-version-outputs", url.PathEscape(workspaceID))
+	options := &StateVersionOutputsListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+	}
+
+	outputs := make(map[string]StateVersionOutput)
+	err := forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		req, err := s.client.NewRequest("GET", u, options)
+		if err != nil {
+			return nil, err
+		}
+
+		ol := &StateVersionOutputsList{}
+		err = req.Do(ctx, ol)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, o := range ol.Items {
+			if o.Sensitive {
+				o, err = s.client.StateVersionOutputs.Read(ctx, o.ID)
+				if err != nil {
+					return nil, err
+				}
+			}
+			outputs[o.Name] = *o
+		}
+		return ol.Pagination, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return outputs, nil
+}
+
 // AssignSSHKey to a workspace.
 func (s *workspaces) AssignSSHKey(ctx context.Context, workspaceID string, options WorkspaceAssignSSHKeyOptions) (*Workspace, error) {
 	if !validStringID(&workspaceID) {
@@ -1350,6 +2758,120 @@ func (s *workspaces) UpdateRemoteStateConsumers(ctx context.Context, workspaceID
 	return req.Do(ctx, nil)
 }
 
+// ListAllRemoteStateConsumers lists all the remote state consumers of a
+// workspace, requesting their pages one at a time.
+func (s *workspaces) ListAllRemoteStateConsumers(ctx context.Context, workspaceID string) ([]*Workspace, error) {
+	var consumers []*Workspace
+	err := s.eachRemoteStateConsumer(ctx, workspaceID, func(w *Workspace) bool {
+		consumers = append(consumers, w)
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return consumers, nil
+}
+
+// IsRemoteStateConsumer reports whether a workspace is one of the remote
+// state consumers of another workspace, listing the consumers until it is
+// found. Only the consumers listed on the producer are considered: any
+// workspace of the organization can read the state of a producer with
+// GlobalRemoteState set.
+func (s *workspaces) IsRemoteStateConsumer(ctx context.Context, producerID, consumerID string) (bool, error) {
+	if !validStringID(&consumerID) {
+		return false, ErrInvalidWorkspaceID
+	}
+
+	var found bool
+	err := s.eachRemoteStateConsumer(ctx, producerID, func(w *Workspace) bool {
+		found = w.ID == consumerID
+		return !found
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return found, nil
+}
+
+// ReplaceRemoteStateConsumers lists the remote state consumers of a workspace
+// and adds the missing workspaces and removes the extra ones, so the
+// consumers match the workspaces in the options. Unlike
+// UpdateRemoteStateConsumers, the consumers can be replaced by an empty list,
+// and no request is made when they already match.
+func (s *workspaces) ReplaceRemoteStateConsumers(ctx context.Context, workspaceID string, options WorkspaceReplaceRemoteStateConsumersOptions) (*RemoteStateConsumersChanges, error) {
+	if err := options.valid(); err != nil {
+		return nil, err
+	}
+
+	current, err := s.ListAllRemoteStateConsumers(ctx, workspaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := make(map[string]bool, len(options.Workspaces))
+	for _, w := range options.Workspaces {
+		desired[w.ID] = true
+	}
+
+	changes := &RemoteStateConsumersChanges{}
+	existing := make(map[string]bool, len(current))
+	for _, w := range current {
+		existing[w.ID] = true
+		if !desired[w.ID] {
+			changes.Removed = append(changes.Removed, w)
+		}
+	}
+	for _, w := range options.Workspaces {
+		if !existing[w.ID] {
+			existing[w.ID] = true
+			changes.Added = append(changes.Added, w)
+		}
+	}
+
+	if len(changes.Added) > 0 {
+		err := s.AddRemoteStateConsumers(ctx, workspaceID, WorkspaceAddRemoteStateConsumersOptions{
+			Workspaces: changes.Added,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(changes.Removed) > 0 {
+		err := s.RemoveRemoteStateConsumers(ctx, workspaceID, WorkspaceRemoveRemoteStateConsumersOptions{
+			Workspaces: changes.Removed,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return changes, nil
+}
+
+// eachRemoteStateConsumer calls fn with each remote state consumer of a
+// workspace, page by page, until fn returns false.
+func (s *workspaces) eachRemoteStateConsumer(ctx context.Context, workspaceID string, fn func(*Workspace) bool) error {
+	options := &RemoteStateConsumersListOptions{
+		ListOptions: ListOptions{PageSize: 100},
+	}
+
+	return forEachPage(&options.ListOptions, func() (*Pagination, error) {
+		wl, err := s.ListRemoteStateConsumers(ctx, workspaceID, options)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, w := range wl.Items {
+			if !fn(w) {
+				return nil, nil
+			}
+		}
+		return wl.Pagination, nil
+	})
+}
+
 // ListTags returns the tags for a given workspace.
 func (s *workspaces) ListTags(ctx context.Context, workspaceID string, options *WorkspaceTagListOptions) (*TagList, error) {
 	if !validStringID(&workspaceID) {
@@ -1570,7 +3092,7 @@ func (o WorkspaceAddTagBindingsOptions) valid() error {
 		return ErrRequiredTagBindings
 	}
 
-	return nil
+	return validTagBindings(o.TagBindings)
 }
 
 func (o WorkspaceCreateOptions) valid() error {
@@ -1605,8 +3127,14 @@ func (o WorkspaceCreateOptions) valid() error {
 		o.FileTriggersEnabled != nil && *o.FileTriggersEnabled {
 		return ErrUnsupportedBothTagsRegexAndFileTriggersEnabled
 	}
+	if vcsConnectionsDefined(o.VCSRepo) {
+		return ErrUnsupportedBothOAuthTokenAndGHAInstallation
+	}
+	if o.Project != nil && o.ProjectName != nil {
+		return ErrUnsupportedBothProjectAndProjectName
+	}
 
-	return nil
+	return validTagBindings(o.TagBindings)
 }
 
 func (o WorkspaceUpdateOptions) valid() error {
@@ -1636,8 +3164,11 @@ func (o WorkspaceUpdateOptions) valid() error {
 		o.FileTriggersEnabled != nil && *o.FileTriggersEnabled {
 		return ErrUnsupportedBothTagsRegexAndFileTriggersEnabled
 	}
+	if vcsConnectionsDefined(o.VCSRepo) {
+		return ErrUnsupportedBothOAuthTokenAndGHAInstallation
+	}
 
-	return nil
+	return validTagBindings(o.TagBindings)
 }
 
 func (o WorkspaceAssignSSHKeyOptions) valid() error {
@@ -1680,6 +3211,18 @@ func (o WorkspaceUpdateRemoteStateConsumersOptions) valid() error {
 	return nil
 }
 
+func (o WorkspaceReplaceRemoteStateConsumersOptions) valid() error {
+	if o.Workspaces == nil {
+		return ErrWorkspacesRequired
+	}
+	for _, w := range o.Workspaces {
+		if w == nil || !validStringID(&w.ID) {
+			return ErrInvalidWorkspaceID
+		}
+	}
+	return nil
+}
+
 func (o WorkspaceAddTagsOptions) valid() error {
 	if len(o.Tags) == 0 {
 		return ErrMissingTagIdentifier
@@ -1710,10 +3253,92 @@ func (o *WorkspaceListOptions) valid() error {
 	return nil
 }
 
+// rememberID records the ID of a workspace read by the client. Previous names
+// of the workspace are kept, so they keep resolving to it once it is renamed.
+func (s *workspaces) rememberID(organization string, w *Workspace) {
+	if organization == "" || w.Name == "" || w.ID == "" {
+		return
+	}
+	s.ids.Store(workspaceKey(organization, w.Name), w.ID)
+}
+
+func workspaceKey(organization, workspace string) string {
+	return organization + "/" + workspace
+}
+
 func (o *WorkspaceReadOptions) valid() error {
 	return nil
 }
 
+func (o WorkspaceVCSConnectionOptions) valid() error {
+	oauth, gha := validString(o.OAuthTokenID), validString(o.GHAInstallationID)
+	if oauth && gha {
+		return ErrUnsupportedBothOAuthTokenAndGHAInstallation
+	}
+	if !oauth && !gha {
+		return ErrRequiredOauthTokenOrGithubAppInstallationID
+	}
+	if oauth && !validStringID(o.OAuthTokenID) {
+		return ErrInvalidOauthTokenID
+	}
+	return nil
+}
+
+// ValidateTerraformVersionConstraint checks that a Terraform version
+// constraint, such as "~> 1.7.0" or ">= 1.5, < 2.0", is well formed. An exact
+// version is a valid constraint.
+func ValidateTerraformVersionConstraint(constraint string) error {
+	if strings.TrimSpace(constraint) == "" {
+		return ErrInvalidTerraformVersionConstraint
+	}
+	if _, err := version.NewConstraint(constraint); err != nil {
+		return ErrInvalidTerraformVersionConstraint
+	}
+	return nil
+}
+
+// terraformVersionOption returns the Terraform version to send for the
+// Terraform version and version constraint options of a workspace.
+func terraformVersionOption(terraformVersion, constraint *string) (*string, error) {
+	if constraint == nil {
+		return terraformVersion, nil
+	}
+	if terraformVersion != nil {
+		return nil, ErrUnsupportedBothTerraformVersionAndConstraint
+	}
+	if err := ValidateTerraformVersionConstraint(*constraint); err != nil {
+		return nil, err
+	}
+	return constraint, nil
+}
+
+func (o *WorkspaceCreateOptions) applyTerraformVersionConstraint() error {
+	v, err := terraformVersionOption(o.TerraformVersion, o.TerraformVersionConstraint)
+	if err != nil {
+		return err
+	}
+	o.TerraformVersion = v
+	return nil
+}
+
+func (o *WorkspaceUpdateOptions) applyTerraformVersionConstraint() error {
+	v, err := terraformVersionOption(o.TerraformVersion, o.TerraformVersionConstraint)
+	if err != nil {
+		return err
+	}
+	o.TerraformVersion = v
+	return nil
+}
+
+// vcsConnectionsDefined returns true if both an OAuth token and a GitHub App
+// installation are set to connect a VCS repository.
+func vcsConnectionsDefined(options *VCSRepoOptions) bool {
+	if options == nil {
+		return false
+	}
+	return validString(options.OAuthTokenID) && validString(options.GHAInstallationID)
+}
+
 func tagRegexDefined(options *VCSRepoOptions) bool {
 	if options == nil {
 		return false
@@ -10,9 +10,12 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"sort"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -1291,6 +1294,114 @@ func TestWorkspaces_DeleteAllTagBindings(t *testing.T) {
 	require.Empty(t, bindings)
 }
 
+func TestWorkspaces_RemoveTagBindings(t *testing.T) {
+	skipUnlessBeta(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wCleanup := createWorkspace(t, client, nil)
+	t.Cleanup(wCleanup)
+
+	_, err := client.Workspaces.AddTagBindings(ctx, wTest.ID, WorkspaceAddTagBindingsOptions{
+		TagBindings: []*TagBinding{
+			{Key: "foo", Value: "bar"},
+			{Key: "baz", Value: "qux"},
+			{Key: "env"},
+		},
+	})
+	require.NoError(t, err)
+
+	t.Run("when removing a subset of keys", func(t *testing.T) {
+		err := client.Workspaces.RemoveTagBindings(ctx, wTest.ID, []string{"foo", "env"})
+		require.NoError(t, err)
+
+		bindings, err := client.Workspaces.ListTagBindings(ctx, wTest.ID)
+		require.NoError(t, err)
+		require.Len(t, bindings, 1)
+		assert.Equal(t, "baz", bindings[0].Key)
+		assert.Equal(t, "qux", bindings[0].Value)
+	})
+
+	t.Run("when none of the keys are bound", func(t *testing.T) {
+		err := client.Workspaces.RemoveTagBindings(ctx, wTest.ID, []string{"nonexisting"})
+		require.NoError(t, err)
+
+		bindings, err := client.Workspaces.ListTagBindings(ctx, wTest.ID)
+		require.NoError(t, err)
+		assert.Len(t, bindings, 1)
+	})
+
+	t.Run("with the deprecated DeleteTagBindings", func(t *testing.T) {
+		_, err := client.Workspaces.AddTagBindings(ctx, wTest.ID, WorkspaceAddTagBindingsOptions{
+			TagBindings: []*TagBinding{{Key: "foo", Value: "bar"}},
+		})
+		require.NoError(t, err)
+
+		err = client.Workspaces.DeleteTagBindings(ctx, wTest.ID, []string{"foo"})
+		require.NoError(t, err)
+
+		bindings, err := client.Workspaces.ListTagBindings(ctx, wTest.ID)
+		require.NoError(t, err)
+		for _, b := range bindings {
+			assert.NotEqual(t, "foo", b.Key)
+		}
+	})
+
+	t.Run("without any keys", func(t *testing.T) {
+		err := client.Workspaces.RemoveTagBindings(ctx, wTest.ID, nil)
+		assert.Equal(t, ErrRequiredTagBindingKeys, err)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		err := client.Workspaces.RemoveTagBindings(ctx, badIdentifier, []string{"foo"})
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspaces_ListEffectiveTagBindingsWithOptions(t *testing.T) {
+	skipUnlessBeta(t)
+
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	pTest, pCleanup := createProjectWithOptions(t, client, orgTest, ProjectCreateOptions{
+		Name: randomStringWithoutSpecialChar(t),
+		TagBindings: []*TagBinding{
+			{Key: "team", Value: "platform"},
+		},
+	})
+	t.Cleanup(pCleanup)
+
+	wTest, wCleanup := createWorkspaceWithOptions(t, client, orgTest, WorkspaceCreateOptions{
+		Name:    String(randomString(t)),
+		Project: pTest,
+		TagBindings: []*TagBinding{
+			{Key: "env", Value: "prod"},
+		},
+	})
+	t.Cleanup(wCleanup)
+
+	t.Run("without options", func(t *testing.T) {
+		bindings, err := client.Workspaces.ListEffectiveTagBindingsWithOptions(ctx, wTest.ID, nil)
+		require.NoError(t, err)
+		assert.Len(t, bindings, 2)
+	})
+
+	t.Run("when excluding inherited bindings", func(t *testing.T) {
+		bindings, err := client.Workspaces.ListEffectiveTagBindingsWithOptions(ctx, wTest.ID, &WorkspaceEffectiveTagBindingsListOptions{
+			ExcludeInherited: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, bindings, 1)
+		assert.Equal(t, "env", bindings[0].Key)
+		assert.False(t, bindings[0].Inherited())
+	})
+}
+
 func TestWorkspacesUpdate(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -1773,6 +1884,268 @@ func TestWorkspacesUpdateTableDrivenWithGithubApp(t *testing.T) {
 	}
 }
 
+func TestWorkspacesUpdateAttributes(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	wTest, wCleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(wCleanup)
+
+	t.Run("with a subset of attributes", func(t *testing.T) {
+		wAfter, err := client.Workspaces.UpdateAttributes(ctx, wTest.ID, map[string]interface{}{
+			"auto-apply":  !wTest.AutoApply,
+			"description": "updated with raw attributes",
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, wTest.Name, wAfter.Name)
+		assert.Equal(t, !wTest.AutoApply, wAfter.AutoApply)
+		assert.Equal(t, "updated with raw attributes", wAfter.Description)
+		assert.Equal(t, wTest.TerraformVersion, wAfter.TerraformVersion)
+	})
+
+	t.Run("without attributes", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateAttributes(ctx, wTest.ID, map[string]interface{}{})
+		assert.Nil(t, w)
+		assert.Equal(t, ErrRequiredAttributes, err)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateAttributes(ctx, badIdentifier, map[string]interface{}{
+			"auto-apply": true,
+		})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacesUpdateRelationship(t *testing.T) {
+	t.Parallel()
+
+	var body []byte
+	var ifMatch string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "PATCH /api/v2/workspaces/ws-123":
+			body, _ = io.ReadAll(r.Body)
+			ifMatch = r.Header.Get("If-Match")
+			if ifMatch == `"stale"` {
+				w.WriteHeader(http.StatusPreconditionFailed)
+				return
+			}
+			_, _ = w.Write([]byte(`{"data":{"id":"ws-123","type":"workspaces","relationships":{"project":{"data":{"id":"prj-2","type":"projects"}}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{
+		Address:    srv.URL,
+		Token:      "insert-your-token-here",
+		HTTPClient: srv.Client(),
+	})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("with a related resource", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateRelationship(ctx, "ws-123", RelationshipUpdateOptions{
+			Relationship: "project",
+			Related:      &ResourceIdentifier{Type: "projects", ID: "prj-2"},
+			IfMatch:      `"current"`,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "prj-2", w.Project.ID)
+		assert.Equal(t, `"current"`, ifMatch)
+		assert.JSONEq(t, `{"data":{"type":"workspaces","id":"ws-123","relationships":{"project":{"data":{"type":"projects","id":"prj-2"}}}}}`, string(body))
+	})
+
+	t.Run("clearing the relationship", func(t *testing.T) {
+		_, err := client.Workspaces.UpdateRelationship(ctx, "ws-123", RelationshipUpdateOptions{
+			Relationship: "agent-pool",
+		})
+		require.NoError(t, err)
+		assert.Empty(t, ifMatch)
+		assert.JSONEq(t, `{"data":{"type":"workspaces","id":"ws-123","relationships":{"agent-pool":{"data":null}}}}`, string(body))
+	})
+
+	t.Run("with a stale ETag", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateRelationship(ctx, "ws-123", RelationshipUpdateOptions{
+			Relationship: "project",
+			Related:      &ResourceIdentifier{Type: "projects", ID: "prj-2"},
+			IfMatch:      `"stale"`,
+		})
+		assert.Nil(t, w)
+		assert.True(t, IsConflict(err))
+	})
+
+	t.Run("without a relationship", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateRelationship(ctx, "ws-123", RelationshipUpdateOptions{})
+		assert.Nil(t, w)
+		assert.Equal(t, ErrRequiredRelationship, err)
+	})
+
+	t.Run("with an incomplete related resource", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateRelationship(ctx, "ws-123", RelationshipUpdateOptions{
+			Relationship: "project",
+			Related:      &ResourceIdentifier{ID: "prj-2"},
+		})
+		assert.Nil(t, w)
+		assert.Equal(t, ErrInvalidRelatedResource, err)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateRelationship(ctx, badIdentifier, RelationshipUpdateOptions{Relationship: "project"})
+		assert.Nil(t, w)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacesSnapshot(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTestCleanup)
+
+	t.Run("when the workspace exists", func(t *testing.T) {
+		golden, err := client.Workspaces.Snapshot(ctx, wTest.ID)
+		require.NoError(t, err)
+		assert.Equal(t, wTest.Name, golden.Name)
+		assert.Equal(t, wTest.Project.ID, golden.ProjectID)
+
+		_, err = client.Workspaces.UpdateByID(ctx, wTest.ID, WorkspaceUpdateOptions{
+			AutoApply:        Bool(!golden.AutoApply),
+			WorkingDirectory: String("infra"),
+		})
+		require.NoError(t, err)
+
+		current, err := client.Workspaces.Snapshot(ctx, wTest.ID)
+		require.NoError(t, err)
+
+		assert.Equal(t, []*WorkspaceSettingsChange{
+			{Attribute: "auto-apply", Old: golden.AutoApply, New: !golden.AutoApply},
+			{Attribute: "working-directory", Old: golden.WorkingDirectory, New: "infra"},
+		}, DiffSettings(golden, current))
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		w, err := client.Workspaces.Snapshot(ctx, badIdentifier)
+		assert.Nil(t, w)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacesCan(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	wTest, wTestCleanup := createWorkspace(t, client, nil)
+	t.Cleanup(wTestCleanup)
+
+	t.Run("as an owner of the organization", func(t *testing.T) {
+		for _, op := range []WorkspaceOperation{
+			OperationQueuePlan,
+			OperationApply,
+			OperationManageVariables,
+			OperationLockUnlock,
+		} {
+			allowed, missing, err := client.Workspaces.Can(ctx, wTest.ID, op)
+			require.NoError(t, err)
+			assert.True(t, allowed, op)
+			assert.Empty(t, missing)
+		}
+	})
+
+	t.Run("with an invalid operation", func(t *testing.T) {
+		allowed, _, err := client.Workspaces.Can(ctx, wTest.ID, "nonexisting")
+		assert.False(t, allowed)
+		assert.ErrorIs(t, err, ErrInvalidWorkspaceOperation)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		allowed, _, err := client.Workspaces.Can(ctx, badIdentifier, OperationApply)
+		assert.False(t, allowed)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacePermissions_Can(t *testing.T) {
+	t.Parallel()
+
+	p := &WorkspacePermissions{
+		CanQueueRun:       true,
+		CanUpdateVariable: true,
+		CanLock:           true,
+	}
+
+	cases := []struct {
+		operation WorkspaceOperation
+		allowed   bool
+		missing   string
+	}{
+		{OperationQueuePlan, true, ""},
+		{OperationApply, false, "can-queue-apply"},
+		{OperationManageVariables, true, ""},
+		{OperationLockUnlock, false, "can-unlock"},
+	}
+	for _, c := range cases {
+		allowed, missing, err := p.Can(c.operation)
+		require.NoError(t, err)
+		assert.Equal(t, c.allowed, allowed, c.operation)
+		assert.Equal(t, c.missing, missing, c.operation)
+	}
+}
+
+func TestDiffSettings(t *testing.T) {
+	t.Parallel()
+
+	a := &WorkspaceSettings{
+		Name:            "app",
+		ExecutionMode:   "remote",
+		TriggerPrefixes: []string{"modules/", "app/"},
+		TagNames:        nil,
+	}
+
+	t.Run("when the settings are equal", func(t *testing.T) {
+		b := &WorkspaceSettings{
+			Name:            "app",
+			ExecutionMode:   "remote",
+			TriggerPrefixes: []string{"app/", "modules/"},
+			TagNames:        []string{},
+		}
+		assert.Empty(t, DiffSettings(a, b))
+	})
+
+	t.Run("when the settings differ", func(t *testing.T) {
+		b := &WorkspaceSettings{
+			Name:            "app",
+			ExecutionMode:   "agent",
+			AgentPoolID:     "apool-123",
+			TriggerPrefixes: []string{"app/"},
+		}
+		assert.Equal(t, []*WorkspaceSettingsChange{
+			{Attribute: "execution-mode", Old: "remote", New: "agent"},
+			{Attribute: "agent-pool", Old: "", New: "apool-123"},
+			{Attribute: "trigger-prefixes", Old: []string{"app/", "modules/"}, New: []string{"app/"}},
+		}, DiffSettings(a, b))
+	})
+
+	t.Run("with a nil snapshot", func(t *testing.T) {
+		changes := DiffSettings(a, nil)
+		assert.Len(t, changes, 3)
+	})
+}
+
 func TestWorkspacesUpdateByID(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -2173,6 +2546,85 @@ func TestWorkspacesLock(t *testing.T) {
 	})
 }
 
+func TestWorkspacesReadLockInfo(t *testing.T) {
+	client := testClient(t)
+	ctx := context.Background()
+
+	orgTest, orgTestCleanup := createOrganization(t, client)
+	t.Cleanup(orgTestCleanup)
+
+	wTest, wTestCleanup := createWorkspace(t, client, orgTest)
+	t.Cleanup(wTestCleanup)
+
+	t.Run("when the workspace is not locked", func(t *testing.T) {
+		info, err := client.Workspaces.ReadLockInfo(ctx, wTest.ID)
+		require.NoError(t, err)
+		assert.False(t, info.Locked)
+		assert.Nil(t, info.User)
+	})
+
+	t.Run("when the workspace is locked with a reason", func(t *testing.T) {
+		_, err := client.Workspaces.Lock(ctx, wTest.ID, WorkspaceLockOptions{
+			Reason: String("Maintenance window"),
+		})
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_, _ = client.Workspaces.Unlock(ctx, wTest.ID)
+		})
+
+		info, err := client.Workspaces.ReadLockInfo(ctx, wTest.ID)
+		require.NoError(t, err)
+		assert.True(t, info.Locked)
+		requireExactlyOneNotEmpty(t, info.Run, info.Team, info.User)
+		assert.Equal(t, "Maintenance window", info.Reason)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		info, err := client.Workspaces.ReadLockInfo(ctx, badIdentifier)
+		assert.Nil(t, info)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacesReadOutputs(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-123/current-state-version-outputs": `{"data":[
+			{"id":"wsout-1","type":"state-version-outputs","attributes":{"name":"region","sensitive":false,"type":"string","value":"us-east-1"}},
+			{"id":"wsout-2","type":"state-version-outputs","attributes":{"name":"password","sensitive":true,"type":"string","value":null}}
+		]}`,
+		"GET /api/v2/state-version-outputs/wsout-2": `{"data":{"id":"wsout-2","type":"state-version-outputs","attributes":{"name":"password","sensitive":true,"type":"string","value":"s3cr3t"}}}`,
+		"GET /api/v2/workspaces/ws-forbidden/current-state-version-outputs": `{"data":[
+			{"id":"wsout-3","type":"state-version-outputs","attributes":{"name":"token","sensitive":true,"type":"string","value":null}}
+		]}`,
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("with sensitive outputs", func(t *testing.T) {
+		outputs, err := client.Workspaces.ReadOutputs(ctx, "ws-123")
+		require.NoError(t, err)
+		require.Len(t, outputs, 2)
+		assert.Equal(t, "us-east-1", outputs["region"].Value)
+		assert.False(t, outputs["region"].Sensitive)
+		assert.Equal(t, "s3cr3t", outputs["password"].Value)
+		assert.True(t, outputs["password"].Sensitive)
+	})
+
+	t.Run("when a sensitive output cannot be read", func(t *testing.T) {
+		outputs, err := client.Workspaces.ReadOutputs(ctx, "ws-forbidden")
+		assert.Nil(t, outputs)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("with invalid workspace ID", func(t *testing.T) {
+		outputs, err := client.Workspaces.ReadOutputs(ctx, badIdentifier)
+		assert.Nil(t, outputs)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
 func TestWorkspacesUnlock(t *testing.T) {
 	client := testClient(t)
 	ctx := context.Background()
@@ -3089,3 +3541,896 @@ func TestWorkspacesAutoDestroyDuration(t *testing.T) {
 		require.Equal(t, wTest.InheritsProjectAutoDestroy, false)
 	})
 }
+
+func TestWorkspacesReadFollowRename(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/organizations/acme/workspaces/current": `{"data":{"id":"ws-2","type":"workspaces","attributes":{"name":"current"}}}`,
+		"GET /api/v2/workspaces/ws-1": `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"new"},
+			"relationships":{"organization":{"data":{"id":"acme","type":"organizations"}}}}}`,
+	})
+	defer done()
+
+	ctx := context.Background()
+
+	// The workspace was read as "old" before being renamed to "new".
+	client.Workspaces.(*workspaces).rememberID("acme", &Workspace{ID: "ws-1", Name: "old"})
+
+	t.Run("with a current name", func(t *testing.T) {
+		w, err := client.Workspaces.ReadFollowRename(ctx, "acme", "current")
+		require.NoError(t, err)
+		assert.Equal(t, "ws-2", w.ID)
+	})
+
+	t.Run("with the name of a renamed workspace", func(t *testing.T) {
+		w, err := client.Workspaces.ReadFollowRename(ctx, "acme", "old")
+		require.NotNil(t, w)
+		assert.Equal(t, "ws-1", w.ID)
+		assert.ErrorIs(t, err, ErrWorkspaceRenamed)
+
+		var renamed *WorkspaceRenamedError
+		require.True(t, errors.As(err, &renamed))
+		assert.Equal(t, "old", renamed.OldName)
+		assert.Equal(t, "new", renamed.NewName)
+	})
+
+	t.Run("records the new name of a renamed workspace", func(t *testing.T) {
+		// Reading the renamed workspace by ID recorded its new name.
+		id, ok := client.Workspaces.(*workspaces).ids.Load("acme/new")
+		require.True(t, ok)
+		assert.Equal(t, "ws-1", id)
+	})
+
+	t.Run("with an unknown name", func(t *testing.T) {
+		w, err := client.Workspaces.ReadFollowRename(ctx, "acme", "unknown")
+		assert.Nil(t, w)
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+}
+
+func TestWorkspaceIDs(t *testing.T) {
+	t.Parallel()
+
+	t.Run("evicts the least recently read name", func(t *testing.T) {
+		ids := &workspaceIDs{size: 2}
+		ids.Store("acme/one", "ws-1")
+		ids.Store("acme/two", "ws-2")
+		ids.Store("acme/one", "ws-1")
+		ids.Store("acme/three", "ws-3")
+
+		_, ok := ids.Load("acme/two")
+		assert.False(t, ok)
+		id, ok := ids.Load("acme/one")
+		assert.True(t, ok)
+		assert.Equal(t, "ws-1", id)
+		id, ok = ids.Load("acme/three")
+		assert.True(t, ok)
+		assert.Equal(t, "ws-3", id)
+	})
+
+	t.Run("forgets names not read again in time", func(t *testing.T) {
+		ids := &workspaceIDs{ttl: time.Millisecond}
+		ids.Store("acme/one", "ws-1")
+		time.Sleep(5 * time.Millisecond)
+
+		_, ok := ids.Load("acme/one")
+		assert.False(t, ok)
+	})
+}
+
+func TestWorkspacesUpdateVCSConnection(t *testing.T) {
+	t.Parallel()
+
+	var patched map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/workspaces/ws-1":
+			_, _ = io.WriteString(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"vcs-repo":{
+				"identifier":"acme/app","branch":"main","ingress-submodules":true,"oauth-token-id":"ot-1"}}}}`)
+		case "PATCH /api/v2/workspaces/ws-1":
+			var body struct {
+				Data struct {
+					Attributes map[string]interface{} `json:"attributes"`
+				} `json:"data"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+			patched, _ = body.Data.Attributes["vcs-repo"].(map[string]interface{})
+			_, _ = io.WriteString(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"vcs-repo":{
+				"identifier":"acme/app","branch":"main","github-app-installation-id":"ghain-1"}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("from an OAuth token to a GitHub App installation", func(t *testing.T) {
+		w, err := client.Workspaces.UpdateVCSConnection(ctx, "ws-1", WorkspaceVCSConnectionOptions{
+			GHAInstallationID: String("ghain-1"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ghain-1", w.VCSRepo.GHAInstallationID)
+
+		require.NotNil(t, patched)
+		assert.Equal(t, "ghain-1", patched["github-app-installation-id"])
+		assert.Contains(t, patched, "oauth-token-id")
+		assert.Nil(t, patched["oauth-token-id"])
+		assert.Equal(t, "acme/app", patched["identifier"])
+		assert.Equal(t, "main", patched["branch"])
+		assert.Equal(t, true, patched["ingress-submodules"])
+	})
+
+	t.Run("switching the branch", func(t *testing.T) {
+		_, err := client.Workspaces.UpdateVCSConnection(ctx, "ws-1", WorkspaceVCSConnectionOptions{
+			OAuthTokenID: String("ot-2"),
+			Branch:       String("release"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "ot-2", patched["oauth-token-id"])
+		assert.Nil(t, patched["github-app-installation-id"])
+		assert.Equal(t, "release", patched["branch"])
+	})
+
+	t.Run("with both connections", func(t *testing.T) {
+		_, err := client.Workspaces.UpdateVCSConnection(ctx, "ws-1", WorkspaceVCSConnectionOptions{
+			OAuthTokenID:      String("ot-2"),
+			GHAInstallationID: String("ghain-1"),
+		})
+		assert.Equal(t, ErrUnsupportedBothOAuthTokenAndGHAInstallation, err)
+	})
+
+	t.Run("without a connection", func(t *testing.T) {
+		_, err := client.Workspaces.UpdateVCSConnection(ctx, "ws-1", WorkspaceVCSConnectionOptions{})
+		assert.Equal(t, ErrRequiredOauthTokenOrGithubAppInstallationID, err)
+	})
+
+	t.Run("with both connections in update options", func(t *testing.T) {
+		_, err := client.Workspaces.Update(ctx, "acme", "app", WorkspaceUpdateOptions{
+			VCSRepo: &VCSRepoOptions{OAuthTokenID: String("ot-2"), GHAInstallationID: String("ghain-1")},
+		})
+		assert.Equal(t, ErrUnsupportedBothOAuthTokenAndGHAInstallation, err)
+	})
+}
+
+func TestValidateTerraformVersionConstraint(t *testing.T) {
+	t.Parallel()
+
+	for _, c := range []string{"1.7.0", "~> 1.7.0", ">= 1.5, < 2.0", "!= 1.6.0"} {
+		assert.NoError(t, ValidateTerraformVersionConstraint(c), c)
+	}
+	for _, c := range []string{"", " ", "~>", "latest", "1.x"} {
+		assert.Equal(t, ErrInvalidTerraformVersionConstraint, ValidateTerraformVersionConstraint(c), c)
+	}
+
+	t.Run("in workspace options", func(t *testing.T) {
+		client, done := newExampleClient(nil)
+		defer done()
+
+		_, err := client.Workspaces.Create(context.Background(), "acme", WorkspaceCreateOptions{
+			Name:                       String("app"),
+			TerraformVersion:           String("1.7.0"),
+			TerraformVersionConstraint: String("~> 1.7.0"),
+		})
+		assert.Equal(t, ErrUnsupportedBothTerraformVersionAndConstraint, err)
+
+		_, err = client.Workspaces.UpdateByID(context.Background(), "ws-1", WorkspaceUpdateOptions{
+			TerraformVersionConstraint: String("~>"),
+		})
+		assert.Equal(t, ErrInvalidTerraformVersionConstraint, err)
+	})
+
+	t.Run("sent as the terraform version", func(t *testing.T) {
+		options := WorkspaceUpdateOptions{TerraformVersionConstraint: String("~> 1.7.0")}
+		require.NoError(t, options.applyTerraformVersionConstraint())
+		assert.Equal(t, "~> 1.7.0", *options.TerraformVersion)
+	})
+}
+
+func TestWorkspacesResolveEffectiveTerraformVersion(t *testing.T) {
+	t.Parallel()
+
+	workspaces := map[string]string{
+		"GET /api/v2/workspaces/ws-1": `{"data":{"id":"ws-1","type":"workspaces","attributes":{"terraform-version":"~> 1.7.0"}}}`,
+		"GET /api/v2/workspaces/ws-2": `{"data":{"id":"ws-2","type":"workspaces","attributes":{"terraform-version":"1.9.0"}}}`,
+		"GET /api/v2/workspaces/ws-3": `{"data":{"id":"ws-3","type":"workspaces","attributes":{"terraform-version":"~> 2.0"}}}`,
+		"GET /api/v2/workspaces/ws-1/runs": `{"data":[
+			{"id":"run-1","type":"runs","attributes":{"terraform-version":"1.7.3"}}
+		]}`,
+	}
+
+	ctx := context.Background()
+
+	t.Run("with the available versions", func(t *testing.T) {
+		responses := map[string]string{
+			"GET /api/v2/admin/terraform-versions": `{"data":[
+				{"id":"tool-1","type":"terraform-versions","attributes":{"version":"1.6.6","enabled":true}},
+				{"id":"tool-2","type":"terraform-versions","attributes":{"version":"1.7.2","enabled":true}},
+				{"id":"tool-3","type":"terraform-versions","attributes":{"version":"1.7.5","enabled":true}},
+				{"id":"tool-4","type":"terraform-versions","attributes":{"version":"1.7.9","enabled":false}},
+				{"id":"tool-5","type":"terraform-versions","attributes":{"version":"1.7.10-beta1","enabled":true,"beta":true}},
+				{"id":"tool-6","type":"terraform-versions","attributes":{"version":"1.8.0","enabled":true}}
+			]}`,
+		}
+		for k, v := range workspaces {
+			responses[k] = v
+		}
+		client, done := newExampleClient(responses)
+		defer done()
+
+		effective, err := client.Workspaces.ResolveEffectiveTerraformVersion(ctx, "ws-1")
+		require.NoError(t, err)
+		assert.Equal(t, "~> 1.7.0", effective.Constraint)
+		assert.Equal(t, "1.7.5", effective.Version)
+		assert.Equal(t, EffectiveTerraformVersionAvailable, effective.Source)
+
+		effective, err = client.Workspaces.ResolveEffectiveTerraformVersion(ctx, "ws-2")
+		require.NoError(t, err)
+		assert.Equal(t, "1.9.0", effective.Version)
+		assert.Equal(t, EffectiveTerraformVersionExact, effective.Source)
+
+		_, err = client.Workspaces.ResolveEffectiveTerraformVersion(ctx, "ws-3")
+		assert.Equal(t, ErrUnresolvedTerraformVersion, err)
+	})
+
+	t.Run("without access to the available versions", func(t *testing.T) {
+		client, done := newExampleClient(workspaces)
+		defer done()
+
+		effective, err := client.Workspaces.ResolveEffectiveTerraformVersion(ctx, "ws-1")
+		require.NoError(t, err)
+		assert.Equal(t, "1.7.3", effective.Version)
+		assert.Equal(t, EffectiveTerraformVersionLatestRun, effective.Source)
+	})
+}
+
+func TestWorkspacesReadMany(t *testing.T) {
+	t.Parallel()
+
+	// The fake API serves three pages of two workspaces, whatever the
+	// requested page size.
+	names := []string{"a", "b", "c", "d", "e", "f"}
+
+	var mu sync.Mutex
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+
+		mu.Lock()
+		requests = append(requests, r.URL.Path+"?"+r.URL.Query().Get("page[number]"))
+		mu.Unlock()
+
+		path := strings.TrimPrefix(r.URL.Path, "/api/v2/organizations/acme/workspaces")
+		switch {
+		case path == "":
+			page := 1
+			_, _ = fmt.Sscan(r.URL.Query().Get("page[number]"), &page)
+			next := page + 1
+			if next > 3 {
+				next = 0
+			}
+			fmt.Fprintf(w, `{"data":[
+				{"id":"ws-%[1]s","type":"workspaces","attributes":{"name":"%[1]s"}},
+				{"id":"ws-%[2]s","type":"workspaces","attributes":{"name":"%[2]s"}}
+			],"meta":{"pagination":{"current-page":%[3]d,"next-page":%[4]d,"total-pages":3,"total-count":6}}}`,
+				names[2*page-2], names[2*page-1], page, next)
+		case strings.HasPrefix(path, "/"):
+			name := strings.TrimPrefix(path, "/")
+			for _, n := range names {
+				if n == name {
+					fmt.Fprintf(w, `{"data":{"id":"ws-%[1]s","type":"workspaces","attributes":{"name":"%[1]s"}}}`, name)
+					return
+				}
+			}
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	reset := func() []string {
+		mu.Lock()
+		defer mu.Unlock()
+		r := requests
+		requests = nil
+		sort.Strings(r)
+		return r
+	}
+
+	t.Run("listing the remaining pages", func(t *testing.T) {
+		result, err := client.Workspaces.ReadMany(ctx, "acme", []string{"x", "a", "c", "e", "c"})
+		require.NoError(t, err)
+		assert.Len(t, result.Workspaces, 3)
+		assert.Equal(t, "ws-c", result.Workspaces["c"].ID)
+		assert.Equal(t, []string{"x"}, result.Missing)
+		assert.Equal(t, []string{
+			"/api/v2/organizations/acme/workspaces?1",
+			"/api/v2/organizations/acme/workspaces?2",
+			"/api/v2/organizations/acme/workspaces?3",
+		}, reset())
+	})
+
+	t.Run("reading the remaining names", func(t *testing.T) {
+		result, err := client.Workspaces.ReadMany(ctx, "acme", []string{"b", "f"})
+		require.NoError(t, err)
+		assert.Len(t, result.Workspaces, 2)
+		assert.Equal(t, "ws-f", result.Workspaces["f"].ID)
+		assert.Empty(t, result.Missing)
+		assert.Equal(t, []string{
+			"/api/v2/organizations/acme/workspaces/f?",
+			"/api/v2/organizations/acme/workspaces?1",
+		}, reset())
+	})
+
+	t.Run("with invalid names", func(t *testing.T) {
+		_, err := client.Workspaces.ReadMany(ctx, badIdentifier, []string{"a"})
+		assert.Equal(t, ErrInvalidOrg, err)
+
+		_, err = client.Workspaces.ReadMany(ctx, "acme", []string{"a", badIdentifier})
+		assert.Equal(t, ErrInvalidWorkspaceValue, err)
+	})
+}
+
+func TestWorkspacesListAll(t *testing.T) {
+	t.Parallel()
+
+	// The fake API serves three pages of two workspaces. The first request
+	// for the second page fails with a server error, and the workspaces of
+	// the "gone" organization cannot be listed.
+	var mu sync.Mutex
+	requests := map[string]int{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.URL.Path != "/api/v2/organizations/acme/workspaces" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		page := 1
+		_, _ = fmt.Sscan(r.URL.Query().Get("page[number]"), &page)
+
+		mu.Lock()
+		requests[r.URL.Query().Get("search[name]")+fmt.Sprint(page)]++
+		attempt := requests[r.URL.Query().Get("search[name]")+fmt.Sprint(page)]
+		mu.Unlock()
+
+		if page == 2 && attempt == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		next := page + 1
+		if next > 3 {
+			next = 0
+		}
+		fmt.Fprintf(w, `{"data":[
+			{"id":"ws-%[1]d-a","type":"workspaces","attributes":{"name":"%[1]d-a"}},
+			{"id":"ws-%[1]d-b","type":"workspaces","attributes":{"name":"%[1]d-b"}}
+		],"meta":{"pagination":{"current-page":%[1]d,"next-page":%[2]d,"total-pages":3,"total-count":6}}}`, page, next)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("retrying a failed page", func(t *testing.T) {
+		workspaces, errs := client.Workspaces.ListAll(ctx, "acme", nil)
+
+		var names []string
+		for w := range workspaces {
+			names = append(names, w.Name)
+		}
+		require.NoError(t, <-errs)
+		assert.Equal(t, []string{"1-a", "1-b", "2-a", "2-b", "3-a", "3-b"}, names)
+
+		mu.Lock()
+		defer mu.Unlock()
+		assert.Equal(t, map[string]int{"1": 1, "2": 2, "3": 1}, requests)
+	})
+
+	t.Run("from a given page", func(t *testing.T) {
+		workspaces, errs := client.Workspaces.ListAll(ctx, "acme", &WorkspaceListOptions{
+			ListOptions: ListOptions{PageNumber: 3},
+			Search:      "from",
+		})
+
+		var names []string
+		for w := range workspaces {
+			names = append(names, w.Name)
+		}
+		require.NoError(t, <-errs)
+		assert.Equal(t, []string{"3-a", "3-b"}, names)
+	})
+
+	t.Run("stopping early", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(ctx)
+		workspaces, errs := client.Workspaces.ListAll(ctx, "acme", &WorkspaceListOptions{Search: "stop"})
+
+		w := <-workspaces
+		assert.Equal(t, "1-a", w.Name)
+		cancel()
+
+		for range workspaces {
+		}
+		assert.ErrorIs(t, <-errs, context.Canceled)
+	})
+
+	t.Run("with an error", func(t *testing.T) {
+		workspaces, errs := client.Workspaces.ListAll(ctx, "gone", nil)
+
+		for range workspaces {
+			t.Fatal("unexpected workspace")
+		}
+		assert.ErrorIs(t, <-errs, ErrResourceNotFound)
+	})
+
+	t.Run("without a valid organization", func(t *testing.T) {
+		workspaces, errs := client.Workspaces.ListAll(ctx, badIdentifier, nil)
+
+		for range workspaces {
+			t.Fatal("unexpected workspace")
+		}
+		assert.Equal(t, ErrInvalidOrg, <-errs)
+	})
+}
+
+func TestWorkspacesReadCurrentConfigurationVersion(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.URL.Path {
+		case "/api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "/api/v2/workspaces/ws-1":
+			assert.Equal(t, "current_configuration_version,current_configuration_version.ingress_attributes", r.URL.Query().Get("include"))
+			_, _ = io.WriteString(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"app"},"relationships":{
+				"current-configuration-version":{"data":{"id":"cv-1","type":"configuration-versions"}}}},
+				"included":[
+					{"id":"cv-1","type":"configuration-versions","attributes":{"status":"uploaded","source":"github"},
+						"relationships":{"ingress-attributes":{"data":{"id":"ia-1","type":"ingress-attributes"}}}},
+					{"id":"ia-1","type":"ingress-attributes","attributes":{"branch":"main","commit-sha":"abc123","commit-message":"Bump module"}}]}`)
+		case "/api/v2/workspaces/ws-2":
+			_, _ = io.WriteString(w, `{"data":{"id":"ws-2","type":"workspaces","attributes":{"name":"empty"},"relationships":{
+				"current-configuration-version":{"data":null}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	t.Run("with ingress attributes", func(t *testing.T) {
+		cv, err := client.Workspaces.ReadCurrentConfigurationVersion(ctx, "ws-1")
+		require.NoError(t, err)
+		assert.Equal(t, "cv-1", cv.ID)
+		assert.Equal(t, ConfigurationUploaded, cv.Status)
+		require.NotNil(t, cv.IngressAttributes)
+		assert.Equal(t, "main", cv.IngressAttributes.Branch)
+		assert.Equal(t, "abc123", cv.IngressAttributes.CommitSHA)
+		assert.Equal(t, "Bump module", cv.IngressAttributes.CommitMessage)
+	})
+
+	t.Run("without a configuration version", func(t *testing.T) {
+		_, err := client.Workspaces.ReadCurrentConfigurationVersion(ctx, "ws-2")
+		assert.Equal(t, ErrWorkspaceNoConfigurationVersion, err)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		_, err := client.Workspaces.ReadCurrentConfigurationVersion(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
+func TestWorkspacesAutoDestroyStatus(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now().UTC()
+	at := func(d time.Duration) string {
+		return now.Add(d).Format(time.RFC3339)
+	}
+
+	// ws-1 is destroyed in two days as set on the workspace, ws-2 in five
+	// days after two weeks of inactivity as inherited from its project, ws-3
+	// in thirty days, and ws-4 is never destroyed.
+	project := `{"id":"prj-1","type":"projects","attributes":{"name":"ephemeral","auto-destroy-activity-duration":"14d"}}`
+	ws1 := fmt.Sprintf(`{"id":"ws-1","type":"workspaces","attributes":{"name":"one","auto-destroy-at":%q,"inherits-project-auto-destroy":false}}`, at(48*time.Hour))
+	ws2 := fmt.Sprintf(`{"id":"ws-2","type":"workspaces","attributes":{"name":"two","auto-destroy-at":%q,"inherits-project-auto-destroy":true},
+		"relationships":{"project":{"data":{"id":"prj-1","type":"projects"}}}}`, at(120*time.Hour))
+	ws3 := fmt.Sprintf(`{"id":"ws-3","type":"workspaces","attributes":{"name":"three","auto-destroy-at":%q,"auto-destroy-activity-duration":"30d"}}`, at(720*time.Hour))
+	ws4 := `{"id":"ws-4","type":"workspaces","attributes":{"name":"four","auto-destroy-at":null}}`
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-2": fmt.Sprintf(`{"data":%s,"included":[%s]}`, ws2, project),
+		"GET /api/v2/workspaces/ws-4": fmt.Sprintf(`{"data":%s}`, ws4),
+		"GET /api/v2/organizations/acme/workspaces": fmt.Sprintf(`{"data":[%s,%s,%s,%s],"included":[%s],
+			"meta":{"pagination":{"current-page":1,"next-page":null,"total-pages":1,"total-count":4}}}`, ws4, ws3, ws2, ws1, project),
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("when inherited from the project", func(t *testing.T) {
+		status, err := client.Workspaces.ReadAutoDestroyStatus(ctx, "ws-2")
+		require.NoError(t, err)
+		assert.Equal(t, "ws-2", status.Workspace.ID)
+		assert.True(t, status.Scheduled())
+		assert.WithinDuration(t, now.Add(120*time.Hour), status.ScheduledAt, time.Second)
+		assert.Equal(t, "14d", status.ActivityDuration)
+		assert.Equal(t, AutoDestroyOriginProject, status.Origin)
+	})
+
+	t.Run("when not scheduled", func(t *testing.T) {
+		status, err := client.Workspaces.ReadAutoDestroyStatus(ctx, "ws-4")
+		require.NoError(t, err)
+		assert.False(t, status.Scheduled())
+		assert.Empty(t, status.ActivityDuration)
+		assert.Equal(t, AutoDestroyOriginNone, status.Origin)
+	})
+
+	t.Run("previewing the next week", func(t *testing.T) {
+		statuses, err := client.Workspaces.PreviewAutoDestroy(ctx, "acme", 7*24*time.Hour)
+		require.NoError(t, err)
+		require.Len(t, statuses, 2)
+		assert.Equal(t, "ws-1", statuses[0].Workspace.ID)
+		assert.Equal(t, AutoDestroyOriginWorkspace, statuses[0].Origin)
+		assert.Equal(t, "ws-2", statuses[1].Workspace.ID)
+		assert.Equal(t, AutoDestroyOriginProject, statuses[1].Origin)
+	})
+
+	t.Run("previewing the next quarter", func(t *testing.T) {
+		statuses, err := client.Workspaces.PreviewAutoDestroy(ctx, "acme", 90*24*time.Hour)
+		require.NoError(t, err)
+		require.Len(t, statuses, 3)
+		assert.Equal(t, "ws-3", statuses[2].Workspace.ID)
+		assert.Equal(t, "30d", statuses[2].ActivityDuration)
+		assert.Equal(t, AutoDestroyOriginWorkspace, statuses[2].Origin)
+	})
+
+	t.Run("with invalid identifiers", func(t *testing.T) {
+		_, err := client.Workspaces.ReadAutoDestroyStatus(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+
+		_, err = client.Workspaces.PreviewAutoDestroy(ctx, badIdentifier, time.Hour)
+		assert.Equal(t, ErrInvalidOrg, err)
+	})
+}
+
+func TestWorkspacesSafeDeleteDryRun(t *testing.T) {
+	t.Parallel()
+
+	sv := func(id string, processed bool) string {
+		return fmt.Sprintf(`{"id":%q,"type":"state-versions","attributes":{"resources-processed":%t}}`, id, processed)
+	}
+	ws := func(id string, locked bool, resources int, permissions string, stateVersion string) string {
+		return fmt.Sprintf(`{"id":%q,"type":"workspaces","attributes":{"locked":%t,"resource-count":%d,"permissions":%s},
+			"relationships":{"current-state-version":{"data":{"id":%q,"type":"state-versions"}}}}`, id, locked, resources, permissions, stateVersion)
+	}
+	permitted := `{"can-destroy":true,"can-force-delete":true}`
+
+	client, done := newExampleClient(map[string]string{
+		"GET /api/v2/workspaces/ws-empty": fmt.Sprintf(`{"data":%s,"included":[%s]}`,
+			ws("ws-empty", false, 0, permitted, "sv-1"), sv("sv-1", true)),
+		"GET /api/v2/workspaces/ws-busy": fmt.Sprintf(`{"data":%s,"included":[%s]}`,
+			ws("ws-busy", true, 3, permitted, "sv-2"), sv("sv-2", true)),
+		"GET /api/v2/workspaces/ws-processing": fmt.Sprintf(`{"data":%s,"included":[%s]}`,
+			ws("ws-processing", false, 0, `{"can-destroy":true,"can-force-delete":false}`, "sv-3"), sv("sv-3", false)),
+		"GET /api/v2/workspaces/ws-forbidden": fmt.Sprintf(`{"data":%s,"included":[%s]}`,
+			ws("ws-forbidden", false, 0, `{"can-destroy":false}`, "sv-4"), sv("sv-4", true)),
+	})
+	defer done()
+	ctx := context.Background()
+
+	t.Run("when the workspace can be safely deleted", func(t *testing.T) {
+		check, err := client.Workspaces.SafeDeleteDryRun(ctx, "ws-empty")
+		require.NoError(t, err)
+		assert.True(t, check.Safe())
+		assert.Empty(t, check.Reasons)
+		assert.NoError(t, check.Err())
+		assert.True(t, check.CanForceDelete)
+	})
+
+	t.Run("when the workspace is locked and managing resources", func(t *testing.T) {
+		check, err := client.Workspaces.SafeDeleteDryRun(ctx, "ws-busy")
+		require.NoError(t, err)
+		assert.False(t, check.Safe())
+		assert.Equal(t, []WorkspaceSafeDeleteReason{SafeDeleteLocked, SafeDeleteManagingResources}, check.Reasons)
+		assert.ErrorIs(t, check.Err(), ErrWorkspaceLockedCannotDelete)
+		assert.True(t, check.CanForceDelete)
+	})
+
+	t.Run("when the state is still processing", func(t *testing.T) {
+		check, err := client.Workspaces.SafeDeleteDryRun(ctx, "ws-processing")
+		require.NoError(t, err)
+		assert.Equal(t, []WorkspaceSafeDeleteReason{SafeDeleteStillProcessing}, check.Reasons)
+		assert.ErrorIs(t, check.Err(), ErrWorkspaceStillProcessing)
+		assert.False(t, check.CanForceDelete)
+	})
+
+	t.Run("without permission to delete the workspace", func(t *testing.T) {
+		check, err := client.Workspaces.SafeDeleteDryRun(ctx, "ws-forbidden")
+		require.NoError(t, err)
+		assert.Equal(t, []WorkspaceSafeDeleteReason{SafeDeleteNotPermitted}, check.Reasons)
+		assert.ErrorIs(t, check.Err(), ErrUnauthorized)
+		assert.False(t, check.CanForceDelete)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		check, err := client.Workspaces.SafeDeleteDryRun(ctx, badIdentifier)
+		assert.Nil(t, check)
+		assert.EqualError(t, err, ErrInvalidWorkspaceID.Error())
+	})
+}
+
+func TestWorkspacesReplaceRemoteStateConsumers(t *testing.T) {
+	t.Parallel()
+
+	// The fake API holds the consumers of ws-producer, listed one per page.
+	var mu sync.Mutex
+	consumers := []string{"ws-a", "ws-b", "ws-c"}
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		if r.URL.Path == "/api/v2/ping" {
+			w.WriteHeader(http.StatusNoContent)
+			return
+		}
+		if r.URL.Path != "/api/v2/workspaces/ws-producer/relationships/remote-state-consumers" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		if r.Method == "GET" {
+			page := 1
+			fmt.Sscan(r.URL.Query().Get("page[number]"), &page)
+			data := "[]"
+			if page <= len(consumers) {
+				data = fmt.Sprintf(`[{"id":%q,"type":"workspaces"}]`, consumers[page-1])
+			}
+			next := "null"
+			if page < len(consumers) {
+				next = fmt.Sprint(page + 1)
+			}
+			fmt.Fprintf(w, `{"data":%s,"meta":{"pagination":{"current-page":%d,"next-page":%s,"total-pages":%d,"total-count":%d}}}`,
+				data, page, next, len(consumers), len(consumers))
+			return
+		}
+
+		var body struct {
+			Data []struct {
+				ID string `json:"id"`
+			} `json:"data"`
+		}
+		_ = json.NewDecoder(r.Body).Decode(&body)
+		var ids []string
+		for _, d := range body.Data {
+			ids = append(ids, d.ID)
+		}
+		requests = append(requests, r.Method+" "+strings.Join(ids, ","))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("list all the consumers", func(t *testing.T) {
+		all, err := client.Workspaces.ListAllRemoteStateConsumers(ctx, "ws-producer")
+		require.NoError(t, err)
+		require.Len(t, all, 3)
+		assert.Equal(t, "ws-c", all[2].ID)
+	})
+
+	t.Run("check a consumer", func(t *testing.T) {
+		ok, err := client.Workspaces.IsRemoteStateConsumer(ctx, "ws-producer", "ws-b")
+		require.NoError(t, err)
+		assert.True(t, ok)
+
+		ok, err = client.Workspaces.IsRemoteStateConsumer(ctx, "ws-producer", "ws-z")
+		require.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("replace the consumers", func(t *testing.T) {
+		changes, err := client.Workspaces.ReplaceRemoteStateConsumers(ctx, "ws-producer", WorkspaceReplaceRemoteStateConsumersOptions{
+			Workspaces: []*Workspace{{ID: "ws-b"}, {ID: "ws-d"}, {ID: "ws-d"}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []*Workspace{{ID: "ws-d"}}, changes.Added)
+		require.Len(t, changes.Removed, 2)
+		assert.Equal(t, "ws-a", changes.Removed[0].ID)
+		assert.Equal(t, "ws-c", changes.Removed[1].ID)
+		assert.Equal(t, []string{"POST ws-d", "DELETE ws-a,ws-c"}, requests)
+	})
+
+	t.Run("when the consumers already match", func(t *testing.T) {
+		requests = nil
+		changes, err := client.Workspaces.ReplaceRemoteStateConsumers(ctx, "ws-producer", WorkspaceReplaceRemoteStateConsumersOptions{
+			Workspaces: []*Workspace{{ID: "ws-c"}, {ID: "ws-a"}, {ID: "ws-b"}},
+		})
+		require.NoError(t, err)
+		assert.Empty(t, changes.Added)
+		assert.Empty(t, changes.Removed)
+		assert.Empty(t, requests)
+	})
+
+	t.Run("with an empty list", func(t *testing.T) {
+		requests = nil
+		changes, err := client.Workspaces.ReplaceRemoteStateConsumers(ctx, "ws-producer", WorkspaceReplaceRemoteStateConsumersOptions{
+			Workspaces: []*Workspace{},
+		})
+		require.NoError(t, err)
+		assert.Len(t, changes.Removed, 3)
+		assert.Equal(t, []string{"DELETE ws-a,ws-b,ws-c"}, requests)
+	})
+
+	t.Run("without workspaces", func(t *testing.T) {
+		_, err := client.Workspaces.ReplaceRemoteStateConsumers(ctx, "ws-producer", WorkspaceReplaceRemoteStateConsumersOptions{})
+		assert.Equal(t, ErrWorkspacesRequired, err)
+
+		_, err = client.Workspaces.ReplaceRemoteStateConsumers(ctx, "ws-producer", WorkspaceReplaceRemoteStateConsumersOptions{
+			Workspaces: []*Workspace{{}},
+		})
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+
+	t.Run("without a valid workspace ID", func(t *testing.T) {
+		_, err := client.Workspaces.ListAllRemoteStateConsumers(ctx, badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+
+		_, err = client.Workspaces.IsRemoteStateConsumer(ctx, "ws-producer", badIdentifier)
+		assert.Equal(t, ErrInvalidWorkspaceID, err)
+	})
+}
+
+func TestWorkspacesCreateWithProjectName(t *testing.T) {
+	t.Parallel()
+
+	var created []byte
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", ContentTypeJSONAPI)
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/ping":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /api/v2/organizations/acme/projects":
+			_, _ = io.WriteString(w, `{"data":[{"id":"prj-1","type":"projects","attributes":{"name":"Platform"}}],
+				"meta":{"pagination":{"current-page":1,"next-page":null,"total-pages":1,"total-count":1}}}`)
+		case "POST /api/v2/organizations/acme/workspaces":
+			created, _ = io.ReadAll(r.Body)
+			w.WriteHeader(http.StatusCreated)
+			_, _ = io.WriteString(w, `{"data":{"id":"ws-1","type":"workspaces","attributes":{"name":"network"},
+				"relationships":{"project":{"data":{"id":"prj-1","type":"projects"}}}}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	client, err := NewClient(&Config{Address: srv.URL, Token: "insert-your-token-here", HTTPClient: srv.Client()})
+	require.NoError(t, err)
+	ctx := context.Background()
+
+	t.Run("with a project name", func(t *testing.T) {
+		w, err := client.Workspaces.Create(ctx, "acme", WorkspaceCreateOptions{
+			Name:        String("network"),
+			ProjectName: String("Platform"),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "prj-1", w.Project.ID)
+		assert.Contains(t, string(created), `"project":{"data":{"type":"projects","id":"prj-1"}}`)
+	})
+
+	t.Run("with an unknown project name", func(t *testing.T) {
+		_, err := client.Workspaces.Create(ctx, "acme", WorkspaceCreateOptions{
+			Name:        String("network"),
+			ProjectName: String("Data"),
+		})
+		assert.ErrorIs(t, err, ErrResourceNotFound)
+	})
+
+	t.Run("with both a project and a project name", func(t *testing.T) {
+		_, err := client.Workspaces.Create(ctx, "acme", WorkspaceCreateOptions{
+			Name:        String("network"),
+			Project:     &Project{ID: "prj-1"},
+			ProjectName: String("Platform"),
+		})
+		assert.Equal(t, ErrUnsupportedBothProjectAndProjectName, err)
+	})
+}
+
+func TestWorkspacesTagBindingLimits(t *testing.T) {
+	t.Parallel()
+
+	client, done := newExampleClient(map[string]string{})
+	defer done()
+	ctx := context.Background()
+
+	tooMany := make([]*TagBinding, MaxTagBindings+1)
+	for i := range tooMany {
+		tooMany[i] = &TagBinding{Key: fmt.Sprintf("key-%d", i)}
+	}
+
+	t.Run("when creating a workspace with too many tag bindings", func(t *testing.T) {
+		_, err := client.Workspaces.Create(ctx, "acme", WorkspaceCreateOptions{
+			Name:        String("network"),
+			TagBindings: tooMany,
+		})
+		assert.Equal(t, ErrTooManyTagBindings, err)
+	})
+
+	t.Run("when updating a workspace with too many tag bindings", func(t *testing.T) {
+		_, err := client.Workspaces.Update(ctx, "acme", "network", WorkspaceUpdateOptions{
+			TagBindings: tooMany,
+		})
+		assert.Equal(t, ErrTooManyTagBindings, err)
+
+		_, err = client.Workspaces.UpdateByID(ctx, "ws-1", WorkspaceUpdateOptions{
+			TagBindings: tooMany,
+		})
+		assert.Equal(t, ErrTooManyTagBindings, err)
+	})
+
+	t.Run("when adding too many tag bindings", func(t *testing.T) {
+		_, err := client.Workspaces.AddTagBindings(ctx, "ws-1", WorkspaceAddTagBindingsOptions{
+			TagBindings: tooMany,
+		})
+		assert.Equal(t, ErrTooManyTagBindings, err)
+	})
+
+	t.Run("when adding invalid tag bindings", func(t *testing.T) {
+		for _, tb := range []*TagBinding{
+			{Key: " ", Value: "dev"},
+			{Key: strings.Repeat("k", MaxTagBindingKeyLength+1)},
+			{Key: "env", Value: strings.Repeat("v", MaxTagBindingValueLength+1)},
+		} {
+			_, err := client.Workspaces.AddTagBindings(ctx, "ws-1", WorkspaceAddTagBindingsOptions{
+				TagBindings: []*TagBinding{tb},
+			})
+			assert.Equal(t, ErrInvalidTagBinding, err)
+		}
+	})
+
+	t.Run("when merging tag bindings", func(t *testing.T) {
+		merged := MergeTagBindings(
+			[]*TagBinding{{Key: "Env", Value: "dev"}, {Key: "team", Value: "network"}},
+			[]*TagBinding{{Key: " env ", Value: "prod"}, {Key: ""}, nil, {Key: "cost-center", Value: "42"}},
+		)
+		assert.Equal(t, []*TagBinding{
+			{Key: "Env", Value: "prod"},
+			{Key: "team", Value: "network"},
+			{Key: "cost-center", Value: "42"},
+		}, merged)
+	})
+}